@@ -0,0 +1,515 @@
+package fp
+
+import (
+    "fmt"
+    "math"
+    "math/big"
+    "math/bits"
+
+    "github.com/camry/fp/fixutil"
+)
+
+var (
+    F128Zero     = F128FromRaw(0, 0)
+    F128Half     = F128FromRaw(0, 1<<63)
+    F128One      = F128FromRaw(1, 0)
+    F128Two      = F128FromRaw(2, 0)
+    F128MinValue = F128FromRaw(1<<63, 0)
+    F128MaxValue = F128FromRaw(1<<63-1, math.MaxUint64)
+)
+
+// F128 Signed 64.64 fixed point value struct, for sums and products that overflow or lose bits in F64.
+//
+// The raw value is a two's complement 128-bit integer, Hi holding the integer part and Lo the fraction.
+// Every F64 and F32 converts to F128 exactly, and so does the full product of two F64 values.
+type F128 struct {
+    Hi uint64 // High word of the raw value, the integer part
+    Lo uint64 // Low word of the raw value, the fraction
+}
+
+/************************************/
+/*********** Construction ***********/
+/************************************/
+
+func F128FromRaw(hi, lo uint64) F128 {
+    return F128{Hi: hi, Lo: lo}
+}
+
+func F128FromInt64(v int64) F128 {
+    return F128FromRaw(uint64(v), 0)
+}
+
+// F128FromF64 Converts v exactly.
+func F128FromF64(v F64) F128 {
+    return F128FromRaw(uint64(v.Raw>>32), uint64(v.Raw)<<32)
+}
+
+// F128FromF32 Converts v exactly.
+func F128FromF32(v F32) F128 {
+    return F128FromRaw(uint64(int64(v.Raw)>>16), uint64(v.Raw)<<48)
+}
+
+// F128FromString Parses a decimal string such as "1.25" or "-3e-2", or a raw hexadecimal literal, see fix64.Parse.
+func F128FromString(s string) (F128, error) {
+    raw, err := fixutil.ParseFixedBig("F128FromString", s, 64)
+    if err != nil {
+        return F128Zero, err
+    }
+    f, ok := f128FromBig(raw)
+    if !ok {
+        if raw.Sign() < 0 {
+            f = F128MinValue
+        } else {
+            f = F128MaxValue
+        }
+        return f, &fixutil.ParseError{Func: "F128FromString", Num: s, Err: fixutil.ErrRange}
+    }
+    return f, nil
+}
+
+// F128Ratio Creates the fixed point number that's a divided by b.
+func F128Ratio(a, b int64) F128 {
+    return F128FromInt64(a).Div(F128FromInt64(b))
+}
+
+// F64SumWide Returns the total of the provided values like F64Sum, but accumulates in F128 so that
+// intermediate sums cannot overflow. ok is false when the total does not fit into F64, the returned
+// value is then clamped to F64MinValue or F64MaxValue.
+func F64SumWide(first F64, rest ...F64) (F64, bool) {
+    total := F128FromF64(first)
+    for _, item := range rest {
+        total = total.Add(F128FromF64(item))
+    }
+    if v, ok := total.F64Checked(); ok {
+        return v, true
+    }
+    if total.Sign() < 0 {
+        return F64MinValue, false
+    }
+    return F64MaxValue, false
+}
+
+// MulWide f * v2, computed exactly
+func (f F64) MulWide(v2 F64) F128 {
+    hi, lo := bits.Mul64(uint64(f.Raw), uint64(v2.Raw))
+    // Signed correction of the unsigned product.
+    if f.Raw < 0 {
+        hi -= uint64(v2.Raw)
+    }
+    if v2.Raw < 0 {
+        hi -= uint64(f.Raw)
+    }
+    return F128FromRaw(hi, lo)
+}
+
+/************************************/
+/*********** Conversions ************/
+/************************************/
+
+// FloorToInt Returns the integer part, rounded down.
+func (f F128) FloorToInt() int64 {
+    return int64(f.Hi)
+}
+
+// RoundToInt Returns the nearest integer, rounding halves up.
+func (f F128) RoundToInt() int64 {
+    return int64(f.Add(F128Half).Hi)
+}
+
+func (f F128) Float64() float64 {
+    return float64(int64(f.Hi)) + float64(f.Lo)*(1.0/18446744073709551616.0)
+}
+
+// F64 Converts f, rounding down and wrapping around when out of range, see F64Checked.
+func (f F128) F64() F64 {
+    return F64FromRaw(int64(f.Hi<<32 | f.Lo>>32))
+}
+
+// F64Checked Converts f rounding down, ok is false when the result is out of range and wrapped around.
+func (f F128) F64Checked() (F64, bool) {
+    top := int64(f.Hi) >> 31
+    return f.F64(), top == 0 || top == -1
+}
+
+/************************************/
+/************ Operators *************/
+/************************************/
+
+// Negate -f
+func (f F128) Negate() F128 {
+    lo, borrow := bits.Sub64(0, f.Lo, 0)
+    hi, _ := bits.Sub64(0, f.Hi, borrow)
+    return F128FromRaw(hi, lo)
+}
+
+// Add f + v2
+func (f F128) Add(v2 F128) F128 {
+    lo, carry := bits.Add64(f.Lo, v2.Lo, 0)
+    hi, _ := bits.Add64(f.Hi, v2.Hi, carry)
+    return F128FromRaw(hi, lo)
+}
+
+// Sub f - v2
+func (f F128) Sub(v2 F128) F128 {
+    lo, borrow := bits.Sub64(f.Lo, v2.Lo, 0)
+    hi, _ := bits.Sub64(f.Hi, v2.Hi, borrow)
+    return F128FromRaw(hi, lo)
+}
+
+// AddChecked f + v2, ok is false on overflow
+func (f F128) AddChecked(v2 F128) (F128, bool) {
+    r := f.Add(v2)
+    return r, ((f.Hi^r.Hi)&(v2.Hi^r.Hi))>>63 == 0
+}
+
+// SubChecked f - v2, ok is false on overflow
+func (f F128) SubChecked(v2 F128) (F128, bool) {
+    r := f.Sub(v2)
+    return r, ((f.Hi^v2.Hi)&(f.Hi^r.Hi))>>63 == 0
+}
+
+// Mul f * v2, rounded down like fix64.Mul and wrapping around on overflow
+func (f F128) Mul(v2 F128) F128 {
+    r, _ := f.MulChecked(v2)
+    return r
+}
+
+// MulChecked f * v2, ok is false on overflow
+func (f F128) MulChecked(v2 F128) (F128, bool) {
+    neg := f.Sign()*v2.Sign() < 0
+    aHi, aLo := f.abs()
+    bHi, bLo := v2.abs()
+    p := mulU128(aHi, aLo, bHi, bLo)
+
+    // Result is the magnitude shifted right by 64, rounded away from zero for negative products so that
+    // the signed result is rounded down.
+    hi, lo := p[2], p[1]
+    over := p[3]
+    if neg && p[0] != 0 {
+        var c uint64
+        lo, c = bits.Add64(lo, 1, 0)
+        hi, c = bits.Add64(hi, 0, c)
+        over += c
+    }
+    return f128FromMag(neg, hi, lo), over == 0 && fitsMag(neg, hi, lo)
+}
+
+// Div f / v2, rounded toward zero like fix64.DivPrecise. Overflow clamps to F128MinValue/F128MaxValue
+// and division by zero returns 0.
+func (f F128) Div(v2 F128) F128 {
+    r, ok := f.DivChecked(v2)
+    if !ok {
+        if v2.IsZero() {
+            return F128Zero
+        }
+        if f.Sign()*v2.Sign() < 0 {
+            return F128MinValue
+        }
+        return F128MaxValue
+    }
+    return r
+}
+
+// DivChecked f / v2, ok is false on overflow or division by zero
+func (f F128) DivChecked(v2 F128) (F128, bool) {
+    if v2.IsZero() {
+        return F128Zero, false
+    }
+    neg := f.Sign()*v2.Sign() < 0
+    aHi, aLo := f.abs()
+    bHi, bLo := v2.abs()
+    q2, q1, q0 := divU192(aHi, aLo, 0, bHi, bLo)
+    return f128FromMag(neg, q1, q0), q2 == 0 && fitsMag(neg, q1, q0)
+}
+
+// Sqrt Calculates the square root, rounded down. Returns 0 for negative values.
+func (f F128) Sqrt() F128 {
+    if f.Sign() <= 0 {
+        return F128Zero
+    }
+    // Bitwise integer square root of the 192-bit value raw << 64, which is below 2^191, so the root
+    // is below 2^96.
+    var rHi, rLo uint64
+    for i := 95; i >= 0; i-- {
+        tHi, tLo := rHi, rLo
+        if i >= 64 {
+            tHi |= 1 << (i - 64)
+        } else {
+            tLo |= 1 << i
+        }
+        p := mulU128(tHi, tLo, tHi, tLo)
+        if p[3] == 0 && cmpU192(p[2], p[1], p[0], f.Hi, f.Lo, 0) <= 0 {
+            rHi, rLo = tHi, tLo
+        }
+    }
+    return F128FromRaw(rHi, rLo)
+}
+
+// Abs Returns the absolute value, F128MinValue stays unchanged.
+func (f F128) Abs() F128 {
+    if f.Sign() < 0 {
+        return f.Negate()
+    }
+    return f
+}
+
+// Sign Returns -1 if negative, 0 if zero, 1 if positive.
+func (f F128) Sign() int32 {
+    if int64(f.Hi) < 0 {
+        return -1
+    }
+    if f.Hi == 0 && f.Lo == 0 {
+        return 0
+    }
+    return 1
+}
+
+func (f F128) IsZero() bool {
+    return f.Hi == 0 && f.Lo == 0
+}
+
+// EQ f == v2
+func (f F128) EQ(v2 F128) bool {
+    return f == v2
+}
+
+// NE f != v2
+func (f F128) NE(v2 F128) bool {
+    return f != v2
+}
+
+// LT f < v2
+func (f F128) LT(v2 F128) bool {
+    return f.CompareTo(v2) < 0
+}
+
+// LE f <= v2
+func (f F128) LE(v2 F128) bool {
+    return f.CompareTo(v2) <= 0
+}
+
+// GT f > v2
+func (f F128) GT(v2 F128) bool {
+    return f.CompareTo(v2) > 0
+}
+
+// GE f >= v2
+func (f F128) GE(v2 F128) bool {
+    return f.CompareTo(v2) >= 0
+}
+
+func (f F128) Equals(obj F128) bool {
+    return f == obj
+}
+
+func (f F128) CompareTo(other F128) int32 {
+    if f.Hi != other.Hi {
+        if int64(f.Hi) < int64(other.Hi) {
+            return -1
+        }
+        return +1
+    }
+    if f.Lo < other.Lo {
+        return -1
+    }
+    if f.Lo > other.Lo {
+        return +1
+    }
+    return 0
+}
+
+func (f F128) ToString() string {
+    return f.Text('f', -1)
+}
+
+// Text Converts f to a string in the given format, 'f' with prec fraction digits (shortest when prec < 0)
+// or 'x' for the raw hexadecimal value, see fix64.Format.
+func (f F128) Text(format byte, prec int) string {
+    mag := f.big()
+    sign := ""
+    if mag.Sign() < 0 {
+        sign = "-"
+        mag.Neg(mag)
+    }
+    switch format {
+    case 'x':
+        return sign + "0x" + mag.Text(16)
+    case 'f':
+    default:
+        return sign + "%" + string(format)
+    }
+
+    one := new(big.Int).Lsh(big.NewInt(1), 64)
+    if prec < 0 {
+        // Shortest: the fewest digits that round back to the same raw value. 20 digits always do, as
+        // they are finer than the 2^-64 resolution.
+        for prec = 0; prec < 20; prec++ {
+            pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+            digits := roundQuo(new(big.Int).Mul(mag, pow), one)
+            if roundQuo(new(big.Int).Lsh(digits, 64), pow).Cmp(mag) == 0 {
+                break
+            }
+        }
+    }
+    return sign + f128Digits(mag, one, prec)
+}
+
+// Format Implements fmt.Formatter, supporting %v, %s, %f and %x.
+func (f F128) Format(s fmt.State, verb rune) {
+    writeFixed(s, verb, "fp.F128", f.Text)
+}
+
+/************************************/
+/************* Helpers **************/
+/************************************/
+
+// abs Returns the magnitude of f as an unsigned 128-bit value.
+func (f F128) abs() (hi, lo uint64) {
+    if f.Sign() < 0 {
+        n := f.Negate()
+        return n.Hi, n.Lo
+    }
+    return f.Hi, f.Lo
+}
+
+// big Returns the raw value as a big.Int.
+func (f F128) big() *big.Int {
+    v := new(big.Int).SetUint64(f.Hi)
+    v.Lsh(v, 64)
+    v.Or(v, new(big.Int).SetUint64(f.Lo))
+    if int64(f.Hi) < 0 {
+        v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+    }
+    return v
+}
+
+// f128FromBig Converts a raw value, ok is false when it does not fit into 128 bits.
+func f128FromBig(v *big.Int) (F128, bool) {
+    if v.BitLen() > 128 || (v.BitLen() == 128 && !(v.Sign() < 0 && v.TrailingZeroBits() == 127)) {
+        return F128Zero, false
+    }
+    mag := new(big.Int).Abs(v)
+    lo := new(big.Int).And(mag, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+    hi := new(big.Int).Rsh(mag, 64).Uint64()
+    return f128FromMag(v.Sign() < 0, hi, lo), true
+}
+
+// f128FromMag Applies the sign to a 128-bit magnitude.
+func f128FromMag(neg bool, hi, lo uint64) F128 {
+    f := F128FromRaw(hi, lo)
+    if neg {
+        return f.Negate()
+    }
+    return f
+}
+
+// fitsMag Reports whether the magnitude with the given sign is in the F128 range.
+func fitsMag(neg bool, hi, lo uint64) bool {
+    if neg {
+        return hi < 1<<63 || (hi == 1<<63 && lo == 0)
+    }
+    return hi < 1<<63
+}
+
+// mulU128 Returns the 256-bit product of two unsigned 128-bit values, least significant word first.
+func mulU128(aHi, aLo, bHi, bLo uint64) [4]uint64 {
+    var p [4]uint64
+    var c uint64
+    h, l := bits.Mul64(aLo, bLo)
+    p[0], p[1] = l, h
+
+    h, l = bits.Mul64(aHi, bLo)
+    p[1], c = bits.Add64(p[1], l, 0)
+    p[2], c = bits.Add64(h, 0, c)
+    p[3] = c
+
+    h, l = bits.Mul64(aLo, bHi)
+    p[1], c = bits.Add64(p[1], l, 0)
+    p[2], c = bits.Add64(p[2], h, c)
+    p[3] += c
+
+    h, l = bits.Mul64(aHi, bHi)
+    p[2], c = bits.Add64(p[2], l, 0)
+    p[3], _ = bits.Add64(p[3], h, c)
+    return p
+}
+
+// cmpU192 Compares two unsigned 192-bit values given most significant word first.
+func cmpU192(a2, a1, a0, b2, b1, b0 uint64) int {
+    switch {
+    case a2 != b2:
+        if a2 < b2 {
+            return -1
+        }
+        return 1
+    case a1 != b1:
+        if a1 < b1 {
+            return -1
+        }
+        return 1
+    case a0 != b0:
+        if a0 < b0 {
+            return -1
+        }
+        return 1
+    }
+    return 0
+}
+
+// divU192 Divides the unsigned 192-bit value n2:n1:n0 by the non-zero 128-bit value dHi:dLo, returning
+// the quotient most significant word first.
+func divU192(n2, n1, n0, dHi, dLo uint64) (q2, q1, q0 uint64) {
+    if dHi == 0 {
+        var r uint64
+        q2, r = bits.Div64(0, n2, dLo)
+        q1, r = bits.Div64(r, n1, dLo)
+        q0, _ = bits.Div64(r, n0, dLo)
+        return q2, q1, q0
+    }
+
+    // Restoring division, one quotient bit per step. The divisor is at least 2^64, so the quotient fits
+    // into 128 bits and the remainder stays below 2^129, with the carry holding the top bit.
+    var rHi, rLo uint64
+    words := [3]uint64{n2, n1, n0}
+    for _, w := range words {
+        for i := 63; i >= 0; i-- {
+            carry := rHi >> 63
+            rHi = rHi<<1 | rLo>>63
+            rLo = rLo<<1 | (w>>uint(i))&1
+            q1 = q1<<1 | q0>>63
+            q0 <<= 1
+            if carry != 0 || rHi > dHi || (rHi == dHi && rLo >= dLo) {
+                var b uint64
+                rLo, b = bits.Sub64(rLo, dLo, 0)
+                rHi, _ = bits.Sub64(rHi, dHi, b)
+                q0 |= 1
+            }
+        }
+    }
+    return 0, q1, q0
+}
+
+// roundQuo Returns num / den rounded to nearest, ties to even, for non-negative values.
+func roundQuo(num, den *big.Int) *big.Int {
+    q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+    r.Lsh(r, 1)
+    if c := r.Cmp(den); c > 0 || (c == 0 && q.Bit(0) == 1) {
+        q.Add(q, big.NewInt(1))
+    }
+    return q
+}
+
+// f128Digits Formats the non-negative raw value mag / one with prec fraction digits, rounded half to even.
+func f128Digits(mag, one *big.Int, prec int) string {
+    pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+    digits := roundQuo(new(big.Int).Mul(mag, pow), one)
+    intPart, frac := new(big.Int).QuoRem(digits, pow, new(big.Int))
+    if prec == 0 {
+        return intPart.String()
+    }
+    s := frac.String()
+    for len(s) < prec {
+        s = "0" + s
+    }
+    return intPart.String() + "." + s
+}
@@ -0,0 +1,93 @@
+package fp_test
+
+import (
+    "fmt"
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+)
+
+func f128Big(f fp.F128) *big.Float {
+    v := new(big.Int).SetUint64(f.Hi)
+    v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(f.Lo))
+    if int64(f.Hi) < 0 {
+        v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+    }
+    return new(big.Float).SetPrec(256).SetMantExp(new(big.Float).SetPrec(256).SetInt(v), -64)
+}
+
+func TestF128_Widening(t *testing.T) {
+    for _, v := range []fp.F64{fp.F64Pi, fp.F64Pi.Negate(), fp.F64MinValue, fp.F64MaxValue, fp.F64FromRaw(-1)} {
+        w := fp.F128FromF64(v)
+        assert.Equal(t, v.Text('f', 32), w.Text('f', 32))
+        back, ok := w.F64Checked()
+        assert.True(t, ok)
+        assert.Equal(t, v, back)
+    }
+    assert.Equal(t, fp.F32Pi.Text('f', 16), fp.F128FromF32(fp.F32Pi.Negate()).Negate().Text('f', 16))
+
+    _, ok := fp.F128FromF64(fp.F64MaxValue).Add(fp.F128FromRaw(0, 1<<32)).F64Checked()
+    assert.False(t, ok)
+}
+
+func TestF128_SumWide(t *testing.T) {
+    big := fp.F64MaxValue.Sub(fp.F64One)
+    values := []fp.F64{big, big, big, big.Negate(), big.Negate(), big.Negate(), fp.F64Half}
+    total, ok := fp.F64SumWide(values[0], values[1:]...)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Half, total)
+
+    total, ok = fp.F64SumWide(big, big)
+    assert.False(t, ok)
+    assert.Equal(t, fp.F64MaxValue, total)
+}
+
+func TestF128_Arithmetic(t *testing.T) {
+    a := fp.F64FromFloat64(12345.678).MulWide(fp.F64FromFloat64(-0.000123))
+    want := new(big.Float).SetPrec(256).Mul(f128Big(fp.F128FromF64(fp.F64FromFloat64(12345.678))), f128Big(fp.F128FromF64(fp.F64FromFloat64(-0.000123))))
+    assert.Equal(t, 0, f128Big(a).Cmp(want)) // exact, fix64.Mul would drop the low bits
+
+    x, err := fp.F128FromString("123456789012345.125")
+    assert.NoError(t, err)
+    y, err := fp.F128FromString("-0.5")
+    assert.NoError(t, err)
+    assert.Equal(t, "123456789012344.625", x.Add(y).ToString())
+    assert.Equal(t, "123456789012345.625", x.Sub(y).ToString())
+    assert.Equal(t, "-61728394506172.5625", x.Mul(y).ToString())
+    assert.Equal(t, "-246913578024690.25", x.Div(y).ToString())
+    assert.Equal(t, "3", fp.F128FromInt64(9).Sqrt().ToString())
+    assert.Equal(t, "1.41421356237309504876", fp.F128Two.Sqrt().ToString())
+    assert.Equal(t, "0.3333333333333333333", fp.F128Ratio(1, 3).ToString())
+    assert.Equal(t, "-0.3333333333333333333", fp.F128Ratio(-1, 3).ToString())
+
+    // Wide divisors take the long division path.
+    z := fp.F128FromInt64(3 << 60)
+    assert.Equal(t, "0.5", z.Div(z.Add(z)).ToString())
+    assert.Equal(t, "41152263004115.041666666666667", x.Div(fp.F128FromInt64(3)).Text('f', 15))
+
+    assert.True(t, y.LT(x))
+    assert.True(t, fp.F128MinValue.LT(y))
+    assert.Equal(t, int32(-1), y.Sign())
+
+    _, ok := fp.F128MaxValue.AddChecked(fp.F128FromRaw(0, 1))
+    assert.False(t, ok)
+    _, ok = fp.F128MaxValue.MulChecked(fp.F128Two)
+    assert.False(t, ok)
+    _, ok = fp.F128One.DivChecked(fp.F128Zero)
+    assert.False(t, ok)
+    r, ok := fp.F128MinValue.MulChecked(fp.F128One)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F128MinValue, r)
+}
+
+func TestF128_Format(t *testing.T) {
+    x, _ := fp.F128FromString("-2.75")
+    assert.Equal(t, "-2.750", fmt.Sprintf("%.3f", x))
+    assert.Equal(t, "-0x2c000000000000000", fmt.Sprintf("%#x", x))
+    assert.Equal(t, "9223372036854775807.99999999999999999995", fp.F128MaxValue.ToString())
+    _, err := fp.F128FromString("1e40")
+    assert.Error(t, err)
+}
@@ -1,7 +1,7 @@
 package fp
 
 import (
-    "reflect"
+    "fmt"
 
     "github.com/camry/fp/fix32"
 )
@@ -39,6 +39,12 @@ func F32FromInt32(v int32) F32 {
     return F32FromRaw(fix32.FromInt32(v))
 }
 
+// F32FromString Parses a decimal string such as "1.25" or "-3e-2", or a raw hexadecimal literal, see fix32.Parse.
+func F32FromString(s string) (F32, error) {
+    raw, err := fix32.Parse(s)
+    return F32FromRaw(raw), err
+}
+
 func F32FromFloat32(v float32) F32 {
     return F32FromRaw(fix32.FromFloat32(v))
 }
@@ -180,6 +186,60 @@ func (f F32) Mod(v2 F32) F32 {
     return F32FromRaw(fix32.Mod(f.Raw, v2.Raw))
 }
 
+// AddChecked f + v2, ok is false on overflow
+func (f F32) AddChecked(v2 F32) (F32, bool) {
+    r, ok := fix32.AddChecked(f.Raw, v2.Raw)
+    return F32FromRaw(r), ok
+}
+
+// SubChecked f - v2, ok is false on overflow
+func (f F32) SubChecked(v2 F32) (F32, bool) {
+    r, ok := fix32.SubChecked(f.Raw, v2.Raw)
+    return F32FromRaw(r), ok
+}
+
+// MulChecked f * v2, ok is false on overflow
+func (f F32) MulChecked(v2 F32) (F32, bool) {
+    r, ok := fix32.MulChecked(f.Raw, v2.Raw)
+    return F32FromRaw(r), ok
+}
+
+// DivChecked f / v2, ok is false on overflow or division by zero
+func (f F32) DivChecked(v2 F32) (F32, bool) {
+    r, ok := fix32.DivChecked(f.Raw, v2.Raw)
+    return F32FromRaw(r), ok
+}
+
+// AddSat f + v2, clamped to F32MinValue/F32MaxValue
+func (f F32) AddSat(v2 F32) F32 {
+    return F32FromRaw(fix32.AddSat(f.Raw, v2.Raw))
+}
+
+// SubSat f - v2, clamped to F32MinValue/F32MaxValue
+func (f F32) SubSat(v2 F32) F32 {
+    return F32FromRaw(fix32.SubSat(f.Raw, v2.Raw))
+}
+
+// MulSat f * v2, clamped to F32MinValue/F32MaxValue
+func (f F32) MulSat(v2 F32) F32 {
+    return F32FromRaw(fix32.MulSat(f.Raw, v2.Raw))
+}
+
+// DivSat f / v2, clamped to F32MinValue/F32MaxValue
+func (f F32) DivSat(v2 F32) F32 {
+    return F32FromRaw(fix32.DivSat(f.Raw, v2.Raw))
+}
+
+// MulRound f * v2, rounded according to mode
+func (f F32) MulRound(v2 F32, mode RoundingMode) F32 {
+    return F32FromRaw(fix32.MulRound(f.Raw, v2.Raw, mode))
+}
+
+// DivRound f / v2, rounded according to mode and clamped to F32MinValue/F32MaxValue
+func (f F32) DivRound(v2 F32, mode RoundingMode) F32 {
+    return F32FromRaw(fix32.DivRound(f.Raw, v2.Raw, mode))
+}
+
 // AddVec2 f + v2
 func (f F32) AddVec2(v2 F32Vec2) F32Vec2 {
     return F32Vec2FromRaw(f.Raw+v2.RawX, f.Raw+v2.RawY)
@@ -473,6 +533,22 @@ func (f F32) TanFastest() F32 {
     return F32FromRaw(fix32.TanFastest(f.Raw))
 }
 
+// SinCos The sine and cosine of f from one reduction, see fix32.SinCos.
+func (f F32) SinCos() (F32, F32) {
+    sin, cos := fix32.SinCos(f.Raw)
+    return F32FromRaw(sin), F32FromRaw(cos)
+}
+
+func (f F32) SinCosFast() (F32, F32) {
+    sin, cos := fix32.SinCosFast(f.Raw)
+    return F32FromRaw(sin), F32FromRaw(cos)
+}
+
+func (f F32) SinCosFastest() (F32, F32) {
+    sin, cos := fix32.SinCosFastest(f.Raw)
+    return F32FromRaw(sin), F32FromRaw(cos)
+}
+
 func (f F32) Asin() F32 {
     return F32FromRaw(fix32.Asin(f.Raw))
 }
@@ -521,6 +597,78 @@ func (f F32) Atan2Fastest(x F32) F32 {
     return F32FromRaw(fix32.Atan2Fastest(f.Raw, x.Raw))
 }
 
+func (f F32) Sinh() F32 {
+    return F32FromRaw(fix32.Sinh(f.Raw))
+}
+
+func (f F32) SinhFast() F32 {
+    return F32FromRaw(fix32.SinhFast(f.Raw))
+}
+
+func (f F32) SinhFastest() F32 {
+    return F32FromRaw(fix32.SinhFastest(f.Raw))
+}
+
+func (f F32) Cosh() F32 {
+    return F32FromRaw(fix32.Cosh(f.Raw))
+}
+
+func (f F32) CoshFast() F32 {
+    return F32FromRaw(fix32.CoshFast(f.Raw))
+}
+
+func (f F32) CoshFastest() F32 {
+    return F32FromRaw(fix32.CoshFastest(f.Raw))
+}
+
+func (f F32) Tanh() F32 {
+    return F32FromRaw(fix32.Tanh(f.Raw))
+}
+
+func (f F32) TanhFast() F32 {
+    return F32FromRaw(fix32.TanhFast(f.Raw))
+}
+
+func (f F32) TanhFastest() F32 {
+    return F32FromRaw(fix32.TanhFastest(f.Raw))
+}
+
+func (f F32) Asinh() F32 {
+    return F32FromRaw(fix32.Asinh(f.Raw))
+}
+
+func (f F32) AsinhFast() F32 {
+    return F32FromRaw(fix32.AsinhFast(f.Raw))
+}
+
+func (f F32) AsinhFastest() F32 {
+    return F32FromRaw(fix32.AsinhFastest(f.Raw))
+}
+
+func (f F32) Acosh() F32 {
+    return F32FromRaw(fix32.Acosh(f.Raw))
+}
+
+func (f F32) AcoshFast() F32 {
+    return F32FromRaw(fix32.AcoshFast(f.Raw))
+}
+
+func (f F32) AcoshFastest() F32 {
+    return F32FromRaw(fix32.AcoshFastest(f.Raw))
+}
+
+func (f F32) Atanh() F32 {
+    return F32FromRaw(fix32.Atanh(f.Raw))
+}
+
+func (f F32) AtanhFast() F32 {
+    return F32FromRaw(fix32.AtanhFast(f.Raw))
+}
+
+func (f F32) AtanhFastest() F32 {
+    return F32FromRaw(fix32.AtanhFastest(f.Raw))
+}
+
 func (f F32) Pow(b F32) F32 {
     return F32FromRaw(fix32.Pow(f.Raw, b.Raw))
 }
@@ -533,6 +681,26 @@ func (f F32) PowFastest(b F32) F32 {
     return F32FromRaw(fix32.PowFastest(f.Raw, b.Raw))
 }
 
+// PowInt f to the integer power n, for negative f as well, see fix32.PowInt.
+func (f F32) PowInt(n int32) F32 {
+    return F32FromRaw(fix32.PowInt(f.Raw, n))
+}
+
+// Cbrt The cube root of f, negative for negative f.
+func (f F32) Cbrt() F32 {
+    return F32FromRaw(fix32.Cbrt(f.Raw))
+}
+
+// NthRoot The n-th root of f, see fix32.NthRoot.
+func (f F32) NthRoot(n int32) F32 {
+    return F32FromRaw(fix32.NthRoot(f.Raw, n))
+}
+
+// Hypot sqrt(f^2 + b^2) without overflow in the squares, see fix32.Hypot.
+func (f F32) Hypot(b F32) F32 {
+    return F32FromRaw(fix32.Hypot(f.Raw, b.Raw))
+}
+
 func (f F32) Clamp(min, max F32) F32 {
     return F32FromRaw(fix32.Clamp(f.Raw, min.Raw, max.Raw))
 }
@@ -548,7 +716,7 @@ func (f F32) Lerp(b, t F32) F32 {
 }
 
 func (f F32) Equals(obj F32) bool {
-    return reflect.DeepEqual(f, obj)
+    return f == obj
 }
 
 func (f F32) CompareTo(other F32) int32 {
@@ -564,3 +732,14 @@ func (f F32) CompareTo(other F32) int32 {
 func (f F32) ToString() string {
     return fix32.ToString(f.Raw)
 }
+
+// Text Converts f to a string in the given format, 'f' with prec fraction digits (shortest when prec < 0)
+// or 'x' for the raw hexadecimal value, see fix32.Format.
+func (f F32) Text(format byte, prec int) string {
+    return fix32.Format(f.Raw, format, prec)
+}
+
+// Format Implements fmt.Formatter, supporting %v, %s, %f and %x.
+func (f F32) Format(s fmt.State, verb rune) {
+    formatFixed(s, verb, int64(f.Raw), uint(fix32.Shift), "fp.F32")
+}
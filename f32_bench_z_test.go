@@ -0,0 +1,313 @@
+package fp_test
+
+import (
+    "testing"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix32"
+)
+
+type (
+    f32Op    = benchOp[fp.F32, fp.F32]
+    f32BinOp = benchOp[pair[fp.F32, fp.F32], fp.F32]
+    fix32Op  = benchOp[int32, int32]
+)
+
+var (
+    f32Sink     fp.F32
+    f32Vec2Sink fp.F32Vec2
+    f32Vec3Sink fp.F32Vec3
+    f32Vec4Sink fp.F32Vec4
+    f32QuatSink fp.F32Quat
+)
+
+// f32Spread Returns benchInputs numbers over [lo, hi), see spread.
+func f32Spread(lo, hi float64) []fp.F32 {
+    return mapped(spread(lo, hi), fp.F32FromFloat64)
+}
+
+func f32Raw(f fp.F32) int32 {
+    return f.Raw
+}
+
+func f32RawPair(p pair[fp.F32, fp.F32]) pair[int32, int32] {
+    return pair[int32, int32]{p.a.Raw, p.b.Raw}
+}
+
+func BenchmarkF32(b *testing.B) {
+    pos, wide, unit := f32Spread(0.25, 16), f32Spread(-8, 8), f32Spread(-0.99, 0.99)
+    div, pow := pairs(wide, pos), pairs(pos, f32Spread(-2, 2))
+    b.Run("Positive", func(b *testing.B) {
+        benchOps(b, pos, &f32Sink, []f32Op{
+            {"SqrtPrecise", fp.F32.SqrtPrecise}, {"Sqrt", fp.F32.Sqrt}, {"SqrtFast", fp.F32.SqrtFast}, {"SqrtFastest", fp.F32.SqrtFastest},
+            {"RSqrt", fp.F32.RSqrt}, {"RSqrtFast", fp.F32.RSqrtFast}, {"RSqrtFastest", fp.F32.RSqrtFastest},
+            {"Rcp", fp.F32.Rcp}, {"RcpFast", fp.F32.RcpFast}, {"RcpFastest", fp.F32.RcpFastest},
+            {"Log", fp.F32.Log}, {"LogFast", fp.F32.LogFast}, {"LogFastest", fp.F32.LogFastest},
+            {"Log2", fp.F32.Log2}, {"Log2Fast", fp.F32.Log2Fast}, {"Log2Fastest", fp.F32.Log2Fastest},
+        })
+    })
+    b.Run("Any", func(b *testing.B) {
+        benchOps(b, wide, &f32Sink, []f32Op{
+            {"Negate", fp.F32.Negate}, {"Abs", fp.F32.Abs}, {"Floor", fp.F32.Floor}, {"Ceil", fp.F32.Ceil},
+            {"Round", fp.F32.Round}, {"Fract", fp.F32.Fract},
+            {"Exp", fp.F32.Exp}, {"ExpFast", fp.F32.ExpFast}, {"ExpFastest", fp.F32.ExpFastest},
+            {"Exp2", fp.F32.Exp2}, {"Exp2Fast", fp.F32.Exp2Fast}, {"Exp2Fastest", fp.F32.Exp2Fastest},
+            {"Sin", fp.F32.Sin}, {"SinFast", fp.F32.SinFast}, {"SinFastest", fp.F32.SinFastest},
+            {"Cos", fp.F32.Cos}, {"CosFast", fp.F32.CosFast}, {"CosFastest", fp.F32.CosFastest},
+            {"Atan", fp.F32.Atan}, {"AtanFast", fp.F32.AtanFast}, {"AtanFastest", fp.F32.AtanFastest},
+        })
+    })
+    b.Run("Unit", func(b *testing.B) {
+        benchOps(b, unit, &f32Sink, []f32Op{
+            {"Tan", fp.F32.Tan}, {"TanFast", fp.F32.TanFast}, {"TanFastest", fp.F32.TanFastest},
+            {"Asin", fp.F32.Asin}, {"AsinFast", fp.F32.AsinFast}, {"AsinFastest", fp.F32.AsinFastest},
+            {"Acos", fp.F32.Acos}, {"AcosFast", fp.F32.AcosFast}, {"AcosFastest", fp.F32.AcosFastest},
+        })
+    })
+    b.Run("Binary", func(b *testing.B) {
+        benchOps(b, div, &f32Sink, []f32BinOp{
+            {"Add", binary(fp.F32.Add)}, {"Sub", binary(fp.F32.Sub)}, {"Mul", binary(fp.F32.Mul)},
+            {"AddSat", binary(fp.F32.AddSat)}, {"SubSat", binary(fp.F32.SubSat)}, {"MulSat", binary(fp.F32.MulSat)},
+            {"DivPrecise", binary(fp.F32.DivPrecise)}, {"DivSat", binary(fp.F32.DivSat)}, {"Mod", binary(fp.F32.Mod)},
+            {"Div", binary(fp.F32.Div)}, {"DivFast", binary(fp.F32.DivFast)}, {"DivFastest", binary(fp.F32.DivFastest)},
+            {"Atan2", binary(fp.F32.Atan2)}, {"Atan2Fast", binary(fp.F32.Atan2Fast)}, {"Atan2Fastest", binary(fp.F32.Atan2Fastest)},
+            {"Lerp", func(p pair[fp.F32, fp.F32]) fp.F32 { return p.a.Lerp(p.b, fp.F32Half) }},
+        })
+        benchOps(b, pow, &f32Sink, []f32BinOp{
+            {"Pow", binary(fp.F32.Pow)}, {"PowFast", binary(fp.F32.PowFast)}, {"PowFastest", binary(fp.F32.PowFastest)},
+        })
+        benchOps(b, div, &boolSink, []benchOp[pair[fp.F32, fp.F32], bool]{
+            {"Equals", binary(fp.F32.Equals)}, {"EQ", binary(fp.F32.EQ)}, {"LT", binary(fp.F32.LT)},
+        })
+    })
+}
+
+// BenchmarkFix32 The raw functions wrapped by BenchmarkF32, for the cost of the wrappers.
+func BenchmarkFix32(b *testing.B) {
+    pos, wide, unit := f32Spread(0.25, 16), f32Spread(-8, 8), f32Spread(-0.99, 0.99)
+    div, pow := mapped(pairs(wide, pos), f32RawPair), mapped(pairs(pos, f32Spread(-2, 2)), f32RawPair)
+    b.Run("Positive", func(b *testing.B) {
+        benchOps(b, mapped(pos, f32Raw), &int32Sink, []fix32Op{
+            {"SqrtPrecise", fix32.SqrtPrecise}, {"Sqrt", fix32.Sqrt}, {"SqrtFast", fix32.SqrtFast}, {"SqrtFastest", fix32.SqrtFastest},
+            {"RSqrt", fix32.RSqrt}, {"RSqrtFast", fix32.RSqrtFast}, {"RSqrtFastest", fix32.RSqrtFastest},
+            {"Rcp", fix32.Rcp}, {"RcpFast", fix32.RcpFast}, {"RcpFastest", fix32.RcpFastest},
+            {"Log", fix32.Log}, {"LogFast", fix32.LogFast}, {"LogFastest", fix32.LogFastest},
+            {"Log2", fix32.Log2}, {"Log2Fast", fix32.Log2Fast}, {"Log2Fastest", fix32.Log2Fastest},
+        })
+    })
+    b.Run("Any", func(b *testing.B) {
+        benchOps(b, mapped(wide, f32Raw), &int32Sink, []fix32Op{
+            {"Negate", func(v int32) int32 { return -v }}, {"Abs", fix32.Abs}, {"Floor", fix32.Floor}, {"Ceil", fix32.Ceil},
+            {"Round", fix32.Round}, {"Fract", fix32.Fract},
+            {"Exp", fix32.Exp}, {"ExpFast", fix32.ExpFast}, {"ExpFastest", fix32.ExpFastest},
+            {"Exp2", fix32.Exp2}, {"Exp2Fast", fix32.Exp2Fast}, {"Exp2Fastest", fix32.Exp2Fastest},
+            {"Sin", fix32.Sin}, {"SinFast", fix32.SinFast}, {"SinFastest", fix32.SinFastest},
+            {"Cos", fix32.Cos}, {"CosFast", fix32.CosFast}, {"CosFastest", fix32.CosFastest},
+            {"Atan", fix32.Atan}, {"AtanFast", fix32.AtanFast}, {"AtanFastest", fix32.AtanFastest},
+        })
+    })
+    b.Run("Unit", func(b *testing.B) {
+        benchOps(b, mapped(unit, f32Raw), &int32Sink, []fix32Op{
+            {"Tan", fix32.Tan}, {"TanFast", fix32.TanFast}, {"TanFastest", fix32.TanFastest},
+            {"Asin", fix32.Asin}, {"AsinFast", fix32.AsinFast}, {"AsinFastest", fix32.AsinFastest},
+            {"Acos", fix32.Acos}, {"AcosFast", fix32.AcosFast}, {"AcosFastest", fix32.AcosFastest},
+        })
+    })
+    b.Run("Binary", func(b *testing.B) {
+        benchOps(b, div, &int32Sink, []benchOp[pair[int32, int32], int32]{
+            {"Add", binary(fix32.Add)}, {"Sub", binary(fix32.Sub)}, {"Mul", binary(fix32.Mul)},
+            {"AddSat", binary(fix32.AddSat)}, {"SubSat", binary(fix32.SubSat)}, {"MulSat", binary(fix32.MulSat)},
+            {"DivPrecise", binary(fix32.DivPrecise)}, {"DivSat", binary(fix32.DivSat)}, {"Mod", binary(fix32.Mod)},
+            {"Div", binary(fix32.Div)}, {"DivFast", binary(fix32.DivFast)}, {"DivFastest", binary(fix32.DivFastest)},
+            {"Atan2", binary(fix32.Atan2)}, {"Atan2Fast", binary(fix32.Atan2Fast)}, {"Atan2Fastest", binary(fix32.Atan2Fastest)},
+            {"Lerp", func(p pair[int32, int32]) int32 { return fix32.Lerp(p.a, p.b, fix32.Half) }},
+        })
+        benchOps(b, pow, &int32Sink, []benchOp[pair[int32, int32], int32]{
+            {"Pow", binary(fix32.Pow)}, {"PowFast", binary(fix32.PowFast)}, {"PowFastest", binary(fix32.PowFastest)},
+        })
+        benchOps(b, div, &boolSink, []benchOp[pair[int32, int32], bool]{
+            {"Equals", func(p pair[int32, int32]) bool { return p.a == p.b }},
+            {"EQ", func(p pair[int32, int32]) bool { return p.a == p.b }},
+            {"LT", func(p pair[int32, int32]) bool { return p.a < p.b }},
+        })
+    })
+}
+
+func f32Vec2s(in []fp.F32) []fp.F32Vec2 {
+    return mapped(pairs(in, rotated(in, 1)), func(p pair[fp.F32, fp.F32]) fp.F32Vec2 { return fp.F32Vec2FromF32(p.a, p.b) })
+}
+
+func f32Vec3s(in []fp.F32) []fp.F32Vec3 {
+    return mapped(pairs(f32Vec2s(in), rotated(in, 2)), func(p pair[fp.F32Vec2, fp.F32]) fp.F32Vec3 {
+        return fp.F32Vec3FromF32(p.a.X(), p.a.Y(), p.b)
+    })
+}
+
+func f32Vec4s(in []fp.F32) []fp.F32Vec4 {
+    return mapped(pairs(f32Vec3s(in), rotated(in, 3)), func(p pair[fp.F32Vec3, fp.F32]) fp.F32Vec4 {
+        return fp.F32Vec4FromF32(p.a.X(), p.a.Y(), p.a.Z(), p.b)
+    })
+}
+
+func BenchmarkF32Vec2(b *testing.B) {
+    type V = fp.F32Vec2
+    pos, pow := f32Vec2s(f32Spread(0.25, 16)), f32Vec2s(f32Spread(-2, 2))
+    benchOps(b, pos, &f32Vec2Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f32Sink, []benchOp[V, fp.F32]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f32Vec2Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F32Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f32Vec2Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f32Sink, []benchOp[pair[V, V], fp.F32]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f32Spread(0.25, 16)), &f32Vec2Sink, []benchOp[pair[V, fp.F32], V]{
+        {"AddF32", binary(V.AddF32)}, {"SubF32", binary(V.SubF32)}, {"MulF32", binary(V.MulF32)},
+        {"DivPreciseF32", binary(V.DivPreciseF32)}, {"ModF32", binary(V.ModF32)},
+    })
+}
+
+func BenchmarkF32Vec3(b *testing.B) {
+    type V = fp.F32Vec3
+    pos, pow := f32Vec3s(f32Spread(0.25, 16)), f32Vec3s(f32Spread(-2, 2))
+    benchOps(b, pos, &f32Vec3Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f32Sink, []benchOp[V, fp.F32]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f32Vec3Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Cross", binary(V.Cross)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F32Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f32Vec3Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f32Sink, []benchOp[pair[V, V], fp.F32]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f32Spread(0.25, 16)), &f32Vec3Sink, []benchOp[pair[V, fp.F32], V]{
+        {"AddF32", binary(V.AddF32)}, {"SubF32", binary(V.SubF32)}, {"MulF32", binary(V.MulF32)},
+        {"DivPreciseF32", binary(V.DivPreciseF32)}, {"ModF32", binary(V.ModF32)},
+        {"DivF32", binary(V.DivF32)}, {"DivFastF32", binary(V.DivFastF32)}, {"DivFastestF32", binary(V.DivFastestF32)},
+    })
+}
+
+func BenchmarkF32Vec4(b *testing.B) {
+    type V = fp.F32Vec4
+    pos, pow := f32Vec4s(f32Spread(0.25, 16)), f32Vec4s(f32Spread(-2, 2))
+    benchOps(b, pos, &f32Vec4Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f32Sink, []benchOp[V, fp.F32]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f32Vec4Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F32Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f32Vec4Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f32Sink, []benchOp[pair[V, V], fp.F32]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f32Spread(0.25, 16)), &f32Vec4Sink, []benchOp[pair[V, fp.F32], V]{
+        {"AddF32", binary(V.AddF32)}, {"SubF32", binary(V.SubF32)}, {"MulF32", binary(V.MulF32)},
+        {"DivPreciseF32", binary(V.DivPreciseF32)}, {"ModF32", binary(V.ModF32)},
+    })
+}
+
+// f32Quats Returns benchInputs unit quaternions over all rotations.
+func f32Quats() []fp.F32Quat {
+    return mapped(f32Vec3s(f32Spread(-4, 4)), func(v fp.F32Vec3) fp.F32Quat {
+        return fp.F32QuatFromYawPitchRoll(v.X(), v.Y(), v.Z())
+    })
+}
+
+func BenchmarkF32Quat(b *testing.B) {
+    type Q = fp.F32Quat
+    q := f32Quats()
+    benchOps(b, q, &f32QuatSink, []benchOp[Q, Q]{
+        {"Negate", Q.Negate}, {"Conjugate", Q.Conjugate}, {"InverseUnit", Q.InverseUnit}, {"Inverse", Q.Inverse},
+        {"Normalize", Q.Normalize}, {"NormalizeFast", Q.NormalizeFast}, {"NormalizeFastest", Q.NormalizeFastest},
+    })
+    benchOps(b, q, &f32Sink, []benchOp[Q, fp.F32]{
+        {"LengthSqr", Q.LengthSqr}, {"Length", Q.Length}, {"LengthFast", Q.LengthFast}, {"LengthFastest", Q.LengthFastest},
+    })
+    qq := pairs(q, rotated(q, 5))
+    benchOps(b, qq, &f32QuatSink, []benchOp[pair[Q, Q], Q]{
+        {"Multiply", binary(Q.Multiply)}, {"Concatenate", binary(Q.Concatenate)},
+        {"Slerp", func(p pair[Q, Q]) Q { return p.a.Slerp(p.b, fp.F32Ratio(1, 3)) }},
+        {"Lerp", func(p pair[Q, Q]) Q { return p.a.Lerp(p.b, fp.F32Ratio(1, 3)) }},
+    })
+    benchOps(b, qq, &boolSink, []benchOp[pair[Q, Q], bool]{
+        {"Equals", binary(Q.Equals)}, {"EQ", binary(Q.EQ)},
+    })
+    v := f32Vec3s(f32Spread(-8, 8))
+    benchOps(b, pairs(q, v), &f32Vec3Sink, []benchOp[pair[Q, fp.F32Vec3], fp.F32Vec3]{
+        {"RotateVector", binary(Q.RotateVector)},
+    })
+    benchOps(b, v, &f32QuatSink, []benchOp[fp.F32Vec3, Q]{
+        {"FromYawPitchRoll", func(v fp.F32Vec3) Q { return fp.F32QuatFromYawPitchRoll(v.X(), v.Y(), v.Z()) }},
+    })
+    benchOps(b, pairs(v, f32Spread(-4, 4)), &f32QuatSink, []benchOp[pair[fp.F32Vec3, fp.F32], Q]{
+        {"FromAxisAngle", func(p pair[fp.F32Vec3, fp.F32]) Q { return fp.F32QuatFromAxisAngle(p.a.NormalizeFastest(), p.b) }},
+    })
+    benchOps(b, pairs(v, rotated(v, 5)), &f32QuatSink, []benchOp[pair[fp.F32Vec3, fp.F32Vec3], Q]{
+        {"FromTwoVectors", binary(fp.F32QuatFromTwoVectors)},
+    })
+}
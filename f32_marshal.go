@@ -0,0 +1,427 @@
+package fp
+
+var (
+    f32Fields     = []string{"Raw"}
+    f32Vec2Fields = []string{"RawX", "RawY"}
+    f32Vec3Fields = []string{"RawX", "RawY", "RawZ"}
+    f32Vec4Fields = []string{"RawX", "RawY", "RawZ", "RawW"}
+    f32QuatFields = []string{"RawX", "RawY", "RawZ", "RawW"}
+)
+
+/************************************/
+/*************** F32 ****************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing a decimal string.
+func (f F32) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSON(nil, int64(f.Raw)), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (f F32) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSON(nil, int64(f.Raw)), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting decimal or hexadecimal strings and raw integers.
+func (f *F32) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSON("F32", data, f32Fields, 1)
+    if err != nil || raws == nil {
+        return err
+    }
+    *f = F32FromRaw(int32(raws[0]))
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (f F32) MarshalText() ([]byte, error) {
+    return f32Format.appendText(nil, int64(f.Raw)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (f *F32) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalText("F32", text, 1)
+    if err != nil {
+        return err
+    }
+    *f = F32FromRaw(int32(raws[0]))
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw value as little-endian int32.
+func (f F32) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 4), int64(f.Raw)), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 4 bytes.
+func (f *F32) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32", data, 1)
+    if err != nil {
+        return err
+    }
+    *f = F32FromRaw(int32(raws[0]))
+    return nil
+}
+
+/************************************/
+/************* F32Vec2 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F32Vec2) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY)), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F32Vec2) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY)), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F32Vec2) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSON("F32Vec2", data, f32Vec2Fields, 2)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F32Vec2FromRaw(int32(raws[0]), int32(raws[1]))
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F32Vec2) MarshalText() ([]byte, error) {
+    return f32Format.appendText(nil, int64(v.RawX), int64(v.RawY)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F32Vec2) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalText("F32Vec2", text, 2)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec2FromRaw(int32(raws[0]), int32(raws[1]))
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int32.
+func (v F32Vec2) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 8), int64(v.RawX), int64(v.RawY)), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 8 bytes.
+func (v *F32Vec2) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Vec2", data, 2)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec2FromRaw(int32(raws[0]), int32(raws[1]))
+    return nil
+}
+
+/************************************/
+/************* F32Vec3 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F32Vec3) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ)), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F32Vec3) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ)), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F32Vec3) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSON("F32Vec3", data, f32Vec3Fields, 3)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F32Vec3FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]))
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F32Vec3) MarshalText() ([]byte, error) {
+    return f32Format.appendText(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F32Vec3) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalText("F32Vec3", text, 3)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec3FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]))
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int32.
+func (v F32Vec3) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 12), int64(v.RawX), int64(v.RawY), int64(v.RawZ)), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 12 bytes.
+func (v *F32Vec3) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Vec3", data, 3)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec3FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]))
+    return nil
+}
+
+/************************************/
+/************* F32Vec4 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F32Vec4) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ), int64(v.RawW)), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F32Vec4) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONTuple(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ), int64(v.RawW)), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F32Vec4) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSON("F32Vec4", data, f32Vec4Fields, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F32Vec4FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F32Vec4) MarshalText() ([]byte, error) {
+    return f32Format.appendText(nil, int64(v.RawX), int64(v.RawY), int64(v.RawZ), int64(v.RawW)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F32Vec4) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalText("F32Vec4", text, 4)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec4FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int32.
+func (v F32Vec4) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 16), int64(v.RawX), int64(v.RawY), int64(v.RawZ), int64(v.RawW)), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 16 bytes.
+func (v *F32Vec4) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Vec4", data, 4)
+    if err != nil {
+        return err
+    }
+    *v = F32Vec4FromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+/************************************/
+/************* F32Quat **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (q F32Quat) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONTuple(nil, int64(q.RawX), int64(q.RawY), int64(q.RawZ), int64(q.RawW)), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (q F32Quat) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONTuple(nil, int64(q.RawX), int64(q.RawY), int64(q.RawZ), int64(q.RawW)), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (q *F32Quat) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSON("F32Quat", data, f32QuatFields, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *q = F32QuatFromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (q F32Quat) MarshalText() ([]byte, error) {
+    return f32Format.appendText(nil, int64(q.RawX), int64(q.RawY), int64(q.RawZ), int64(q.RawW)), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (q *F32Quat) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalText("F32Quat", text, 4)
+    if err != nil {
+        return err
+    }
+    *q = F32QuatFromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int32.
+func (q F32Quat) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 16), int64(q.RawX), int64(q.RawY), int64(q.RawZ), int64(q.RawW)), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 16 bytes.
+func (q *F32Quat) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Quat", data, 4)
+    if err != nil {
+        return err
+    }
+    *q = F32QuatFromRaw(int32(raws[0]), int32(raws[1]), int32(raws[2]), int32(raws[3]))
+    return nil
+}
+
+/************************************/
+/************* F32Mat3 **************/
+/************************************/
+
+// raws Returns the elements in row-major order.
+func (m F32Mat3) raws() []int64 {
+    raws := make([]int64, 0, 9)
+    for _, row := range m.Raw {
+        for _, v := range row {
+            raws = append(raws, int64(v))
+        }
+    }
+    return raws
+}
+
+// f32Mat3FromRaws Creates a matrix from elements in row-major order.
+func f32Mat3FromRaws(raws []int64) F32Mat3 {
+    var m F32Mat3
+    for i, v := range raws {
+        m.Raw[i/3][i%3] = int32(v)
+    }
+    return m
+}
+
+// MarshalJSON Implements json.Marshaler, writing an array of rows.
+func (m F32Mat3) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONRows(nil, 3, m.raws()...), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (m F32Mat3) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONRows(nil, 3, m.raws()...), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of rows.
+func (m *F32Mat3) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSONRows("F32Mat3", data, 3, 3)
+    if err != nil || raws == nil {
+        return err
+    }
+    *m = f32Mat3FromRaws(raws)
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (m F32Mat3) MarshalText() ([]byte, error) {
+    return []byte(m.ToString()), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (m *F32Mat3) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalTextRows("F32Mat3", text, 3, 3)
+    if err != nil {
+        return err
+    }
+    *m = f32Mat3FromRaws(raws)
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the elements in row-major order as little-endian int32.
+func (m F32Mat3) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 36), m.raws()...), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 36 bytes.
+func (m *F32Mat3) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Mat3", data, 9)
+    if err != nil {
+        return err
+    }
+    *m = f32Mat3FromRaws(raws)
+    return nil
+}
+
+/************************************/
+/************* F32Mat4 **************/
+/************************************/
+
+// raws Returns the elements in row-major order.
+func (m F32Mat4) raws() []int64 {
+    raws := make([]int64, 0, 16)
+    for _, row := range m.Raw {
+        for _, v := range row {
+            raws = append(raws, int64(v))
+        }
+    }
+    return raws
+}
+
+// f32Mat4FromRaws Creates a matrix from elements in row-major order.
+func f32Mat4FromRaws(raws []int64) F32Mat4 {
+    var m F32Mat4
+    for i, v := range raws {
+        m.Raw[i/4][i%4] = int32(v)
+    }
+    return m
+}
+
+// MarshalJSON Implements json.Marshaler, writing an array of rows.
+func (m F32Mat4) MarshalJSON() ([]byte, error) {
+    return f32Format.appendJSONRows(nil, 4, m.raws()...), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (m F32Mat4) MarshalJSONRaw() ([]byte, error) {
+    return f32RawFormat.appendJSONRows(nil, 4, m.raws()...), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of rows.
+func (m *F32Mat4) UnmarshalJSON(data []byte) error {
+    raws, err := f32Format.unmarshalJSONRows("F32Mat4", data, 4, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *m = f32Mat4FromRaws(raws)
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (m F32Mat4) MarshalText() ([]byte, error) {
+    return []byte(m.ToString()), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (m *F32Mat4) UnmarshalText(text []byte) error {
+    raws, err := f32Format.unmarshalTextRows("F32Mat4", text, 4, 4)
+    if err != nil {
+        return err
+    }
+    *m = f32Mat4FromRaws(raws)
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the elements in row-major order as little-endian int32.
+func (m F32Mat4) MarshalBinary() ([]byte, error) {
+    return f32Format.appendBinary(make([]byte, 0, 64), m.raws()...), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 64 bytes.
+func (m *F32Mat4) UnmarshalBinary(data []byte) error {
+    raws, err := f32Format.unmarshalBinary("F32Mat4", data, 16)
+    if err != nil {
+        return err
+    }
+    *m = f32Mat4FromRaws(raws)
+    return nil
+}
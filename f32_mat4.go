@@ -0,0 +1,298 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix32"
+)
+
+var (
+    F32Mat4Zero     = F32Mat4FromRows(F32Vec4Zero, F32Vec4Zero, F32Vec4Zero, F32Vec4Zero)
+    F32Mat4Identity = F32Mat4FromRows(F32Vec4AxisX, F32Vec4AxisY, F32Vec4AxisZ, F32Vec4AxisW)
+)
+
+// F32Mat4 Row-major 4x4 matrix with signed 16.16 fixed point elements.
+// Vectors are treated as columns, so m.MulVec4(v) computes m * v and the translation lives in the last column.
+type F32Mat4 struct {
+    Raw [4][4]int32 // Raw[row][col]
+}
+
+func F32Mat4FromRows(r0, r1, r2, r3 F32Vec4) F32Mat4 {
+    return F32Mat4{
+        Raw: [4][4]int32{
+            {r0.RawX, r0.RawY, r0.RawZ, r0.RawW},
+            {r1.RawX, r1.RawY, r1.RawZ, r1.RawW},
+            {r2.RawX, r2.RawY, r2.RawZ, r2.RawW},
+            {r3.RawX, r3.RawY, r3.RawZ, r3.RawW},
+        },
+    }
+}
+
+func F32Mat4FromCols(c0, c1, c2, c3 F32Vec4) F32Mat4 {
+    return F32Mat4FromRows(c0, c1, c2, c3).Transpose()
+}
+
+// F32Mat4FromMat3 Creates an affine matrix whose upper-left 3x3 block is m.
+func F32Mat4FromMat3(m F32Mat3) F32Mat4 {
+    r := F32Mat4Identity
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            r.Raw[i][j] = m.Raw[i][j]
+        }
+    }
+    return r
+}
+
+func F32Mat4FromTranslation(t F32Vec3) F32Mat4 {
+    r := F32Mat4Identity
+    r.Raw[0][3] = t.RawX
+    r.Raw[1][3] = t.RawY
+    r.Raw[2][3] = t.RawZ
+    return r
+}
+
+func F32Mat4FromScale(s F32Vec3) F32Mat4 {
+    return F32Mat4FromMat3(F32Mat3FromScale(s))
+}
+
+func F32Mat4FromQuat(q F32Quat) F32Mat4 {
+    return F32Mat4FromMat3(F32Mat3FromQuat(q))
+}
+
+// F32Mat4FromTRS Creates the matrix T * R * S, which scales, then rotates, then translates.
+func F32Mat4FromTRS(t F32Vec3, r F32Quat, s F32Vec3) F32Mat4 {
+    m := F32Mat4FromMat3(F32Mat3FromRotationScale(r, s))
+    m.Raw[0][3] = t.RawX
+    m.Raw[1][3] = t.RawY
+    m.Raw[2][3] = t.RawZ
+    return m
+}
+
+// At Returns the element at row r and column c.
+func (m F32Mat4) At(r, c int) F32 {
+    return F32FromRaw(m.Raw[r][c])
+}
+
+func (m F32Mat4) Row(r int) F32Vec4 {
+    return F32Vec4FromRaw(m.Raw[r][0], m.Raw[r][1], m.Raw[r][2], m.Raw[r][3])
+}
+
+func (m F32Mat4) Col(c int) F32Vec4 {
+    return F32Vec4FromRaw(m.Raw[0][c], m.Raw[1][c], m.Raw[2][c], m.Raw[3][c])
+}
+
+// Mat3 Returns the upper-left 3x3 block.
+func (m F32Mat4) Mat3() F32Mat3 {
+    return F32Mat3FromRaw(
+        m.Raw[0][0], m.Raw[0][1], m.Raw[0][2],
+        m.Raw[1][0], m.Raw[1][1], m.Raw[1][2],
+        m.Raw[2][0], m.Raw[2][1], m.Raw[2][2],
+    )
+}
+
+// Translation Returns the translation stored in the last column.
+func (m F32Mat4) Translation() F32Vec3 {
+    return F32Vec3FromRaw(m.Raw[0][3], m.Raw[1][3], m.Raw[2][3])
+}
+
+// Add m + b
+func (m F32Mat4) Add(b F32Mat4) F32Mat4 {
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            r.Raw[i][j] = m.Raw[i][j] + b.Raw[i][j]
+        }
+    }
+    return r
+}
+
+// Sub m - b
+func (m F32Mat4) Sub(b F32Mat4) F32Mat4 {
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            r.Raw[i][j] = m.Raw[i][j] - b.Raw[i][j]
+        }
+    }
+    return r
+}
+
+// Mul m * b
+func (m F32Mat4) Mul(b F32Mat4) F32Mat4 {
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            r.Raw[i][j] = fix32.Mul(m.Raw[i][0], b.Raw[0][j]) + fix32.Mul(m.Raw[i][1], b.Raw[1][j]) + fix32.Mul(m.Raw[i][2], b.Raw[2][j]) + fix32.Mul(m.Raw[i][3], b.Raw[3][j])
+        }
+    }
+    return r
+}
+
+// MulF32 m * b
+func (m F32Mat4) MulF32(b F32) F32Mat4 {
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            r.Raw[i][j] = fix32.Mul(m.Raw[i][j], b.Raw)
+        }
+    }
+    return r
+}
+
+// MulVec4 m * v
+func (m F32Mat4) MulVec4(v F32Vec4) F32Vec4 {
+    var r [4]int32
+    for i := 0; i < 4; i++ {
+        r[i] = fix32.Mul(m.Raw[i][0], v.RawX) + fix32.Mul(m.Raw[i][1], v.RawY) + fix32.Mul(m.Raw[i][2], v.RawZ) + fix32.Mul(m.Raw[i][3], v.RawW)
+    }
+    return F32Vec4FromRaw(r[0], r[1], r[2], r[3])
+}
+
+// MulVec3 Multiplies v by the upper-left 3x3 block, ignoring translation.
+func (m F32Mat4) MulVec3(v F32Vec3) F32Vec3 {
+    return m.TransformDirection(v)
+}
+
+// TransformPoint Transforms the point p (w = 1). When the bottom row is not (0, 0, 0, 1)
+// the result is divided by the resulting w.
+func (m F32Mat4) TransformPoint(p F32Vec3) F32Vec3 {
+    r := m.MulVec4(F32Vec4FromRaw(p.RawX, p.RawY, p.RawZ, fix32.One))
+    if r.RawW == fix32.One || r.RawW == 0 {
+        return F32Vec3FromRaw(r.RawX, r.RawY, r.RawZ)
+    }
+    return F32Vec3FromRaw(fix32.DivPrecise(r.RawX, r.RawW), fix32.DivPrecise(r.RawY, r.RawW), fix32.DivPrecise(r.RawZ, r.RawW))
+}
+
+// TransformDirection Transforms the direction d (w = 0), so translation is not applied.
+func (m F32Mat4) TransformDirection(d F32Vec3) F32Vec3 {
+    return F32Vec3FromRaw(
+        fix32.Mul(m.Raw[0][0], d.RawX)+fix32.Mul(m.Raw[0][1], d.RawY)+fix32.Mul(m.Raw[0][2], d.RawZ),
+        fix32.Mul(m.Raw[1][0], d.RawX)+fix32.Mul(m.Raw[1][1], d.RawY)+fix32.Mul(m.Raw[1][2], d.RawZ),
+        fix32.Mul(m.Raw[2][0], d.RawX)+fix32.Mul(m.Raw[2][1], d.RawY)+fix32.Mul(m.Raw[2][2], d.RawZ),
+    )
+}
+
+// EQ m == b
+func (m F32Mat4) EQ(b F32Mat4) bool {
+    return m.Raw == b.Raw
+}
+
+// NE m != b
+func (m F32Mat4) NE(b F32Mat4) bool {
+    return m.Raw != b.Raw
+}
+
+func (m F32Mat4) Transpose() F32Mat4 {
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            r.Raw[i][j] = m.Raw[j][i]
+        }
+    }
+    return r
+}
+
+// minors Returns the 2x2 sub-determinants of the top two rows (s) and bottom two rows (c).
+func (m F32Mat4) minors() (s, c [6]int32) {
+    a := &m.Raw
+    s[0] = fix32.Mul(a[0][0], a[1][1]) - fix32.Mul(a[1][0], a[0][1])
+    s[1] = fix32.Mul(a[0][0], a[1][2]) - fix32.Mul(a[1][0], a[0][2])
+    s[2] = fix32.Mul(a[0][0], a[1][3]) - fix32.Mul(a[1][0], a[0][3])
+    s[3] = fix32.Mul(a[0][1], a[1][2]) - fix32.Mul(a[1][1], a[0][2])
+    s[4] = fix32.Mul(a[0][1], a[1][3]) - fix32.Mul(a[1][1], a[0][3])
+    s[5] = fix32.Mul(a[0][2], a[1][3]) - fix32.Mul(a[1][2], a[0][3])
+
+    c[5] = fix32.Mul(a[2][2], a[3][3]) - fix32.Mul(a[3][2], a[2][3])
+    c[4] = fix32.Mul(a[2][1], a[3][3]) - fix32.Mul(a[3][1], a[2][3])
+    c[3] = fix32.Mul(a[2][1], a[3][2]) - fix32.Mul(a[3][1], a[2][2])
+    c[2] = fix32.Mul(a[2][0], a[3][3]) - fix32.Mul(a[3][0], a[2][3])
+    c[1] = fix32.Mul(a[2][0], a[3][2]) - fix32.Mul(a[3][0], a[2][2])
+    c[0] = fix32.Mul(a[2][0], a[3][1]) - fix32.Mul(a[3][0], a[2][1])
+    return s, c
+}
+
+func (m F32Mat4) Determinant() F32 {
+    s, c := m.minors()
+    return F32FromRaw(fix32.Mul(s[0], c[5]) - fix32.Mul(s[1], c[4]) + fix32.Mul(s[2], c[3]) + fix32.Mul(s[3], c[2]) - fix32.Mul(s[4], c[1]) + fix32.Mul(s[5], c[0]))
+}
+
+// Inverse Returns the inverse of m, or ok=false when m is singular or so close to it that an entry of the
+// inverse leaves the F32 range.
+func (m F32Mat4) Inverse() (F32Mat4, bool) {
+    // From: https://www.geometrictools.com/Documentation/LaplaceExpansionTheorem.pdf
+    a := &m.Raw
+    s, c := m.minors()
+
+    det := fix32.Mul(s[0], c[5]) - fix32.Mul(s[1], c[4]) + fix32.Mul(s[2], c[3]) + fix32.Mul(s[3], c[2]) - fix32.Mul(s[4], c[1]) + fix32.Mul(s[5], c[0])
+    if det == 0 {
+        return F32Mat4Zero, false
+    }
+
+    adj := [4][4]int32{
+        {
+            fix32.Mul(a[1][1], c[5]) - fix32.Mul(a[1][2], c[4]) + fix32.Mul(a[1][3], c[3]),
+            -fix32.Mul(a[0][1], c[5]) + fix32.Mul(a[0][2], c[4]) - fix32.Mul(a[0][3], c[3]),
+            fix32.Mul(a[3][1], s[5]) - fix32.Mul(a[3][2], s[4]) + fix32.Mul(a[3][3], s[3]),
+            -fix32.Mul(a[2][1], s[5]) + fix32.Mul(a[2][2], s[4]) - fix32.Mul(a[2][3], s[3]),
+        },
+        {
+            -fix32.Mul(a[1][0], c[5]) + fix32.Mul(a[1][2], c[2]) - fix32.Mul(a[1][3], c[1]),
+            fix32.Mul(a[0][0], c[5]) - fix32.Mul(a[0][2], c[2]) + fix32.Mul(a[0][3], c[1]),
+            -fix32.Mul(a[3][0], s[5]) + fix32.Mul(a[3][2], s[2]) - fix32.Mul(a[3][3], s[1]),
+            fix32.Mul(a[2][0], s[5]) - fix32.Mul(a[2][2], s[2]) + fix32.Mul(a[2][3], s[1]),
+        },
+        {
+            fix32.Mul(a[1][0], c[4]) - fix32.Mul(a[1][1], c[2]) + fix32.Mul(a[1][3], c[0]),
+            -fix32.Mul(a[0][0], c[4]) + fix32.Mul(a[0][1], c[2]) - fix32.Mul(a[0][3], c[0]),
+            fix32.Mul(a[3][0], s[4]) - fix32.Mul(a[3][1], s[2]) + fix32.Mul(a[3][3], s[0]),
+            -fix32.Mul(a[2][0], s[4]) + fix32.Mul(a[2][1], s[2]) - fix32.Mul(a[2][3], s[0]),
+        },
+        {
+            -fix32.Mul(a[1][0], c[3]) + fix32.Mul(a[1][1], c[1]) - fix32.Mul(a[1][2], c[0]),
+            fix32.Mul(a[0][0], c[3]) - fix32.Mul(a[0][1], c[1]) + fix32.Mul(a[0][2], c[0]),
+            -fix32.Mul(a[3][0], s[3]) + fix32.Mul(a[3][1], s[1]) - fix32.Mul(a[3][2], s[0]),
+            fix32.Mul(a[2][0], s[3]) - fix32.Mul(a[2][1], s[1]) + fix32.Mul(a[2][2], s[0]),
+        },
+    }
+
+    var r F32Mat4
+    for i := 0; i < 4; i++ {
+        for j := 0; j < 4; j++ {
+            v, ok := fix32.DivChecked(adj[i][j], det)
+            if !ok {
+                return F32Mat4Zero, false
+            }
+            r.Raw[i][j] = v
+        }
+    }
+    return r, true
+}
+
+// Decompose Splits an affine matrix built by F32Mat4FromTRS back into translation, rotation and scale.
+// Returns ok=false when a scale component is zero and the rotation cannot be recovered.
+func (m F32Mat4) Decompose() (t F32Vec3, r F32Quat, s F32Vec3, ok bool) {
+    t = m.Translation()
+
+    rs := m.Mat3()
+    c0, c1, c2 := rs.Col(0), rs.Col(1), rs.Col(2)
+    s = F32Vec3FromF32(c0.Length(), c1.Length(), c2.Length())
+    if s.RawX == 0 || s.RawY == 0 || s.RawZ == 0 {
+        return t, F32QuatIdentity, s, false
+    }
+
+    // A negative determinant means one axis is mirrored; attribute it to x.
+    if rs.Determinant().Raw < 0 {
+        s.RawX = -s.RawX
+    }
+
+    rot := F32Mat3FromCols(c0.DivPreciseF32(s.X()), c1.DivPreciseF32(s.Y()), c2.DivPreciseF32(s.Z()))
+    return t, rot.Quat(), s, true
+}
+
+func (m F32Mat4) Equals(obj F32Mat4) bool {
+    return m == obj
+}
+
+func (m F32Mat4) ToString() string {
+    return fmt.Sprintf(`(%s, %s, %s, %s)`, m.Row(0).ToString(), m.Row(1).ToString(), m.Row(2).ToString(), m.Row(3).ToString())
+}
@@ -0,0 +1,129 @@
+package fp
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/camry/fp/fix32"
+    "github.com/camry/fp/fix64"
+)
+
+// F32Polygon Simple polygon given by its vertices in order, see F64Polygon. The vertices are widened to
+// F64Vec2 without loss, so all queries share the exact predicates of F64Polygon, and results are rounded
+// back to 16.16 towards negative infinity.
+type F32Polygon []F32Vec2
+
+// F32ConvexHull Returns the convex hull of the points counter-clockwise, see F64ConvexHull.
+func F32ConvexHull(points []F32Vec2) F32Polygon {
+    return f32PolygonFrom(F64ConvexHull(F32Polygon(points).toF64()))
+}
+
+// SignedArea Returns the area enclosed by the polygon, positive when it is counter-clockwise, rounded
+// towards negative infinity and saturated.
+func (p F32Polygon) SignedArea() F32 {
+    return f32Sat(p.toF64().SignedArea())
+}
+
+// Area Returns the absolute area enclosed by the polygon, saturated.
+func (p F32Polygon) Area() F32 {
+    return f32Sat(p.toF64().Area())
+}
+
+// Winding Returns 1 when the polygon is counter-clockwise, -1 when it is clockwise, and 0 when its area is
+// exactly zero.
+func (p F32Polygon) Winding() int32 {
+    return p.toF64().Winding()
+}
+
+// IsConvex Reports whether every turn of the polygon goes the same way, see F64Polygon.IsConvex.
+func (p F32Polygon) IsConvex() bool {
+    return p.toF64().IsConvex()
+}
+
+// Centroid Returns the center of mass of the enclosed area, see F64Polygon.Centroid.
+func (p F32Polygon) Centroid() F32Vec2 {
+    c := p.toF64().Centroid()
+    return F32Vec2FromF32(F32FromF64(c.X()), F32FromF64(c.Y()))
+}
+
+// Contains Reports whether pt is inside the polygon or on its boundary, using the nonzero winding rule.
+func (p F32Polygon) Contains(pt F32Vec2) bool {
+    return p.toF64().Contains(F64Vec2FromF64(F64FromF32(pt.X()), F64FromF32(pt.Y())))
+}
+
+// Reverse Returns the polygon with its vertices in the opposite order, which flips its winding.
+func (p F32Polygon) Reverse() F32Polygon {
+    r := make(F32Polygon, len(p))
+    for i, v := range p {
+        r[len(p)-1-i] = v
+    }
+    return r
+}
+
+// Clip Returns the part of the polygon inside the convex polygon clip, see F64Polygon.Clip.
+func (p F32Polygon) Clip(clip F32Polygon) F32Polygon {
+    return f32PolygonFrom(p.toF64().Clip(clip.toF64()))
+}
+
+// Triangulate Splits the polygon into triangles by ear clipping, see F64Polygon.Triangulate.
+func (p F32Polygon) Triangulate() (tris [][3]int, ok bool) {
+    return p.toF64().Triangulate()
+}
+
+func (p F32Polygon) Equals(obj F32Polygon) bool {
+    if len(p) != len(obj) {
+        return false
+    }
+    for i := range p {
+        if p[i] != obj[i] {
+            return false
+        }
+    }
+    return true
+}
+
+func (p F32Polygon) ToString() string {
+    s := make([]string, len(p))
+    for i, v := range p {
+        s[i] = v.ToString()
+    }
+    return `(` + strings.Join(s, `, `) + `)`
+}
+
+// Format Implements fmt.Formatter, formatting the vertices with the verb, see F32Vec2.Format.
+func (p F32Polygon) Format(s fmt.State, verb rune) {
+    vs := make([]fmt.Formatter, len(p))
+    for i, v := range p {
+        vs[i] = v
+    }
+    formatTuple(s, verb, vs...)
+}
+
+// toF64 Returns the polygon widened to F64.
+func (p F32Polygon) toF64() F64Polygon {
+    if p == nil {
+        return nil
+    }
+    r := make(F64Polygon, len(p))
+    for i, v := range p {
+        r[i] = F64Vec2FromF64(F64FromF32(v.X()), F64FromF32(v.Y()))
+    }
+    return r
+}
+
+// f32PolygonFrom Returns the polygon narrowed to F32, rounding towards negative infinity.
+func f32PolygonFrom(p F64Polygon) F32Polygon {
+    if p == nil {
+        return nil
+    }
+    r := make(F32Polygon, len(p))
+    for i, v := range p {
+        r[i] = F32Vec2FromF32(F32FromF64(v.X()), F32FromF64(v.Y()))
+    }
+    return r
+}
+
+// f32Sat Returns v narrowed to F32, rounding towards negative infinity and saturating.
+func f32Sat(v F64) F32 {
+    return F32FromRaw(int32(fix64.Clamp(v.Raw>>16, int64(fix32.MinValue), int64(fix32.MaxValue))))
+}
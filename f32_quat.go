@@ -0,0 +1,350 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix32"
+)
+
+var F32QuatIdentity = F32QuatFromRaw(fix32.Zero, fix32.Zero, fix32.Zero, fix32.One)
+
+// F32Quat struct with signed 16.16 fixed point components.
+type F32Quat struct {
+    RawX int32
+    RawY int32
+    RawZ int32
+    RawW int32
+}
+
+func F32QuatFromRaw(x, y, z, w int32) F32Quat {
+    return F32Quat{
+        RawX: x,
+        RawY: y,
+        RawZ: z,
+        RawW: w,
+    }
+}
+
+func F32QuatFromF32(x, y, z, w F32) F32Quat {
+    return F32Quat{
+        RawX: x.Raw,
+        RawY: y.Raw,
+        RawZ: z.Raw,
+        RawW: w.Raw,
+    }
+}
+
+func F32QuatFromVector(v F32Vec3, w F32) F32Quat {
+    return F32Quat{
+        RawX: v.RawX,
+        RawY: v.RawY,
+        RawZ: v.RawZ,
+        RawW: w.Raw,
+    }
+}
+
+// F32QuatFromF64Quat Converts a 32.32 quaternion into 16.16, dropping the low 16 fraction bits.
+func F32QuatFromF64Quat(q F64Quat) F32Quat {
+    return F32QuatFromRaw(int32(q.RawX>>16), int32(q.RawY>>16), int32(q.RawZ>>16), int32(q.RawW>>16))
+}
+
+func F32QuatFromAxisAngle(axis F32Vec3, angle F32) F32Quat {
+    halfAngle := angle.Div2()
+    halfAngleSinFastest := halfAngle.SinFastest()
+    return F32QuatFromVector(axis.Mul(F32Vec3FromF32(halfAngleSinFastest, halfAngleSinFastest, halfAngleSinFastest)), halfAngle.CosFastest())
+}
+
+func F32QuatFromYawPitchRoll(yawY, pitchX, rollZ F32) F32Quat {
+    //  Roll first, about axis the object is facing, then
+    //  pitch upward, then yaw to face into the new heading
+    halfRoll := rollZ.Div2()
+    sr := halfRoll.SinFastest()
+    cr := halfRoll.CosFastest()
+
+    halfPitch := pitchX.Div2()
+    sp := halfPitch.SinFastest()
+    cp := halfPitch.CosFastest()
+
+    halfYaw := yawY.Div2()
+    sy := halfYaw.SinFastest()
+    cy := halfYaw.CosFastest()
+
+    return F32QuatFromF32(
+        cy.Mul(sp).Mul(cr).Add(sy.Mul(cp).Mul(sr)),
+        sy.Mul(cp).Mul(cr).Sub(cy.Mul(sp).Mul(sr)),
+        cy.Mul(cp).Mul(sr).Sub(sy.Mul(sp).Mul(cr)),
+        cy.Mul(cp).Mul(cr).Add(sy.Mul(sp).Mul(sr)),
+    )
+}
+
+func F32QuatFromTwoVectors(a, b F32Vec3) F32Quat {
+    // From: http://lolengine.net/blog/2014/02/24/quaternion-from-two-vectors-final
+    // 1e-6 is below the 16.16 resolution, so use the closest usable tolerance instead.
+    epsilon := F32Ratio(1, 10000)
+
+    normANormB := (a.LengthSqr().Mul(b.LengthSqr())).SqrtFastest()
+    realPart := normANormB.Add(a.Dot(b))
+
+    var v F32Vec3
+
+    if realPart.LT(epsilon.Mul(normANormB)) {
+        /* If u and v are exactly opposite, rotate 180 degrees
+         * around an arbitrary orthogonal axis. Axis normalization
+         * can happen later, when we normalize the quaternion. */
+        realPart = F32Zero
+        cond := a.X().Abs().GT(a.Z().Abs())
+        if cond {
+            v = F32Vec3FromF32(a.Y().Negate(), a.X(), F32Zero)
+        } else {
+            v = F32Vec3FromF32(F32Zero, a.Z().Negate(), a.Y())
+        }
+    } else {
+        /* Otherwise, build quaternion the standard way. */
+        v = a.Cross(b)
+    }
+
+    return F32QuatFromVector(v, realPart).NormalizeFastest()
+}
+
+func F32QuatLookRotation(dir, up F32Vec3) F32Quat {
+    // From: https://answers.unity.com/questions/819699/calculate-quaternionlookrotation-manually.html
+    if dir == F32Vec3Zero {
+        return F32QuatIdentity
+    }
+
+    if up != dir {
+        up = up.NormalizeFastest()
+        v := dir.Add(up).MulF32(up.Dot(dir).Negate())
+        q := F32QuatFromTwoVectors(F32Vec3AxisZ, v)
+        return F32QuatFromTwoVectors(v, dir).Mul(q)
+    } else {
+        return F32QuatFromTwoVectors(F32Vec3AxisZ, dir)
+    }
+}
+
+func F32QuatLookAtRotation(from, to, up F32Vec3) F32Quat {
+    dir := (to.Sub(from)).NormalizeFastest()
+    return F32QuatLookRotation(dir, up)
+}
+
+func (q F32Quat) QuatX() F32 {
+    return F32FromRaw(q.RawX)
+}
+
+func (q F32Quat) QuatY() F32 {
+    return F32FromRaw(q.RawY)
+}
+
+func (q F32Quat) QuatZ() F32 {
+    return F32FromRaw(q.RawZ)
+}
+
+func (q F32Quat) QuatW() F32 {
+    return F32FromRaw(q.RawW)
+}
+
+// F64Quat Converts the quaternion into 32.32 without losing precision.
+func (q F32Quat) F64Quat() F64Quat {
+    return QuatFromRaw(int64(q.RawX)<<16, int64(q.RawY)<<16, int64(q.RawZ)<<16, int64(q.RawW)<<16)
+}
+
+func (q F32Quat) Mul(b F32Quat) F32Quat {
+    return q.Multiply(b)
+}
+
+// EQ q == b
+func (q F32Quat) EQ(b F32Quat) bool {
+    return q.RawX == b.RawX && q.RawY == b.RawY && q.RawZ == b.RawZ && q.RawW == b.RawW
+}
+
+// NE q != b
+func (q F32Quat) NE(b F32Quat) bool {
+    return q.RawX != b.RawX || q.RawY != b.RawY || q.RawZ != b.RawZ || q.RawW != b.RawW
+}
+
+// Negate -q.RawX, -q.RawY, -q.RawZ, -q.RawW
+func (q F32Quat) Negate() F32Quat {
+    return F32QuatFromRaw(-q.RawX, -q.RawY, -q.RawZ, -q.RawW)
+}
+
+// Conjugate -q.RawX, -q.RawY, -q.RawZ, q.RawW
+func (q F32Quat) Conjugate() F32Quat {
+    return F32QuatFromRaw(-q.RawX, -q.RawY, -q.RawZ, q.RawW)
+}
+
+func (q F32Quat) Inverse() F32Quat {
+    invNorm := q.LengthSqr().Rcp().Raw
+    return F32QuatFromRaw(
+        -fix32.Mul(q.RawX, invNorm),
+        -fix32.Mul(q.RawY, invNorm),
+        -fix32.Mul(q.RawZ, invNorm),
+        fix32.Mul(q.RawW, invNorm),
+    )
+}
+
+func (q F32Quat) InverseUnit() F32Quat {
+    return F32QuatFromRaw(-q.RawX, -q.RawY, -q.RawZ, q.RawW)
+}
+
+func (q F32Quat) Multiply(b F32Quat) F32Quat {
+    // cross(av, bv)
+    cx := fix32.Mul(q.RawY, b.RawZ) - fix32.Mul(q.RawZ, b.RawY)
+    cy := fix32.Mul(q.RawZ, b.RawX) - fix32.Mul(q.RawX, b.RawZ)
+    cz := fix32.Mul(q.RawX, b.RawY) - fix32.Mul(q.RawY, b.RawX)
+
+    dot := fix32.Mul(q.RawX, b.RawX) + fix32.Mul(q.RawY, b.RawY) + fix32.Mul(q.RawZ, b.RawZ)
+
+    return F32QuatFromRaw(
+        fix32.Mul(q.RawX, b.RawW)+fix32.Mul(b.RawX, q.RawW)+cx,
+        fix32.Mul(q.RawY, b.RawW)+fix32.Mul(b.RawY, q.RawW)+cy,
+        fix32.Mul(q.RawZ, b.RawW)+fix32.Mul(b.RawZ, q.RawW)+cz,
+        fix32.Mul(q.RawW, b.RawW)-dot,
+    )
+}
+
+func (q F32Quat) Length() F32 {
+    return q.LengthSqr().Sqrt()
+}
+
+func (q F32Quat) LengthFast() F32 {
+    return q.LengthSqr().SqrtFast()
+}
+
+func (q F32Quat) LengthFastest() F32 {
+    return q.LengthSqr().SqrtFastest()
+}
+
+func (q F32Quat) LengthSqr() F32 {
+    return F32FromRaw(fix32.Mul(q.RawX, q.RawX) + fix32.Mul(q.RawY, q.RawY) + fix32.Mul(q.RawZ, q.RawZ) + fix32.Mul(q.RawW, q.RawW))
+}
+
+func (q F32Quat) Normalize() F32Quat {
+    invNorm := q.Length().Rcp().Raw
+    return F32QuatFromRaw(
+        fix32.Mul(q.RawX, invNorm),
+        fix32.Mul(q.RawY, invNorm),
+        fix32.Mul(q.RawZ, invNorm),
+        fix32.Mul(q.RawW, invNorm),
+    )
+}
+
+func (q F32Quat) NormalizeFast() F32Quat {
+    invNorm := q.LengthFast().RcpFast().Raw
+    return F32QuatFromRaw(
+        fix32.Mul(q.RawX, invNorm),
+        fix32.Mul(q.RawY, invNorm),
+        fix32.Mul(q.RawZ, invNorm),
+        fix32.Mul(q.RawW, invNorm),
+    )
+}
+
+func (q F32Quat) NormalizeFastest() F32Quat {
+    invNorm := q.LengthFastest().RcpFastest().Raw
+    return F32QuatFromRaw(
+        fix32.Mul(q.RawX, invNorm),
+        fix32.Mul(q.RawY, invNorm),
+        fix32.Mul(q.RawZ, invNorm),
+        fix32.Mul(q.RawW, invNorm),
+    )
+}
+
+func (q F32Quat) Slerp(q2 F32Quat, t F32) F32Quat {
+    const epsilon = fix32.One / 10000
+    cosOmega := fix32.Mul(q.RawX, q2.RawX) + fix32.Mul(q.RawY, q2.RawY) + fix32.Mul(q.RawZ, q2.RawZ) + fix32.Mul(q.RawW, q2.RawW)
+
+    flip := false
+
+    if cosOmega < 0 {
+        flip = true
+        cosOmega = -cosOmega
+    }
+
+    var s1, s2 int32
+    if cosOmega > fix32.One-epsilon {
+        // Too close, do straight linear interpolation.
+        s1 = fix32.One - t.Raw
+        if flip {
+            s2 = -t.Raw
+        } else {
+            s2 = t.Raw
+        }
+    } else {
+        omega := fix32.AcosFastest(cosOmega)
+        invSinOmega := fix32.RcpFastest(fix32.SinFastest(omega))
+
+        s1 = fix32.Mul(fix32.SinFastest(fix32.Mul(fix32.One-t.Raw, omega)), invSinOmega)
+        s2 = fix32.SinFastest(fix32.Mul(t.Raw, omega))
+        if flip {
+            s2 = -s2
+        }
+        s2 = fix32.Mul(s2, invSinOmega)
+    }
+
+    return F32QuatFromRaw(
+        fix32.Mul(s1, q.RawX)+fix32.Mul(s2, q2.RawX),
+        fix32.Mul(s1, q.RawY)+fix32.Mul(s2, q2.RawY),
+        fix32.Mul(s1, q.RawZ)+fix32.Mul(s2, q2.RawZ),
+        fix32.Mul(s1, q.RawW)+fix32.Mul(s2, q2.RawW),
+    )
+}
+
+func (q F32Quat) Lerp(q2 F32Quat, t F32) F32Quat {
+    t1 := fix32.One - t.Raw
+    dot := fix32.Mul(q.RawX, q2.RawX) + fix32.Mul(q.RawY, q2.RawY) + fix32.Mul(q.RawZ, q2.RawZ) + fix32.Mul(q.RawW, q2.RawW)
+
+    var r F32Quat
+    if dot >= 0 {
+        r = F32QuatFromRaw(
+            fix32.Mul(t1, q.RawX)+fix32.Mul(t.Raw, q2.RawX),
+            fix32.Mul(t1, q.RawY)+fix32.Mul(t.Raw, q2.RawY),
+            fix32.Mul(t1, q.RawZ)+fix32.Mul(t.Raw, q2.RawZ),
+            fix32.Mul(t1, q.RawW)+fix32.Mul(t.Raw, q2.RawW),
+        )
+    } else {
+        r = F32QuatFromRaw(
+            fix32.Mul(t1, q.RawX)-fix32.Mul(t.Raw, q2.RawX),
+            fix32.Mul(t1, q.RawY)-fix32.Mul(t.Raw, q2.RawY),
+            fix32.Mul(t1, q.RawZ)-fix32.Mul(t.Raw, q2.RawZ),
+            fix32.Mul(t1, q.RawW)-fix32.Mul(t.Raw, q2.RawW),
+        )
+    }
+
+    return r.NormalizeFastest()
+}
+
+// Concatenate two Quaternions; the result represents the value1 rotation followed by the value2 rotation.
+func (q F32Quat) Concatenate(q2 F32Quat) F32Quat {
+    return q.Mul(q2)
+}
+
+// RotateVector Rotates a vector by the unit quaternion.
+func (q F32Quat) RotateVector(v F32Vec3) F32Vec3 {
+    // From https://gamedev.stackexchange.com/questions/28395/rotating-vector3-by-a-quaternion
+    // 2 dot(u, v) u + (s^2 - dot(u, u)) v + 2 s cross(u, v), with u the vector part and s the scalar part.
+    uv := (fix32.Mul(q.RawX, v.RawX) + fix32.Mul(q.RawY, v.RawY) + fix32.Mul(q.RawZ, v.RawZ)) << 1
+    ss := fix32.Mul(q.RawW, q.RawW) - (fix32.Mul(q.RawX, q.RawX) + fix32.Mul(q.RawY, q.RawY) + fix32.Mul(q.RawZ, q.RawZ))
+    s2 := q.RawW << 1
+
+    cx := fix32.Mul(q.RawY, v.RawZ) - fix32.Mul(q.RawZ, v.RawY)
+    cy := fix32.Mul(q.RawZ, v.RawX) - fix32.Mul(q.RawX, v.RawZ)
+    cz := fix32.Mul(q.RawX, v.RawY) - fix32.Mul(q.RawY, v.RawX)
+
+    return F32Vec3FromRaw(
+        fix32.Mul(q.RawX, uv)+fix32.Mul(v.RawX, ss)+fix32.Mul(s2, cx),
+        fix32.Mul(q.RawY, uv)+fix32.Mul(v.RawY, ss)+fix32.Mul(s2, cy),
+        fix32.Mul(q.RawZ, uv)+fix32.Mul(v.RawZ, ss)+fix32.Mul(s2, cz),
+    )
+}
+
+func (q F32Quat) Equals(obj F32Quat) bool {
+    return q == obj
+}
+
+func (q F32Quat) ToString() string {
+    return fmt.Sprintf(`(%s, %s, %s, %s)`, fix32.ToString(q.RawX), fix32.ToString(q.RawY), fix32.ToString(q.RawZ), fix32.ToString(q.RawW))
+}
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F32.Format.
+func (q F32Quat) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, q.QuatX(), q.QuatY(), q.QuatZ(), q.QuatW())
+}
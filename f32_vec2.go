@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix32"
 )
@@ -122,6 +121,11 @@ func (v F32Vec2) MulF32(b F32) F32Vec2 {
     return F32Vec2FromRaw(fix32.Mul(v.RawX, b.Raw), fix32.Mul(v.RawY, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F32Vec2) Scale(k F32) F32Vec2 {
+    return v.MulF32(k)
+}
+
 // DivPreciseF32 v / b
 func (v F32Vec2) DivPreciseF32(b F32) F32Vec2 {
     return F32Vec2FromRaw(fix32.DivPrecise(v.RawX, b.Raw), fix32.DivPrecise(v.RawY, b.Raw))
@@ -278,6 +282,25 @@ func (v F32Vec2) PowFastest(b F32Vec2) F32Vec2 {
     return F32Vec2FromRaw(fix32.PowFastest(v.RawX, b.RawX), fix32.PowFastest(v.RawY, b.RawY))
 }
 
+// PowInt Each component to the integer power n.
+func (v F32Vec2) PowInt(n int32) F32Vec2 {
+    return F32Vec2FromRaw(fix32.PowInt(v.RawX, n), fix32.PowInt(v.RawY, n))
+}
+
+func (v F32Vec2) Cbrt() F32Vec2 {
+    return F32Vec2FromRaw(fix32.Cbrt(v.RawX), fix32.Cbrt(v.RawY))
+}
+
+// NthRoot The n-th root of each component.
+func (v F32Vec2) NthRoot(n int32) F32Vec2 {
+    return F32Vec2FromRaw(fix32.NthRoot(v.RawX, n), fix32.NthRoot(v.RawY, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix32.Hypot.
+func (v F32Vec2) Hypot() F32 {
+    return F32FromRaw(fix32.Hypot(v.RawX, v.RawY))
+}
+
 func (v F32Vec2) Length() F32 {
     return F32FromRaw(fix32.Sqrt(fix32.Mul(v.RawX, v.RawX) + fix32.Mul(v.RawY, v.RawY)))
 }
@@ -336,9 +359,14 @@ func (v F32Vec2) Lerp(b F32Vec2, t F32) F32Vec2 {
 }
 
 func (v F32Vec2) Equals(obj F32Vec2) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F32Vec2) ToString() string {
     return fmt.Sprintf(`(%s, %s)`, fix32.ToString(v.RawX), fix32.ToString(v.RawY))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F32.Format.
+func (v F32Vec2) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y())
+}
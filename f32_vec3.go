@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix32"
 )
@@ -71,7 +70,7 @@ func F32Vec3Min(v0 F32Vec3, v1 F32Vec3) F32Vec3 {
 //
 // This makes it harder to accidentally call F32Vec3Max with 0 arguments.
 func F32Vec3Max(v0 F32Vec3, v1 F32Vec3) F32Vec3 {
-    return F32Vec3FromRaw(fix32.Max(v0.RawX, v1.RawX), fix32.Max(v0.RawY, v1.RawY), fix32.Min(v0.RawZ, v1.RawZ))
+    return F32Vec3FromRaw(fix32.Max(v0.RawX, v1.RawX), fix32.Max(v0.RawY, v1.RawY), fix32.Max(v0.RawZ, v1.RawZ))
 }
 
 func (v F32Vec3) X() F32 {
@@ -131,6 +130,11 @@ func (v F32Vec3) MulF32(b F32) F32Vec3 {
     return F32Vec3FromRaw(fix32.Mul(v.RawX, b.Raw), fix32.Mul(v.RawY, b.Raw), fix32.Mul(v.RawZ, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F32Vec3) Scale(k F32) F32Vec3 {
+    return v.MulF32(k)
+}
+
 // DivPreciseF32 v / b
 func (v F32Vec3) DivPreciseF32(b F32) F32Vec3 {
     return F32Vec3FromRaw(fix32.DivPrecise(v.RawX, b.Raw), fix32.DivPrecise(v.RawY, b.Raw), fix32.DivPrecise(v.RawZ, b.Raw))
@@ -299,6 +303,25 @@ func (v F32Vec3) PowFastest(b F32Vec3) F32Vec3 {
     return F32Vec3FromRaw(fix32.PowFastest(v.RawX, b.RawX), fix32.PowFastest(v.RawY, b.RawY), fix32.PowFastest(v.RawZ, b.RawZ))
 }
 
+// PowInt Each component to the integer power n.
+func (v F32Vec3) PowInt(n int32) F32Vec3 {
+    return F32Vec3FromRaw(fix32.PowInt(v.RawX, n), fix32.PowInt(v.RawY, n), fix32.PowInt(v.RawZ, n))
+}
+
+func (v F32Vec3) Cbrt() F32Vec3 {
+    return F32Vec3FromRaw(fix32.Cbrt(v.RawX), fix32.Cbrt(v.RawY), fix32.Cbrt(v.RawZ))
+}
+
+// NthRoot The n-th root of each component.
+func (v F32Vec3) NthRoot(n int32) F32Vec3 {
+    return F32Vec3FromRaw(fix32.NthRoot(v.RawX, n), fix32.NthRoot(v.RawY, n), fix32.NthRoot(v.RawZ, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix32.Hypot3.
+func (v F32Vec3) Hypot() F32 {
+    return F32FromRaw(fix32.Hypot3(v.RawX, v.RawY, v.RawZ))
+}
+
 func (v F32Vec3) Length() F32 {
     return F32FromRaw(fix32.Sqrt(fix32.Mul(v.RawX, v.RawX) + fix32.Mul(v.RawY, v.RawY) + fix32.Mul(v.RawZ, v.RawZ)))
 }
@@ -361,9 +384,14 @@ func (v F32Vec3) Cross(b F32Vec3) F32Vec3 {
 }
 
 func (v F32Vec3) Equals(obj F32Vec3) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F32Vec3) ToString() string {
     return fmt.Sprintf(`(%s, %s, %s)`, fix32.ToString(v.RawX), fix32.ToString(v.RawY), fix32.ToString(v.RawZ))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F32.Format.
+func (v F32Vec3) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y(), v.Z())
+}
@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix32"
 )
@@ -132,6 +131,11 @@ func (v F32Vec4) MulF32(b F32) F32Vec4 {
     return F32Vec4FromRaw(fix32.Mul(v.RawX, b.Raw), fix32.Mul(v.RawY, b.Raw), fix32.Mul(v.RawZ, b.Raw), fix32.Mul(v.RawW, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F32Vec4) Scale(k F32) F32Vec4 {
+    return v.MulF32(k)
+}
+
 // DivPreciseF32 v / b
 func (v F32Vec4) DivPreciseF32(b F32) F32Vec4 {
     return F32Vec4FromRaw(fix32.DivPrecise(v.RawX, b.Raw), fix32.DivPrecise(v.RawY, b.Raw), fix32.DivPrecise(v.RawZ, b.Raw), fix32.DivPrecise(v.RawW, b.Raw))
@@ -288,6 +292,25 @@ func (v F32Vec4) PowFastest(b F32Vec4) F32Vec4 {
     return F32Vec4FromRaw(fix32.PowFastest(v.RawX, b.RawX), fix32.PowFastest(v.RawY, b.RawY), fix32.PowFastest(v.RawZ, b.RawZ), fix32.PowFastest(v.RawW, b.RawW))
 }
 
+// PowInt Each component to the integer power n.
+func (v F32Vec4) PowInt(n int32) F32Vec4 {
+    return F32Vec4FromRaw(fix32.PowInt(v.RawX, n), fix32.PowInt(v.RawY, n), fix32.PowInt(v.RawZ, n), fix32.PowInt(v.RawW, n))
+}
+
+func (v F32Vec4) Cbrt() F32Vec4 {
+    return F32Vec4FromRaw(fix32.Cbrt(v.RawX), fix32.Cbrt(v.RawY), fix32.Cbrt(v.RawZ), fix32.Cbrt(v.RawW))
+}
+
+// NthRoot The n-th root of each component.
+func (v F32Vec4) NthRoot(n int32) F32Vec4 {
+    return F32Vec4FromRaw(fix32.NthRoot(v.RawX, n), fix32.NthRoot(v.RawY, n), fix32.NthRoot(v.RawZ, n), fix32.NthRoot(v.RawW, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix32.Hypot4.
+func (v F32Vec4) Hypot() F32 {
+    return F32FromRaw(fix32.Hypot4(v.RawX, v.RawY, v.RawZ, v.RawW))
+}
+
 func (v F32Vec4) Length() F32 {
     return F32FromRaw(fix32.Sqrt(fix32.Mul(v.RawX, v.RawX) + fix32.Mul(v.RawY, v.RawY) + fix32.Mul(v.RawZ, v.RawZ) + fix32.Mul(v.RawW, v.RawW)))
 }
@@ -346,9 +369,14 @@ func (v F32Vec4) Lerp(b F32Vec4, t F32) F32Vec4 {
 }
 
 func (v F32Vec4) Equals(obj F32Vec4) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F32Vec4) ToString() string {
     return fmt.Sprintf(`(%s, %s, %s, %s)`, fix32.ToString(v.RawX), fix32.ToString(v.RawY), fix32.ToString(v.RawZ), fix32.ToString(v.RawW))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F32.Format.
+func (v F32Vec4) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y(), v.Z(), v.W())
+}
@@ -1,6 +1,8 @@
 package fp_test
 
 import (
+    "fmt"
+    "math"
     "testing"
 
     "github.com/stretchr/testify/assert"
@@ -9,6 +11,162 @@ import (
 )
 
 func TestF32_Pow(t *testing.T) {
-    f1 := fp.F32FromInt32(1).Add(fp.F32FromFloat32(0.08)).Pow(fp.F32FromInt32(3))
-    assert.Equal(t, f1.Float32(), float32(1.2595978))
+    // 0.08 is not representable in 16.16, the base is 1 + 5242 / 65536 = 1.0799866, whose cube is
+    // 1.2596650. Integer exponents go through PowInt, which comes within an ulp of it.
+    x := fp.F32FromInt32(1).Add(fp.F32FromFloat32(0.08))
+    f1 := x.Pow(fp.F32FromInt32(3))
+    assert.Equal(t, f1.Float32(), float32(1.2596588))
+    exact := math.Pow(x.Float64(), 3)
+    assert.InDelta(t, exact, f1.Float64(), 1.0/65536)
+}
+
+func TestF32_PowInt(t *testing.T) {
+    x := fp.F32FromInt32(1).Add(fp.F32FromFloat32(0.08))
+    assert.Equal(t, x.Pow(fp.F32FromInt32(3)), x.PowInt(3))
+    assert.Equal(t, fp.F32FromInt32(-27), fp.F32FromInt32(-3).PowInt(3))
+    assert.Equal(t, fp.F32FromInt32(-27), fp.F32FromInt32(-3).Pow(fp.F32FromInt32(3)))
+    assert.Equal(t, fp.F32FromInt32(-3), fp.F32FromInt32(-27).Cbrt())
+    assert.Equal(t, fp.F32FromInt32(-2), fp.F32FromInt32(-32).NthRoot(5))
+    assert.Equal(t, fp.F32FromInt32(5), fp.F32FromInt32(3).Hypot(fp.F32FromInt32(-4)))
+    assert.Equal(t, fp.F32MaxValue, fp.F32FromInt32(30000).Hypot(fp.F32FromInt32(30000)))
+
+    v := fp.F32Vec3FromInt32(-2, 8, 27)
+    assert.Equal(t, fp.F32Vec3FromInt32(4, 64, 729), v.PowInt(2))
+    assert.Equal(t, fp.F32Vec3FromInt32(-8, 512, 19683), v.Pow(fp.F32Vec3FromInt32(3, 3, 3)))
+    assert.Equal(t, fp.F32Vec3FromInt32(-2, 8, 27), v.PowInt(3).Cbrt())
+    assert.Equal(t, fp.F32Vec3FromInt32(-2, 4, 3), fp.F32Vec3FromInt32(-8, 64, 27).Cbrt())
+    assert.Equal(t, fp.F32Vec3FromInt32(0, 2, 0), fp.F32Vec3FromInt32(-4, 4, 0).NthRoot(2))
+    assert.Equal(t, fp.F32FromInt32(13), fp.F32Vec3FromInt32(3, 4, 12).Hypot())
+    assert.Equal(t, fp.F32FromInt32(13), fp.F32Vec2FromInt32(-5, 12).Hypot())
+    assert.Equal(t, fp.F32FromInt32(2), fp.F32Vec4FromInt32(1, -1, 1, -1).Hypot())
+    assert.Equal(t, fp.F32FromInt32(20000), fp.F32Vec3FromInt32(12000, 0, -16000).Hypot())
+}
+
+func TestF32_Hyperbolic(t *testing.T) {
+    x := fp.F32FromFloat64(0.75)
+    sin, cos := x.SinCos()
+    assert.Equal(t, x.Sin(), sin)
+    assert.InDelta(t, math.Cos(0.75), cos.Float64(), 1e-4)
+    assert.InDelta(t, math.Sinh(0.75), x.Sinh().Float64(), 1e-4)
+    assert.InDelta(t, math.Cosh(0.75), x.CoshFast().Float64(), 1e-4)
+    assert.InDelta(t, math.Tanh(0.75), x.TanhFastest().Float64(), 1e-3)
+    assert.InDelta(t, math.Asinh(0.75), x.Asinh().Float64(), 1e-4)
+    assert.InDelta(t, math.Atanh(0.75), x.Atanh().Float64(), 1e-4)
+    assert.InDelta(t, math.Acosh(1.75), x.Add(fp.F32One).Acosh().Float64(), 1e-4)
+    assert.Equal(t, fp.F32Zero, x.Acosh())
+    assert.Equal(t, fp.F32Zero, fp.F32One.Atanh())
+}
+
+func TestF32Quat_RotateVector(t *testing.T) {
+    q := fp.F32QuatFromAxisAngle(fp.F32Vec3AxisZ, fp.F32PiHalf)
+    v := q.RotateVector(fp.F32Vec3AxisX)
+    assert.InDelta(t, v.X().Float64(), 0, 0.01)
+    assert.InDelta(t, v.Y().Float64(), 1, 0.01)
+    assert.InDelta(t, v.Z().Float64(), 0, 0.01)
+}
+
+func TestF32Quat_F64Quat(t *testing.T) {
+    q := fp.F32QuatFromYawPitchRoll(fp.F32FromFloat32(0.3), fp.F32FromFloat32(-1.2), fp.F32FromFloat32(2.5))
+    assert.Equal(t, fp.F32QuatFromF64Quat(q.F64Quat()), q)
+    assert.Equal(t, fp.F64QuatFromF32Quat(q).F32Quat(), q)
+    assert.InDelta(t, q.F64Quat().Length().Float64(), q.Length().Float64(), 0.0001)
+}
+
+func TestF32Mat4_TRS(t *testing.T) {
+    tr := fp.F32Vec3FromFloat64(10, -3.5, 7)
+    rot := fp.F32QuatFromAxisAngle(fp.F32Vec3AxisY, fp.F32FromFloat64(0.9)).Normalize()
+    sc := fp.F32Vec3FromFloat64(2, 0.5, 3)
+    m := fp.F32Mat4FromTRS(tr, rot, sc)
+
+    p := fp.F32Vec3FromFloat64(1, 2, 3)
+    want := rot.RotateVector(p.Mul(sc)).Add(tr)
+    got := m.TransformPoint(p)
+    assert.InDelta(t, got.X().Float64(), want.X().Float64(), 0.001)
+    assert.InDelta(t, got.Y().Float64(), want.Y().Float64(), 0.001)
+    assert.InDelta(t, got.Z().Float64(), want.Z().Float64(), 0.001)
+
+    inv, ok := m.Inverse()
+    assert.True(t, ok)
+    back := inv.TransformPoint(got)
+    assert.InDelta(t, back.X().Float64(), 1, 0.005)
+    assert.InDelta(t, back.Y().Float64(), 2, 0.005)
+    assert.InDelta(t, back.Z().Float64(), 3, 0.005)
+
+    dt, _, ds, ok := m.Decompose()
+    assert.True(t, ok)
+    assert.Equal(t, dt, tr)
+    assert.InDelta(t, ds.Z().Float64(), 3, 0.001)
+
+    _, ok = fp.F32Mat3FromScale(fp.F32Vec3FromInt32(1, 0, 1)).Inverse()
+    assert.False(t, ok)
+
+    // Nearly singular, the inverses leave the F32 range.
+    tiny := fp.F32Vec3FromRaw(fp.F32One.Raw, 1, fp.F32One.Raw)
+    _, ok = fp.F32Mat3FromScale(tiny).Inverse()
+    assert.False(t, ok)
+    _, ok = fp.F32Mat4FromScale(tiny).Inverse()
+    assert.False(t, ok)
+    small, ok := fp.F32Mat4FromScale(fp.F32Vec3FromRaw(fp.F32One.Raw, fp.F32One.Raw>>8, fp.F32One.Raw)).Inverse()
+    assert.True(t, ok)
+    assert.Equal(t, fp.F32FromInt32(256), small.At(1, 1))
+}
+
+func TestF32_Checked(t *testing.T) {
+    _, ok := fp.F32MaxValue.AddChecked(fp.F32One)
+    assert.False(t, ok)
+    r, ok := fp.F32FromInt32(3).MulChecked(fp.F32Half)
+    assert.True(t, ok)
+    assert.Equal(t, r, fp.F32Ratio(3, 2))
+    assert.Equal(t, fp.F32FromInt32(32767).MulSat(fp.F32Two), fp.F32MaxValue)
+    assert.Equal(t, fp.F32One.DivSat(fp.F32Zero), fp.F32MaxValue)
+}
+
+func TestF32_Round(t *testing.T) {
+    ulp := fp.F32FromRaw(1)
+    assert.Equal(t, fp.F32Zero, ulp.MulRound(fp.F32Half, fp.RoundNearestEven))
+    assert.Equal(t, ulp, ulp.MulRound(fp.F32Half, fp.RoundNearestAway))
+    assert.Equal(t, ulp.Negate(), ulp.Negate().MulRound(fp.F32Half, fp.RoundFloor))
+    assert.Equal(t, fp.F32Zero, ulp.Negate().MulRound(fp.F32Half, fp.RoundTowardZero))
+    assert.Equal(t, fp.F32FromRaw(2), fp.F32FromRaw(3).DivRound(fp.F32Two, fp.RoundNearestEven))
+    assert.Equal(t, fp.F32FromRaw(1), fp.F32FromRaw(3).DivRound(fp.F32Two, fp.RoundTowardZero))
+    assert.Equal(t, fp.F32MaxValue, fp.F32One.DivRound(ulp, fp.RoundCeil))
+    assert.Equal(t, "RoundNearestEven", fp.RoundNearestEven.String())
+}
+
+func TestF32FromString(t *testing.T) {
+    f, err := fp.F32FromString("-1.25")
+    assert.NoError(t, err)
+    assert.Equal(t, f, fp.F32Ratio(-5, 4))
+    f, err = fp.F32FromString(fp.F32Pi.ToString())
+    assert.NoError(t, err)
+    assert.Equal(t, f, fp.F32Pi)
+    _, err = fp.F32FromString("40000")
+    assert.Error(t, err)
+}
+
+func TestF32_Format(t *testing.T) {
+    f := fp.F32Ratio(-5, 4)
+    assert.Equal(t, "-1.25", fmt.Sprint(f))
+    assert.Equal(t, "-1.250", fmt.Sprintf("%.3f", f))
+    assert.Equal(t, "-14000", fmt.Sprintf("%x", f))
+    assert.Equal(t, "3.14159", fmt.Sprint(fp.F32Pi))
+    assert.Equal(t, "0x10000", fp.F32One.Text('x', -1))
+    assert.Equal(t, "(0.5, -1, 1.5)", fmt.Sprint(fp.F32Vec3FromInt32(1, -2, 3).DivF32(fp.F32Two)))
+    assert.Equal(t, "(1.0, 2.0)", fmt.Sprintf("%.1f", fp.F32Vec2FromInt32(1, 2)))
+    assert.Equal(t, "(0, 0, 0, 1)", fmt.Sprint(fp.F32QuatIdentity))
+}
+
+func TestF32_Allocs(t *testing.T) {
+    a, b := fp.F32QuatFromYawPitchRoll(fp.F32One, fp.F32Half, fp.F32Two), fp.F32QuatFromYawPitchRoll(fp.F32Pi, fp.F32One, fp.F32Half)
+    v := fp.F32Vec3FromInt32(1, -2, 3)
+    m := fp.F32Mat3FromQuat(a)
+    assert.Zero(t, testing.AllocsPerRun(100, func() {
+        boolSink = fp.F32One.Equals(fp.F32Two) || v.Equals(v.Negate()) || fp.F32Vec2One.Equals(fp.F32Vec2Zero) ||
+            fp.F32Vec4One.Equals(fp.F32Vec4Zero) || a.Equals(b) || m.Equals(fp.F32Mat3Identity) || fp.F32Mat4Identity.Equals(fp.F32Mat4Zero)
+        f32QuatSink = a.Multiply(b).Slerp(b, fp.F32Half).Lerp(a, fp.F32Half)
+        f32Vec3Sink = a.RotateVector(v)
+    }))
+    assert.True(t, a.Equals(a))
+    assert.True(t, m.Equals(m))
+    assert.False(t, boolSink)
 }
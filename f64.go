@@ -1,7 +1,7 @@
 package fp
 
 import (
-    "reflect"
+    "fmt"
 
     "github.com/camry/fp/fix64"
 )
@@ -43,6 +43,12 @@ func F64FromInt64(v int64) F64 {
     return F64FromRaw(fix64.FromInt64(v))
 }
 
+// F64FromString Parses a decimal string such as "1.25" or "-3e-2", or a raw hexadecimal literal, see fix64.Parse.
+func F64FromString(s string) (F64, error) {
+    raw, err := fix64.Parse(s)
+    return F64FromRaw(raw), err
+}
+
 func F64FromFloat32(v float32) F64 {
     return F64FromRaw(fix64.FromFloat32(v))
 }
@@ -188,6 +194,60 @@ func (f F64) Mod(v2 F64) F64 {
     return F64FromRaw(fix64.Mod(f.Raw, v2.Raw))
 }
 
+// AddChecked f + v2, ok is false on overflow
+func (f F64) AddChecked(v2 F64) (F64, bool) {
+    r, ok := fix64.AddChecked(f.Raw, v2.Raw)
+    return F64FromRaw(r), ok
+}
+
+// SubChecked f - v2, ok is false on overflow
+func (f F64) SubChecked(v2 F64) (F64, bool) {
+    r, ok := fix64.SubChecked(f.Raw, v2.Raw)
+    return F64FromRaw(r), ok
+}
+
+// MulChecked f * v2, ok is false on overflow
+func (f F64) MulChecked(v2 F64) (F64, bool) {
+    r, ok := fix64.MulChecked(f.Raw, v2.Raw)
+    return F64FromRaw(r), ok
+}
+
+// DivChecked f / v2, ok is false on overflow or division by zero
+func (f F64) DivChecked(v2 F64) (F64, bool) {
+    r, ok := fix64.DivChecked(f.Raw, v2.Raw)
+    return F64FromRaw(r), ok
+}
+
+// AddSat f + v2, clamped to F64MinValue/F64MaxValue
+func (f F64) AddSat(v2 F64) F64 {
+    return F64FromRaw(fix64.AddSat(f.Raw, v2.Raw))
+}
+
+// SubSat f - v2, clamped to F64MinValue/F64MaxValue
+func (f F64) SubSat(v2 F64) F64 {
+    return F64FromRaw(fix64.SubSat(f.Raw, v2.Raw))
+}
+
+// MulSat f * v2, clamped to F64MinValue/F64MaxValue
+func (f F64) MulSat(v2 F64) F64 {
+    return F64FromRaw(fix64.MulSat(f.Raw, v2.Raw))
+}
+
+// DivSat f / v2, clamped to F64MinValue/F64MaxValue
+func (f F64) DivSat(v2 F64) F64 {
+    return F64FromRaw(fix64.DivSat(f.Raw, v2.Raw))
+}
+
+// MulRound f * v2, rounded according to mode
+func (f F64) MulRound(v2 F64, mode RoundingMode) F64 {
+    return F64FromRaw(fix64.MulRound(f.Raw, v2.Raw, mode))
+}
+
+// DivRound f / v2, rounded according to mode and clamped to F64MinValue/F64MaxValue
+func (f F64) DivRound(v2 F64, mode RoundingMode) F64 {
+    return F64FromRaw(fix64.DivRound(f.Raw, v2.Raw, mode))
+}
+
 // AddVec2 f + v2
 func (f F64) AddVec2(v2 F64Vec2) F64Vec2 {
     return F64Vec2FromRaw(f.Raw+v2.RawX, f.Raw+v2.RawY)
@@ -481,6 +541,22 @@ func (f F64) TanFastest() F64 {
     return F64FromRaw(fix64.TanFastest(f.Raw))
 }
 
+// SinCos The sine and cosine of f from one reduction, see fix64.SinCos.
+func (f F64) SinCos() (F64, F64) {
+    sin, cos := fix64.SinCos(f.Raw)
+    return F64FromRaw(sin), F64FromRaw(cos)
+}
+
+func (f F64) SinCosFast() (F64, F64) {
+    sin, cos := fix64.SinCosFast(f.Raw)
+    return F64FromRaw(sin), F64FromRaw(cos)
+}
+
+func (f F64) SinCosFastest() (F64, F64) {
+    sin, cos := fix64.SinCosFastest(f.Raw)
+    return F64FromRaw(sin), F64FromRaw(cos)
+}
+
 func (f F64) Asin() F64 {
     return F64FromRaw(fix64.Asin(f.Raw))
 }
@@ -529,6 +605,78 @@ func (f F64) Atan2Fastest(x F64) F64 {
     return F64FromRaw(fix64.Atan2Fastest(f.Raw, x.Raw))
 }
 
+func (f F64) Sinh() F64 {
+    return F64FromRaw(fix64.Sinh(f.Raw))
+}
+
+func (f F64) SinhFast() F64 {
+    return F64FromRaw(fix64.SinhFast(f.Raw))
+}
+
+func (f F64) SinhFastest() F64 {
+    return F64FromRaw(fix64.SinhFastest(f.Raw))
+}
+
+func (f F64) Cosh() F64 {
+    return F64FromRaw(fix64.Cosh(f.Raw))
+}
+
+func (f F64) CoshFast() F64 {
+    return F64FromRaw(fix64.CoshFast(f.Raw))
+}
+
+func (f F64) CoshFastest() F64 {
+    return F64FromRaw(fix64.CoshFastest(f.Raw))
+}
+
+func (f F64) Tanh() F64 {
+    return F64FromRaw(fix64.Tanh(f.Raw))
+}
+
+func (f F64) TanhFast() F64 {
+    return F64FromRaw(fix64.TanhFast(f.Raw))
+}
+
+func (f F64) TanhFastest() F64 {
+    return F64FromRaw(fix64.TanhFastest(f.Raw))
+}
+
+func (f F64) Asinh() F64 {
+    return F64FromRaw(fix64.Asinh(f.Raw))
+}
+
+func (f F64) AsinhFast() F64 {
+    return F64FromRaw(fix64.AsinhFast(f.Raw))
+}
+
+func (f F64) AsinhFastest() F64 {
+    return F64FromRaw(fix64.AsinhFastest(f.Raw))
+}
+
+func (f F64) Acosh() F64 {
+    return F64FromRaw(fix64.Acosh(f.Raw))
+}
+
+func (f F64) AcoshFast() F64 {
+    return F64FromRaw(fix64.AcoshFast(f.Raw))
+}
+
+func (f F64) AcoshFastest() F64 {
+    return F64FromRaw(fix64.AcoshFastest(f.Raw))
+}
+
+func (f F64) Atanh() F64 {
+    return F64FromRaw(fix64.Atanh(f.Raw))
+}
+
+func (f F64) AtanhFast() F64 {
+    return F64FromRaw(fix64.AtanhFast(f.Raw))
+}
+
+func (f F64) AtanhFastest() F64 {
+    return F64FromRaw(fix64.AtanhFastest(f.Raw))
+}
+
 func (f F64) Pow(b F64) F64 {
     return F64FromRaw(fix64.Pow(f.Raw, b.Raw))
 }
@@ -541,6 +689,26 @@ func (f F64) PowFastest(b F64) F64 {
     return F64FromRaw(fix64.PowFastest(f.Raw, b.Raw))
 }
 
+// PowInt f to the integer power n, for negative f as well, see fix64.PowInt.
+func (f F64) PowInt(n int32) F64 {
+    return F64FromRaw(fix64.PowInt(f.Raw, n))
+}
+
+// Cbrt The cube root of f, negative for negative f.
+func (f F64) Cbrt() F64 {
+    return F64FromRaw(fix64.Cbrt(f.Raw))
+}
+
+// NthRoot The n-th root of f, see fix64.NthRoot.
+func (f F64) NthRoot(n int32) F64 {
+    return F64FromRaw(fix64.NthRoot(f.Raw, n))
+}
+
+// Hypot sqrt(f^2 + b^2) without overflow in the squares, see fix64.Hypot.
+func (f F64) Hypot(b F64) F64 {
+    return F64FromRaw(fix64.Hypot(f.Raw, b.Raw))
+}
+
 func (f F64) Clamp(min, max F64) F64 {
     return F64FromRaw(fix64.Clamp(f.Raw, min.Raw, max.Raw))
 }
@@ -556,7 +724,7 @@ func (f F64) Lerp(b, t F64) F64 {
 }
 
 func (f F64) Equals(obj F64) bool {
-    return reflect.DeepEqual(f, obj)
+    return f == obj
 }
 
 func (f F64) CompareTo(other F64) int32 {
@@ -572,3 +740,14 @@ func (f F64) CompareTo(other F64) int32 {
 func (f F64) ToString() string {
     return fix64.ToString(f.Raw)
 }
+
+// Text Converts f to a string in the given format, 'f' with prec fraction digits (shortest when prec < 0)
+// or 'x' for the raw hexadecimal value, see fix64.Format.
+func (f F64) Text(format byte, prec int) string {
+    return fix64.Format(f.Raw, format, prec)
+}
+
+// Format Implements fmt.Formatter, supporting %v, %s, %f and %x.
+func (f F64) Format(s fmt.State, verb rune) {
+    formatFixed(s, verb, int64(f.Raw), uint(fix64.Shift), "fp.F64")
+}
@@ -0,0 +1,139 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64AABB2Empty The empty rectangle, which is the identity of Union.
+var F64AABB2Empty = F64AABB2{
+    Min: F64Vec2FromRaw(fix64.MaxValue, fix64.MaxValue),
+    Max: F64Vec2FromRaw(fix64.MinValue, fix64.MinValue),
+}
+
+// F64AABB2 Axis-aligned bounding rectangle with inclusive bounds. A rectangle with a Min component greater
+// than the matching Max component is empty.
+//
+// Sizes and areas saturate at F64MaxValue, while containment, overlap and closest point queries are
+// exact for any coordinates.
+type F64AABB2 struct {
+    Min F64Vec2
+    Max F64Vec2
+}
+
+func F64AABB2FromMinMax(min, max F64Vec2) F64AABB2 {
+    return F64AABB2{Min: min, Max: max}
+}
+
+// F64AABB2FromPoints Creates the smallest rectangle containing the points, or F64AABB2Empty for none.
+func F64AABB2FromPoints(points ...F64Vec2) F64AABB2 {
+    b := F64AABB2Empty
+    for _, p := range points {
+        b = b.ExpandToInclude(p)
+    }
+    return b
+}
+
+// F64AABB2FromCenterExtents Creates the rectangle center - extents to center + extents, clamped to the F64 range.
+func F64AABB2FromCenterExtents(center, extents F64Vec2) F64AABB2 {
+    return F64AABB2{
+        Min: F64Vec2FromRaw(fix64.SubSat(center.RawX, extents.RawX), fix64.SubSat(center.RawY, extents.RawY)),
+        Max: F64Vec2FromRaw(fix64.AddSat(center.RawX, extents.RawX), fix64.AddSat(center.RawY, extents.RawY)),
+    }
+}
+
+// IsEmpty Reports whether the rectangle contains no points.
+func (b F64AABB2) IsEmpty() bool {
+    return b.Min.RawX > b.Max.RawX || b.Min.RawY > b.Max.RawY
+}
+
+// Center Returns the point halfway between Min and Max.
+func (b F64AABB2) Center() F64Vec2 {
+    return F64Vec2FromRaw(midpoint(b.Min.RawX, b.Max.RawX), midpoint(b.Min.RawY, b.Max.RawY))
+}
+
+// Extents Returns half the size of the rectangle, which never overflows.
+func (b F64AABB2) Extents() F64Vec2 {
+    return F64Vec2FromRaw(halfExtent(b.Min.RawX, b.Max.RawX), halfExtent(b.Min.RawY, b.Max.RawY))
+}
+
+// Size Returns Max - Min, saturated, or zero for an empty rectangle.
+func (b F64AABB2) Size() F64Vec2 {
+    if b.IsEmpty() {
+        return F64Vec2Zero
+    }
+    return F64Vec2FromRaw(fix64.SubSat(b.Max.RawX, b.Min.RawX), fix64.SubSat(b.Max.RawY, b.Min.RawY))
+}
+
+// Area Returns the area, saturated.
+func (b F64AABB2) Area() F64 {
+    s := b.Size()
+    return s.X().MulSat(s.Y())
+}
+
+// Perimeter Returns the length of the boundary, saturated.
+func (b F64AABB2) Perimeter() F64 {
+    s := b.Size()
+    return s.X().AddSat(s.Y()).MulSat(F64Two)
+}
+
+// Union Returns the smallest rectangle containing both rectangles.
+func (b F64AABB2) Union(b2 F64AABB2) F64AABB2 {
+    return F64AABB2{Min: F64Vec2Min(b.Min, b2.Min), Max: F64Vec2Max(b.Max, b2.Max)}
+}
+
+// Intersection Returns the rectangle shared by both rectangles, which is empty if they don't overlap.
+func (b F64AABB2) Intersection(b2 F64AABB2) F64AABB2 {
+    return F64AABB2{Min: F64Vec2Max(b.Min, b2.Min), Max: F64Vec2Min(b.Max, b2.Max)}
+}
+
+// ExpandToInclude Returns the smallest rectangle containing b and p.
+func (b F64AABB2) ExpandToInclude(p F64Vec2) F64AABB2 {
+    return F64AABB2{Min: F64Vec2Min(b.Min, p), Max: F64Vec2Max(b.Max, p)}
+}
+
+// Expand Moves every edge outward by margin, or inward for a negative margin, clamped to the F64 range.
+// Expanding an empty rectangle leaves it empty.
+func (b F64AABB2) Expand(margin F64) F64AABB2 {
+    if b.IsEmpty() {
+        return b
+    }
+    return F64AABB2FromMinMax(
+        F64Vec2FromRaw(fix64.SubSat(b.Min.RawX, margin.Raw), fix64.SubSat(b.Min.RawY, margin.Raw)),
+        F64Vec2FromRaw(fix64.AddSat(b.Max.RawX, margin.Raw), fix64.AddSat(b.Max.RawY, margin.Raw)),
+    )
+}
+
+// Contains Reports whether p is inside the rectangle or on its boundary.
+func (b F64AABB2) Contains(p F64Vec2) bool {
+    return b.Min.RawX <= p.RawX && p.RawX <= b.Max.RawX && b.Min.RawY <= p.RawY && p.RawY <= b.Max.RawY
+}
+
+// ContainsAABB Reports whether b2 lies entirely inside the rectangle. Every rectangle contains the empty one.
+func (b F64AABB2) ContainsAABB(b2 F64AABB2) bool {
+    return b2.IsEmpty() || (b.Contains(b2.Min) && b.Contains(b2.Max))
+}
+
+// Overlaps Reports whether the rectangles share at least one point, touching boundaries included.
+func (b F64AABB2) Overlaps(b2 F64AABB2) bool {
+    return !b.Intersection(b2).IsEmpty()
+}
+
+// ClosestPoint Returns the point of the rectangle nearest to p, which is p itself when it is inside.
+func (b F64AABB2) ClosestPoint(p F64Vec2) F64Vec2 {
+    return F64Vec2FromRaw(fix64.Clamp(p.RawX, b.Min.RawX, b.Max.RawX), fix64.Clamp(p.RawY, b.Min.RawY, b.Max.RawY))
+}
+
+func (b F64AABB2) Equals(obj F64AABB2) bool {
+    return b == obj
+}
+
+func (b F64AABB2) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, b.Min.ToString(), b.Max.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting Min and Max with the verb, see F64Vec2.Format.
+func (b F64AABB2) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, b.Min, b.Max)
+}
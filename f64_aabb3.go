@@ -0,0 +1,155 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64AABB3Empty The empty box, which is the identity of Union.
+var F64AABB3Empty = F64AABB3{
+    Min: F64Vec3FromRaw(fix64.MaxValue, fix64.MaxValue, fix64.MaxValue),
+    Max: F64Vec3FromRaw(fix64.MinValue, fix64.MinValue, fix64.MinValue),
+}
+
+// F64AABB3 Axis-aligned bounding box with inclusive bounds. A box with a Min component greater than the
+// matching Max component is empty.
+//
+// Sizes, areas and volumes saturate at F64MaxValue, while containment, overlap and closest point queries
+// are exact for any coordinates.
+type F64AABB3 struct {
+    Min F64Vec3
+    Max F64Vec3
+}
+
+func F64AABB3FromMinMax(min, max F64Vec3) F64AABB3 {
+    return F64AABB3{Min: min, Max: max}
+}
+
+// F64AABB3FromPoints Creates the smallest box containing the points, or F64AABB3Empty for none.
+func F64AABB3FromPoints(points ...F64Vec3) F64AABB3 {
+    b := F64AABB3Empty
+    for _, p := range points {
+        b = b.ExpandToInclude(p)
+    }
+    return b
+}
+
+// F64AABB3FromCenterExtents Creates the box center - extents to center + extents, clamped to the F64 range.
+func F64AABB3FromCenterExtents(center, extents F64Vec3) F64AABB3 {
+    return F64AABB3{
+        Min: F64Vec3FromRaw(fix64.SubSat(center.RawX, extents.RawX), fix64.SubSat(center.RawY, extents.RawY), fix64.SubSat(center.RawZ, extents.RawZ)),
+        Max: F64Vec3FromRaw(fix64.AddSat(center.RawX, extents.RawX), fix64.AddSat(center.RawY, extents.RawY), fix64.AddSat(center.RawZ, extents.RawZ)),
+    }
+}
+
+// IsEmpty Reports whether the box contains no points.
+func (b F64AABB3) IsEmpty() bool {
+    return b.Min.RawX > b.Max.RawX || b.Min.RawY > b.Max.RawY || b.Min.RawZ > b.Max.RawZ
+}
+
+// Center Returns the point halfway between Min and Max.
+func (b F64AABB3) Center() F64Vec3 {
+    return F64Vec3FromRaw(midpoint(b.Min.RawX, b.Max.RawX), midpoint(b.Min.RawY, b.Max.RawY), midpoint(b.Min.RawZ, b.Max.RawZ))
+}
+
+// Extents Returns half the size of the box, which never overflows.
+func (b F64AABB3) Extents() F64Vec3 {
+    return F64Vec3FromRaw(halfExtent(b.Min.RawX, b.Max.RawX), halfExtent(b.Min.RawY, b.Max.RawY), halfExtent(b.Min.RawZ, b.Max.RawZ))
+}
+
+// Size Returns Max - Min, saturated, or zero for an empty box.
+func (b F64AABB3) Size() F64Vec3 {
+    if b.IsEmpty() {
+        return F64Vec3Zero
+    }
+    return F64Vec3FromRaw(fix64.SubSat(b.Max.RawX, b.Min.RawX), fix64.SubSat(b.Max.RawY, b.Min.RawY), fix64.SubSat(b.Max.RawZ, b.Min.RawZ))
+}
+
+// SurfaceArea Returns the total area of the six faces, saturated.
+func (b F64AABB3) SurfaceArea() F64 {
+    s := b.Size()
+    x, y, z := s.X(), s.Y(), s.Z()
+    return x.MulSat(y).AddSat(y.MulSat(z)).AddSat(z.MulSat(x)).MulSat(F64Two)
+}
+
+// Volume Returns the volume, saturated.
+func (b F64AABB3) Volume() F64 {
+    s := b.Size()
+    return s.X().MulSat(s.Y()).MulSat(s.Z())
+}
+
+// Union Returns the smallest box containing both boxes.
+func (b F64AABB3) Union(b2 F64AABB3) F64AABB3 {
+    return F64AABB3{Min: F64Vec3Min(b.Min, b2.Min), Max: F64Vec3Max(b.Max, b2.Max)}
+}
+
+// Intersection Returns the box shared by both boxes, which is empty if they don't overlap.
+func (b F64AABB3) Intersection(b2 F64AABB3) F64AABB3 {
+    return F64AABB3{Min: F64Vec3Max(b.Min, b2.Min), Max: F64Vec3Min(b.Max, b2.Max)}
+}
+
+// ExpandToInclude Returns the smallest box containing b and p.
+func (b F64AABB3) ExpandToInclude(p F64Vec3) F64AABB3 {
+    return F64AABB3{Min: F64Vec3Min(b.Min, p), Max: F64Vec3Max(b.Max, p)}
+}
+
+// Expand Moves every face outward by margin, or inward for a negative margin, clamped to the F64 range.
+// Expanding an empty box leaves it empty.
+func (b F64AABB3) Expand(margin F64) F64AABB3 {
+    if b.IsEmpty() {
+        return b
+    }
+    return F64AABB3FromMinMax(
+        F64Vec3FromRaw(fix64.SubSat(b.Min.RawX, margin.Raw), fix64.SubSat(b.Min.RawY, margin.Raw), fix64.SubSat(b.Min.RawZ, margin.Raw)),
+        F64Vec3FromRaw(fix64.AddSat(b.Max.RawX, margin.Raw), fix64.AddSat(b.Max.RawY, margin.Raw), fix64.AddSat(b.Max.RawZ, margin.Raw)),
+    )
+}
+
+// Contains Reports whether p is inside the box or on its boundary.
+func (b F64AABB3) Contains(p F64Vec3) bool {
+    return b.Min.RawX <= p.RawX && p.RawX <= b.Max.RawX &&
+        b.Min.RawY <= p.RawY && p.RawY <= b.Max.RawY &&
+        b.Min.RawZ <= p.RawZ && p.RawZ <= b.Max.RawZ
+}
+
+// ContainsAABB Reports whether b2 lies entirely inside the box. Every box contains the empty box.
+func (b F64AABB3) ContainsAABB(b2 F64AABB3) bool {
+    return b2.IsEmpty() || (b.Contains(b2.Min) && b.Contains(b2.Max))
+}
+
+// Overlaps Reports whether the boxes share at least one point, touching boundaries included.
+func (b F64AABB3) Overlaps(b2 F64AABB3) bool {
+    return !b.Intersection(b2).IsEmpty()
+}
+
+// OverlapsSphere Reports whether the box and the sphere share at least one point.
+func (b F64AABB3) OverlapsSphere(s F64Sphere) bool {
+    return s.OverlapsAABB(b)
+}
+
+// ClosestPoint Returns the point of the box nearest to p, which is p itself when it is inside.
+func (b F64AABB3) ClosestPoint(p F64Vec3) F64Vec3 {
+    return F64Vec3FromRaw(fix64.Clamp(p.RawX, b.Min.RawX, b.Max.RawX), fix64.Clamp(p.RawY, b.Min.RawY, b.Max.RawY), fix64.Clamp(p.RawZ, b.Min.RawZ, b.Max.RawZ))
+}
+
+func (b F64AABB3) Equals(obj F64AABB3) bool {
+    return b == obj
+}
+
+func (b F64AABB3) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, b.Min.ToString(), b.Max.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting Min and Max with the verb, see F64Vec3.Format.
+func (b F64AABB3) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, b.Min, b.Max)
+}
+
+// halfExtent Returns half of max - min, or zero when min > max.
+func halfExtent(min, max int64) int64 {
+    if min > max {
+        return 0
+    }
+    return int64((uint64(max) - uint64(min)) >> 1)
+}
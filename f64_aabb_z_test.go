@@ -0,0 +1,163 @@
+package fp_test
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/rand"
+)
+
+func TestF64Vec3_MinMax(t *testing.T) {
+    a, b := fp.F64Vec3FromInt32(1, 5, -3), fp.F64Vec3FromInt32(2, -1, 4)
+    assert.Equal(t, fp.F64Vec3FromInt32(1, -1, -3), fp.F64Vec3Min(a, b))
+    assert.Equal(t, fp.F64Vec3FromInt32(2, 5, 4), fp.F64Vec3Max(a, b))
+    c, d := fp.F32Vec3FromInt32(1, 5, -3), fp.F32Vec3FromInt32(2, -1, 4)
+    assert.Equal(t, fp.F32Vec3FromInt32(1, -1, -3), fp.F32Vec3Min(c, d))
+    assert.Equal(t, fp.F32Vec3FromInt32(2, 5, 4), fp.F32Vec3Max(c, d))
+
+    // Z took the minimum before the bounding volumes were added, in either argument order.
+    assert.Equal(t, fp.F64Vec3Max(a, b), fp.F64Vec3Max(b, a))
+    assert.Equal(t, fp.F32Vec3Max(c, d), fp.F32Vec3Max(d, c))
+}
+
+func TestF64AABB3(t *testing.T) {
+    b := fp.F64AABB3FromPoints(fp.F64Vec3FromInt32(1, 2, 3), fp.F64Vec3FromInt32(-1, 4, 0), fp.F64Vec3FromInt32(0, 3, 5))
+    assert.Equal(t, fp.F64AABB3FromMinMax(fp.F64Vec3FromInt32(-1, 2, 0), fp.F64Vec3FromInt32(1, 4, 5)), b)
+    assert.False(t, b.IsEmpty())
+    assert.True(t, fp.F64AABB3FromPoints().IsEmpty())
+    assert.Equal(t, "(0, 3, 2.5) (1, 1, 2.5)", fmt.Sprint(b.Center(), " ", b.Extents()))
+    assert.Equal(t, fp.F64Vec3FromInt32(2, 2, 5), b.Size())
+    assert.Equal(t, fp.F64FromInt32(48), b.SurfaceArea())
+    assert.Equal(t, fp.F64FromInt32(20), b.Volume())
+    assert.Equal(t, fp.F64Zero, fp.F64AABB3Empty.Volume())
+
+    // Union with the empty box is the identity, intersection of disjoint boxes is empty.
+    assert.Equal(t, b, b.Union(fp.F64AABB3Empty))
+    c := fp.F64AABB3FromCenterExtents(fp.F64Vec3FromInt32(2, 3, 3), fp.F64Vec3FromInt32(1, 1, 1))
+    assert.Equal(t, "((1, 2, 2), (1, 4, 4))", b.Intersection(c).ToString())
+    assert.True(t, b.Overlaps(c))
+    assert.True(t, b.Overlaps(fp.F64AABB3FromPoints(fp.F64Vec3FromInt32(1, 4, 5), fp.F64Vec3FromInt32(9, 9, 9)))) // touching corner
+    assert.False(t, b.Overlaps(fp.F64AABB3FromPoints(fp.F64Vec3FromInt32(2, 0, 0))))
+    assert.False(t, b.Overlaps(fp.F64AABB3Empty))
+    assert.True(t, b.Union(c).ContainsAABB(c))
+    assert.True(t, b.ContainsAABB(fp.F64AABB3Empty))
+    assert.False(t, b.ContainsAABB(c))
+
+    assert.True(t, b.Contains(fp.F64Vec3FromInt32(1, 2, 5)))
+    assert.False(t, b.Contains(fp.F64Vec3FromInt32(1, 2, 6)))
+    assert.Equal(t, fp.F64Vec3FromInt32(1, 2, 3), b.ClosestPoint(fp.F64Vec3FromInt32(7, -7, 3)))
+    assert.Equal(t, "((-1.5, 1.5, -0.5), (1.5, 4.5, 5.5))", b.Expand(fp.F64Half).ToString())
+    assert.True(t, b.Expand(fp.F64FromInt32(-2)).IsEmpty())
+    assert.True(t, fp.F64AABB3Empty.Expand(fp.F64One).IsEmpty())
+}
+
+func TestF64AABB3_Large(t *testing.T) {
+    // Boxes spanning the whole range: sizes saturate, queries stay exact.
+    world := fp.F64AABB3FromMinMax(fp.F64Vec3FromRaw(fp.F64MinValue.Raw, fp.F64MinValue.Raw, fp.F64MinValue.Raw), fp.F64Vec3FromRaw(fp.F64MaxValue.Raw, fp.F64MaxValue.Raw, fp.F64MaxValue.Raw))
+    assert.Equal(t, fp.F64MaxValue, world.Size().X())
+    assert.Equal(t, fp.F64MaxValue, world.Volume())
+    assert.Equal(t, fp.F64MaxValue, world.SurfaceArea())
+    assert.Equal(t, fp.F64Vec3FromRaw(-1, -1, -1), world.Center())
+    assert.Equal(t, fp.F64MaxValue, world.Extents().X())
+    assert.Equal(t, world, world.Expand(fp.F64One))
+
+    far := fp.F64Vec3FromInt32(2000000000, -2000000000, 2000000000)
+    s := fp.F64SphereFromCenterRadius(far, fp.F64FromInt32(10))
+    assert.True(t, world.OverlapsSphere(s))
+    assert.False(t, fp.F64AABB3FromPoints(fp.F64Vec3Zero).OverlapsSphere(s))
+    assert.Equal(t, far.X().Sub(fp.F64FromInt32(10)), s.AABB().Min.X())
+}
+
+func TestF64AABB2(t *testing.T) {
+    b := fp.F64AABB2FromPoints(fp.F64Vec2FromInt32(1, 2), fp.F64Vec2FromInt32(-3, 4))
+    assert.Equal(t, "((-3, 2), (1, 4))", b.ToString())
+    assert.Equal(t, fp.F64FromInt32(8), b.Area())
+    assert.Equal(t, fp.F64FromInt32(12), b.Perimeter())
+    assert.Equal(t, fp.F64Vec2FromInt32(-1, 3), b.Center())
+    assert.True(t, b.Contains(fp.F64Vec2FromInt32(-3, 3)))
+    assert.Equal(t, fp.F64Vec2FromInt32(1, 4), b.ClosestPoint(fp.F64Vec2FromInt32(5, 5)))
+    c := fp.F64AABB2FromCenterExtents(fp.F64Vec2FromInt32(2, 3), fp.F64Vec2FromInt32(1, 1))
+    assert.True(t, b.Overlaps(c))
+    assert.Equal(t, "((1, 2), (1, 4))", b.Intersection(c).ToString())
+    assert.Equal(t, "((-3, 2), (3, 4))", b.Union(c).ToString())
+    assert.False(t, b.Overlaps(c.Expand(fp.F64Half.Negate()).Expand(fp.F64FromRaw(-1))))
+    assert.True(t, b.ContainsAABB(fp.F64AABB2Empty))
+    assert.Equal(t, fp.F64Zero, fp.F64AABB2Empty.Area())
+}
+
+func TestF64Sphere(t *testing.T) {
+    s := fp.F64SphereFromCenterRadius(fp.F64Vec3Zero, fp.F64FromInt32(2))
+    assert.True(t, s.Contains(fp.F64Vec3FromInt32(0, 2, 0)))
+    assert.False(t, s.Contains(fp.F64Vec3FromInt32(2, 1, 0)))
+    assert.True(t, s.Overlaps(fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(3, 0, 0), fp.F64One))) // touching
+    assert.False(t, s.Overlaps(fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(3, 1, 0), fp.F64One)))
+    assert.True(t, s.ContainsSphere(fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(1, 0, 0), fp.F64One)))
+    assert.False(t, s.ContainsSphere(fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(1, 1, 0), fp.F64One)))
+    assert.Equal(t, fp.F64Vec3FromInt32(0, 0, -2), s.ClosestPoint(fp.F64Vec3FromInt32(0, 0, -5)))
+    assert.Equal(t, fp.F64FromInt32(3), s.Expand(fp.F64One).Radius)
+    assert.Equal(t, fp.F64Zero, s.Expand(fp.F64FromInt32(-3)).Radius)
+    assert.InDelta(t, 16*3.14159265, s.SurfaceArea().Float64(), 1e-6)
+    assert.InDelta(t, 32.0/3*3.14159265, s.Volume().Float64(), 1e-6)
+    assert.Equal(t, "((0, 0, 0), 2)", fmt.Sprint(s))
+
+    box := fp.F64AABB3FromPoints(fp.F64Vec3FromInt32(2, 2, 0), fp.F64Vec3FromInt32(3, 3, 1))
+    assert.False(t, s.OverlapsAABB(box)) // the corner is sqrt(8) away
+    assert.True(t, s.Expand(fp.F64One).OverlapsAABB(box))
+
+    u := s.Union(fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(4, 0, 0), fp.F64One))
+    assert.InDelta(t, 1.5, u.Center.X().Float64(), 1e-9)
+    assert.InDelta(t, 3.5, u.Radius.Float64(), 1e-9)
+
+    p := fp.F64SphereFromPoints(fp.F64Vec3FromInt32(1, 0, 0), fp.F64Vec3FromInt32(-1, 0, 0), fp.F64Vec3FromInt32(0, 1, 0))
+    assert.Equal(t, fp.F64Vec3FromRaw(0, fp.F64Half.Raw, 0), p.Center)
+    assert.InDelta(t, 1.118033989, p.Radius.Float64(), 1e-9)
+    assert.Equal(t, fp.F64Sphere{}, fp.F64SphereFromPoints())
+}
+
+func TestF64Sphere_Large(t *testing.T) {
+    // Distances far beyond the F64 range compare exactly.
+    a := fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(-2000000000, 0, 0), fp.F64FromInt32(2000000000))
+    b := fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(2000000000, 0, 0), fp.F64FromInt32(2000000000))
+    assert.True(t, a.Overlaps(b))
+    b.Center.RawY = 1
+    assert.False(t, a.Overlaps(b))
+    assert.True(t, a.Contains(fp.F64Vec3FromInt32(0, 0, 0)))
+    assert.False(t, a.Contains(fp.F64Vec3FromInt32(1, 0, 0).Add(fp.F64Vec3FromRaw(1, 0, 0))))
+
+    c := fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(1000000000, 1000000000, 1000000000), fp.F64FromInt32(1))
+    d := fp.F64SphereFromCenterRadius(fp.F64Vec3FromInt32(-1000000000, -1000000000, -1000000000), fp.F64FromInt32(1))
+    u := c.Union(d)
+    assert.True(t, u.ContainsSphere(c))
+    assert.True(t, u.ContainsSphere(d))
+    assert.InDelta(t, 1732050808.7, u.Radius.Float64(), 1)
+}
+
+func TestF64Sphere_Random(t *testing.T) {
+    r := rand.New(14)
+    scale := fp.F64FromInt32(1000)
+    for i := 0; i < 300; i++ {
+        points := make([]fp.F64Vec3, 1+r.Intn(8))
+        for j := range points {
+            points[j] = r.F64Vec3InBall().MulF64(scale)
+        }
+        s := fp.F64SphereFromPoints(points...)
+        box := fp.F64AABB3FromPoints(points...)
+        for _, p := range points {
+            assert.True(t, s.Contains(p))
+            assert.True(t, box.Contains(p))
+            assert.True(t, s.OverlapsAABB(box))
+        }
+        s2 := fp.F64SphereFromCenterRadius(r.F64Vec3InBall().MulF64(scale), r.F64().Mul(scale))
+        u := s.Union(s2)
+        assert.True(t, u.ContainsSphere(s), "%v %v %v", s, s2, u)
+        assert.True(t, u.ContainsSphere(s2), "%v %v %v", s, s2, u)
+
+        q := r.F64Vec3InBall().MulF64(scale.Mul(fp.F64Two))
+        c := s.ClosestPoint(q)
+        assert.True(t, s.Contains(c))
+        assert.Equal(t, s.Contains(q), c == q)
+    }
+}
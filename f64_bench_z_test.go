@@ -0,0 +1,313 @@
+package fp_test
+
+import (
+    "testing"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+)
+
+type (
+    f64Op    = benchOp[fp.F64, fp.F64]
+    f64BinOp = benchOp[pair[fp.F64, fp.F64], fp.F64]
+    fix64Op  = benchOp[int64, int64]
+)
+
+var (
+    f64Sink     fp.F64
+    f64Vec2Sink fp.F64Vec2
+    f64Vec3Sink fp.F64Vec3
+    f64Vec4Sink fp.F64Vec4
+    f64QuatSink fp.F64Quat
+)
+
+// f64Spread Returns benchInputs numbers over [lo, hi), see spread.
+func f64Spread(lo, hi float64) []fp.F64 {
+    return mapped(spread(lo, hi), fp.F64FromFloat64)
+}
+
+func f64Raw(f fp.F64) int64 {
+    return f.Raw
+}
+
+func f64RawPair(p pair[fp.F64, fp.F64]) pair[int64, int64] {
+    return pair[int64, int64]{p.a.Raw, p.b.Raw}
+}
+
+func BenchmarkF64(b *testing.B) {
+    pos, wide, unit := f64Spread(0.25, 16), f64Spread(-8, 8), f64Spread(-0.99, 0.99)
+    div, pow := pairs(wide, pos), pairs(pos, f64Spread(-2, 2))
+    b.Run("Positive", func(b *testing.B) {
+        benchOps(b, pos, &f64Sink, []f64Op{
+            {"SqrtPrecise", fp.F64.SqrtPrecise}, {"Sqrt", fp.F64.Sqrt}, {"SqrtFast", fp.F64.SqrtFast}, {"SqrtFastest", fp.F64.SqrtFastest},
+            {"RSqrt", fp.F64.RSqrt}, {"RSqrtFast", fp.F64.RSqrtFast}, {"RSqrtFastest", fp.F64.RSqrtFastest},
+            {"Rcp", fp.F64.Rcp}, {"RcpFast", fp.F64.RcpFast}, {"RcpFastest", fp.F64.RcpFastest},
+            {"Log", fp.F64.Log}, {"LogFast", fp.F64.LogFast}, {"LogFastest", fp.F64.LogFastest},
+            {"Log2", fp.F64.Log2}, {"Log2Fast", fp.F64.Log2Fast}, {"Log2Fastest", fp.F64.Log2Fastest},
+        })
+    })
+    b.Run("Any", func(b *testing.B) {
+        benchOps(b, wide, &f64Sink, []f64Op{
+            {"Negate", fp.F64.Negate}, {"Abs", fp.F64.Abs}, {"Floor", fp.F64.Floor}, {"Ceil", fp.F64.Ceil},
+            {"Round", fp.F64.Round}, {"Fract", fp.F64.Fract},
+            {"Exp", fp.F64.Exp}, {"ExpFast", fp.F64.ExpFast}, {"ExpFastest", fp.F64.ExpFastest},
+            {"Exp2", fp.F64.Exp2}, {"Exp2Fast", fp.F64.Exp2Fast}, {"Exp2Fastest", fp.F64.Exp2Fastest},
+            {"Sin", fp.F64.Sin}, {"SinFast", fp.F64.SinFast}, {"SinFastest", fp.F64.SinFastest},
+            {"Cos", fp.F64.Cos}, {"CosFast", fp.F64.CosFast}, {"CosFastest", fp.F64.CosFastest},
+            {"Atan", fp.F64.Atan}, {"AtanFast", fp.F64.AtanFast}, {"AtanFastest", fp.F64.AtanFastest},
+        })
+    })
+    b.Run("Unit", func(b *testing.B) {
+        benchOps(b, unit, &f64Sink, []f64Op{
+            {"Tan", fp.F64.Tan}, {"TanFast", fp.F64.TanFast}, {"TanFastest", fp.F64.TanFastest},
+            {"Asin", fp.F64.Asin}, {"AsinFast", fp.F64.AsinFast}, {"AsinFastest", fp.F64.AsinFastest},
+            {"Acos", fp.F64.Acos}, {"AcosFast", fp.F64.AcosFast}, {"AcosFastest", fp.F64.AcosFastest},
+        })
+    })
+    b.Run("Binary", func(b *testing.B) {
+        benchOps(b, div, &f64Sink, []f64BinOp{
+            {"Add", binary(fp.F64.Add)}, {"Sub", binary(fp.F64.Sub)}, {"Mul", binary(fp.F64.Mul)},
+            {"AddSat", binary(fp.F64.AddSat)}, {"SubSat", binary(fp.F64.SubSat)}, {"MulSat", binary(fp.F64.MulSat)},
+            {"DivPrecise", binary(fp.F64.DivPrecise)}, {"DivSat", binary(fp.F64.DivSat)}, {"Mod", binary(fp.F64.Mod)},
+            {"Div", binary(fp.F64.Div)}, {"DivFast", binary(fp.F64.DivFast)}, {"DivFastest", binary(fp.F64.DivFastest)},
+            {"Atan2", binary(fp.F64.Atan2)}, {"Atan2Fast", binary(fp.F64.Atan2Fast)}, {"Atan2Fastest", binary(fp.F64.Atan2Fastest)},
+            {"Lerp", func(p pair[fp.F64, fp.F64]) fp.F64 { return p.a.Lerp(p.b, fp.F64Half) }},
+        })
+        benchOps(b, pow, &f64Sink, []f64BinOp{
+            {"Pow", binary(fp.F64.Pow)}, {"PowFast", binary(fp.F64.PowFast)}, {"PowFastest", binary(fp.F64.PowFastest)},
+        })
+        benchOps(b, div, &boolSink, []benchOp[pair[fp.F64, fp.F64], bool]{
+            {"Equals", binary(fp.F64.Equals)}, {"EQ", binary(fp.F64.EQ)}, {"LT", binary(fp.F64.LT)},
+        })
+    })
+}
+
+// BenchmarkFix64 The raw functions wrapped by BenchmarkF64, for the cost of the wrappers.
+func BenchmarkFix64(b *testing.B) {
+    pos, wide, unit := f64Spread(0.25, 16), f64Spread(-8, 8), f64Spread(-0.99, 0.99)
+    div, pow := mapped(pairs(wide, pos), f64RawPair), mapped(pairs(pos, f64Spread(-2, 2)), f64RawPair)
+    b.Run("Positive", func(b *testing.B) {
+        benchOps(b, mapped(pos, f64Raw), &int64Sink, []fix64Op{
+            {"SqrtPrecise", fix64.SqrtPrecise}, {"Sqrt", fix64.Sqrt}, {"SqrtFast", fix64.SqrtFast}, {"SqrtFastest", fix64.SqrtFastest},
+            {"RSqrt", fix64.RSqrt}, {"RSqrtFast", fix64.RSqrtFast}, {"RSqrtFastest", fix64.RSqrtFastest},
+            {"Rcp", fix64.Rcp}, {"RcpFast", fix64.RcpFast}, {"RcpFastest", fix64.RcpFastest},
+            {"Log", fix64.Log}, {"LogFast", fix64.LogFast}, {"LogFastest", fix64.LogFastest},
+            {"Log2", fix64.Log2}, {"Log2Fast", fix64.Log2Fast}, {"Log2Fastest", fix64.Log2Fastest},
+        })
+    })
+    b.Run("Any", func(b *testing.B) {
+        benchOps(b, mapped(wide, f64Raw), &int64Sink, []fix64Op{
+            {"Negate", func(v int64) int64 { return -v }}, {"Abs", fix64.Abs}, {"Floor", fix64.Floor}, {"Ceil", fix64.Ceil},
+            {"Round", fix64.Round}, {"Fract", fix64.Fract},
+            {"Exp", fix64.Exp}, {"ExpFast", fix64.ExpFast}, {"ExpFastest", fix64.ExpFastest},
+            {"Exp2", fix64.Exp2}, {"Exp2Fast", fix64.Exp2Fast}, {"Exp2Fastest", fix64.Exp2Fastest},
+            {"Sin", fix64.Sin}, {"SinFast", fix64.SinFast}, {"SinFastest", fix64.SinFastest},
+            {"Cos", fix64.Cos}, {"CosFast", fix64.CosFast}, {"CosFastest", fix64.CosFastest},
+            {"Atan", fix64.Atan}, {"AtanFast", fix64.AtanFast}, {"AtanFastest", fix64.AtanFastest},
+        })
+    })
+    b.Run("Unit", func(b *testing.B) {
+        benchOps(b, mapped(unit, f64Raw), &int64Sink, []fix64Op{
+            {"Tan", fix64.Tan}, {"TanFast", fix64.TanFast}, {"TanFastest", fix64.TanFastest},
+            {"Asin", fix64.Asin}, {"AsinFast", fix64.AsinFast}, {"AsinFastest", fix64.AsinFastest},
+            {"Acos", fix64.Acos}, {"AcosFast", fix64.AcosFast}, {"AcosFastest", fix64.AcosFastest},
+        })
+    })
+    b.Run("Binary", func(b *testing.B) {
+        benchOps(b, div, &int64Sink, []benchOp[pair[int64, int64], int64]{
+            {"Add", binary(fix64.Add)}, {"Sub", binary(fix64.Sub)}, {"Mul", binary(fix64.Mul)},
+            {"AddSat", binary(fix64.AddSat)}, {"SubSat", binary(fix64.SubSat)}, {"MulSat", binary(fix64.MulSat)},
+            {"DivPrecise", binary(fix64.DivPrecise)}, {"DivSat", binary(fix64.DivSat)}, {"Mod", binary(fix64.Mod)},
+            {"Div", binary(fix64.Div)}, {"DivFast", binary(fix64.DivFast)}, {"DivFastest", binary(fix64.DivFastest)},
+            {"Atan2", binary(fix64.Atan2)}, {"Atan2Fast", binary(fix64.Atan2Fast)}, {"Atan2Fastest", binary(fix64.Atan2Fastest)},
+            {"Lerp", func(p pair[int64, int64]) int64 { return fix64.Lerp(p.a, p.b, fix64.Half) }},
+        })
+        benchOps(b, pow, &int64Sink, []benchOp[pair[int64, int64], int64]{
+            {"Pow", binary(fix64.Pow)}, {"PowFast", binary(fix64.PowFast)}, {"PowFastest", binary(fix64.PowFastest)},
+        })
+        benchOps(b, div, &boolSink, []benchOp[pair[int64, int64], bool]{
+            {"Equals", func(p pair[int64, int64]) bool { return p.a == p.b }},
+            {"EQ", func(p pair[int64, int64]) bool { return p.a == p.b }},
+            {"LT", func(p pair[int64, int64]) bool { return p.a < p.b }},
+        })
+    })
+}
+
+func f64Vec2s(in []fp.F64) []fp.F64Vec2 {
+    return mapped(pairs(in, rotated(in, 1)), func(p pair[fp.F64, fp.F64]) fp.F64Vec2 { return fp.F64Vec2FromF64(p.a, p.b) })
+}
+
+func f64Vec3s(in []fp.F64) []fp.F64Vec3 {
+    return mapped(pairs(f64Vec2s(in), rotated(in, 2)), func(p pair[fp.F64Vec2, fp.F64]) fp.F64Vec3 {
+        return fp.F64Vec3FromF64(p.a.X(), p.a.Y(), p.b)
+    })
+}
+
+func f64Vec4s(in []fp.F64) []fp.F64Vec4 {
+    return mapped(pairs(f64Vec3s(in), rotated(in, 3)), func(p pair[fp.F64Vec3, fp.F64]) fp.F64Vec4 {
+        return fp.F64Vec4FromF64(p.a.X(), p.a.Y(), p.a.Z(), p.b)
+    })
+}
+
+func BenchmarkF64Vec2(b *testing.B) {
+    type V = fp.F64Vec2
+    pos, pow := f64Vec2s(f64Spread(0.25, 16)), f64Vec2s(f64Spread(-2, 2))
+    benchOps(b, pos, &f64Vec2Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f64Sink, []benchOp[V, fp.F64]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f64Vec2Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F64Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f64Vec2Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f64Sink, []benchOp[pair[V, V], fp.F64]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f64Spread(0.25, 16)), &f64Vec2Sink, []benchOp[pair[V, fp.F64], V]{
+        {"AddF64", binary(V.AddF64)}, {"SubF64", binary(V.SubF64)}, {"MulF64", binary(V.MulF64)},
+        {"DivPreciseF64", binary(V.DivPreciseF64)}, {"ModF64", binary(V.ModF64)},
+    })
+}
+
+func BenchmarkF64Vec3(b *testing.B) {
+    type V = fp.F64Vec3
+    pos, pow := f64Vec3s(f64Spread(0.25, 16)), f64Vec3s(f64Spread(-2, 2))
+    benchOps(b, pos, &f64Vec3Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f64Sink, []benchOp[V, fp.F64]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f64Vec3Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Cross", binary(V.Cross)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F64Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f64Vec3Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f64Sink, []benchOp[pair[V, V], fp.F64]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f64Spread(0.25, 16)), &f64Vec3Sink, []benchOp[pair[V, fp.F64], V]{
+        {"AddF64", binary(V.AddF64)}, {"SubF64", binary(V.SubF64)}, {"MulF64", binary(V.MulF64)},
+        {"DivPreciseF64", binary(V.DivPreciseF64)}, {"ModF64", binary(V.ModF64)},
+        {"DivF64", binary(V.DivF64)}, {"DivFastF64", binary(V.DivFastF64)}, {"DivFastestF64", binary(V.DivFastestF64)},
+    })
+}
+
+func BenchmarkF64Vec4(b *testing.B) {
+    type V = fp.F64Vec4
+    pos, pow := f64Vec4s(f64Spread(0.25, 16)), f64Vec4s(f64Spread(-2, 2))
+    benchOps(b, pos, &f64Vec4Sink, []benchOp[V, V]{
+        {"Negate", V.Negate},
+        {"SqrtPrecise", V.SqrtPrecise}, {"Sqrt", V.Sqrt}, {"SqrtFast", V.SqrtFast}, {"SqrtFastest", V.SqrtFastest},
+        {"RSqrt", V.RSqrt}, {"RSqrtFast", V.RSqrtFast}, {"RSqrtFastest", V.RSqrtFastest},
+        {"Rcp", V.Rcp}, {"RcpFast", V.RcpFast}, {"RcpFastest", V.RcpFastest},
+        {"Exp", V.Exp}, {"ExpFast", V.ExpFast}, {"ExpFastest", V.ExpFastest},
+        {"Exp2", V.Exp2}, {"Exp2Fast", V.Exp2Fast}, {"Exp2Fastest", V.Exp2Fastest},
+        {"Log", V.Log}, {"LogFast", V.LogFast}, {"LogFastest", V.LogFastest},
+        {"Log2", V.Log2}, {"Log2Fast", V.Log2Fast}, {"Log2Fastest", V.Log2Fastest},
+        {"Sin", V.Sin}, {"SinFast", V.SinFast}, {"SinFastest", V.SinFastest},
+        {"Cos", V.Cos}, {"CosFast", V.CosFast}, {"CosFastest", V.CosFastest},
+        {"Normalize", V.Normalize}, {"NormalizeFast", V.NormalizeFast}, {"NormalizeFastest", V.NormalizeFastest},
+    })
+    benchOps(b, pos, &f64Sink, []benchOp[V, fp.F64]{
+        {"LengthSqr", V.LengthSqr}, {"Length", V.Length}, {"LengthFast", V.LengthFast}, {"LengthFastest", V.LengthFastest},
+    })
+    vv := pairs(pos, rotated(pos, 5))
+    benchOps(b, vv, &f64Vec4Sink, []benchOp[pair[V, V], V]{
+        {"Add", binary(V.Add)}, {"Sub", binary(V.Sub)}, {"Mul", binary(V.Mul)}, {"DivPrecise", binary(V.DivPrecise)}, {"Mod", binary(V.Mod)},
+        {"Div", binary(V.Div)}, {"DivFast", binary(V.DivFast)}, {"DivFastest", binary(V.DivFastest)},
+        {"Lerp", func(p pair[V, V]) V { return p.a.Lerp(p.b, fp.F64Half) }},
+    })
+    benchOps(b, pairs(pos, pow), &f64Vec4Sink, []benchOp[pair[V, V], V]{
+        {"Pow", binary(V.Pow)}, {"PowFast", binary(V.PowFast)}, {"PowFastest", binary(V.PowFastest)},
+    })
+    benchOps(b, vv, &f64Sink, []benchOp[pair[V, V], fp.F64]{
+        {"Dot", binary(V.Dot)}, {"Distance", binary(V.Distance)}, {"DistanceFast", binary(V.DistanceFast)}, {"DistanceFastest", binary(V.DistanceFastest)},
+    })
+    benchOps(b, vv, &boolSink, []benchOp[pair[V, V], bool]{
+        {"Equals", binary(V.Equals)}, {"EQ", binary(V.EQ)},
+    })
+    benchOps(b, pairs(pos, f64Spread(0.25, 16)), &f64Vec4Sink, []benchOp[pair[V, fp.F64], V]{
+        {"AddF64", binary(V.AddF64)}, {"SubF64", binary(V.SubF64)}, {"MulF64", binary(V.MulF64)},
+        {"DivPreciseF64", binary(V.DivPreciseF64)}, {"ModF64", binary(V.ModF64)},
+    })
+}
+
+// f64Quats Returns benchInputs unit quaternions over all rotations.
+func f64Quats() []fp.F64Quat {
+    return mapped(f64Vec3s(f64Spread(-4, 4)), func(v fp.F64Vec3) fp.F64Quat {
+        return fp.FromYawPitchRoll(v.X(), v.Y(), v.Z())
+    })
+}
+
+func BenchmarkF64Quat(b *testing.B) {
+    type Q = fp.F64Quat
+    q := f64Quats()
+    benchOps(b, q, &f64QuatSink, []benchOp[Q, Q]{
+        {"Negate", Q.Negate}, {"Conjugate", Q.Conjugate}, {"InverseUnit", Q.InverseUnit}, {"Inverse", Q.Inverse},
+        {"Normalize", Q.Normalize}, {"NormalizeFast", Q.NormalizeFast}, {"NormalizeFastest", Q.NormalizeFastest},
+    })
+    benchOps(b, q, &f64Sink, []benchOp[Q, fp.F64]{
+        {"LengthSqr", Q.LengthSqr}, {"Length", Q.Length}, {"LengthFast", Q.LengthFast}, {"LengthFastest", Q.LengthFastest},
+    })
+    qq := pairs(q, rotated(q, 5))
+    benchOps(b, qq, &f64QuatSink, []benchOp[pair[Q, Q], Q]{
+        {"Multiply", binary(Q.Multiply)}, {"Concatenate", binary(Q.Concatenate)},
+        {"Slerp", func(p pair[Q, Q]) Q { return p.a.Slerp(p.b, fp.F64Ratio(1, 3)) }},
+        {"Lerp", func(p pair[Q, Q]) Q { return p.a.Lerp(p.b, fp.F64Ratio(1, 3)) }},
+    })
+    benchOps(b, qq, &boolSink, []benchOp[pair[Q, Q], bool]{
+        {"Equals", binary(Q.Equals)}, {"EQ", binary(Q.EQ)},
+    })
+    v := f64Vec3s(f64Spread(-8, 8))
+    benchOps(b, pairs(q, v), &f64Vec3Sink, []benchOp[pair[Q, fp.F64Vec3], fp.F64Vec3]{
+        {"RotateVector", binary(Q.RotateVector)},
+    })
+    benchOps(b, v, &f64QuatSink, []benchOp[fp.F64Vec3, Q]{
+        {"FromYawPitchRoll", func(v fp.F64Vec3) Q { return fp.FromYawPitchRoll(v.X(), v.Y(), v.Z()) }},
+    })
+    benchOps(b, pairs(v, f64Spread(-4, 4)), &f64QuatSink, []benchOp[pair[fp.F64Vec3, fp.F64], Q]{
+        {"FromAxisAngle", func(p pair[fp.F64Vec3, fp.F64]) Q { return fp.FromAxisAngle(p.a.NormalizeFastest(), p.b) }},
+    })
+    benchOps(b, pairs(v, rotated(v, 5)), &f64QuatSink, []benchOp[pair[fp.F64Vec3, fp.F64Vec3], Q]{
+        {"FromTwoVectors", binary(fp.FromTwoVectors)},
+    })
+}
@@ -0,0 +1,427 @@
+package fp
+
+var (
+    f64Fields     = []string{"Raw"}
+    f64Vec2Fields = []string{"RawX", "RawY"}
+    f64Vec3Fields = []string{"RawX", "RawY", "RawZ"}
+    f64Vec4Fields = []string{"RawX", "RawY", "RawZ", "RawW"}
+    f64QuatFields = []string{"RawX", "RawY", "RawZ", "RawW"}
+)
+
+/************************************/
+/*************** F64 ****************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing a decimal string.
+func (f F64) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSON(nil, f.Raw), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (f F64) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSON(nil, f.Raw), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting decimal or hexadecimal strings and raw integers.
+func (f *F64) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSON("F64", data, f64Fields, 1)
+    if err != nil || raws == nil {
+        return err
+    }
+    *f = F64FromRaw(raws[0])
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (f F64) MarshalText() ([]byte, error) {
+    return f64Format.appendText(nil, f.Raw), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (f *F64) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalText("F64", text, 1)
+    if err != nil {
+        return err
+    }
+    *f = F64FromRaw(raws[0])
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw value as little-endian int64.
+func (f F64) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 8), f.Raw), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 8 bytes.
+func (f *F64) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64", data, 1)
+    if err != nil {
+        return err
+    }
+    *f = F64FromRaw(raws[0])
+    return nil
+}
+
+/************************************/
+/************* F64Vec2 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F64Vec2) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONTuple(nil, v.RawX, v.RawY), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F64Vec2) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONTuple(nil, v.RawX, v.RawY), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F64Vec2) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSON("F64Vec2", data, f64Vec2Fields, 2)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F64Vec2FromRaw(raws[0], raws[1])
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F64Vec2) MarshalText() ([]byte, error) {
+    return f64Format.appendText(nil, v.RawX, v.RawY), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F64Vec2) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalText("F64Vec2", text, 2)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec2FromRaw(raws[0], raws[1])
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int64.
+func (v F64Vec2) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 16), v.RawX, v.RawY), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 16 bytes.
+func (v *F64Vec2) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Vec2", data, 2)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec2FromRaw(raws[0], raws[1])
+    return nil
+}
+
+/************************************/
+/************* F64Vec3 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F64Vec3) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONTuple(nil, v.RawX, v.RawY, v.RawZ), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F64Vec3) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONTuple(nil, v.RawX, v.RawY, v.RawZ), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F64Vec3) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSON("F64Vec3", data, f64Vec3Fields, 3)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F64Vec3FromRaw(raws[0], raws[1], raws[2])
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F64Vec3) MarshalText() ([]byte, error) {
+    return f64Format.appendText(nil, v.RawX, v.RawY, v.RawZ), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F64Vec3) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalText("F64Vec3", text, 3)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec3FromRaw(raws[0], raws[1], raws[2])
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int64.
+func (v F64Vec3) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 24), v.RawX, v.RawY, v.RawZ), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 24 bytes.
+func (v *F64Vec3) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Vec3", data, 3)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec3FromRaw(raws[0], raws[1], raws[2])
+    return nil
+}
+
+/************************************/
+/************* F64Vec4 **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (v F64Vec4) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONTuple(nil, v.RawX, v.RawY, v.RawZ, v.RawW), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (v F64Vec4) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONTuple(nil, v.RawX, v.RawY, v.RawZ, v.RawW), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (v *F64Vec4) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSON("F64Vec4", data, f64Vec4Fields, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *v = F64Vec4FromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (v F64Vec4) MarshalText() ([]byte, error) {
+    return f64Format.appendText(nil, v.RawX, v.RawY, v.RawZ, v.RawW), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (v *F64Vec4) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalText("F64Vec4", text, 4)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec4FromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int64.
+func (v F64Vec4) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 32), v.RawX, v.RawY, v.RawZ, v.RawW), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 32 bytes.
+func (v *F64Vec4) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Vec4", data, 4)
+    if err != nil {
+        return err
+    }
+    *v = F64Vec4FromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+/************************************/
+/************* F64Quat **************/
+/************************************/
+
+// MarshalJSON Implements json.Marshaler, writing an array of decimal strings.
+func (q F64Quat) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONTuple(nil, q.RawX, q.RawY, q.RawZ, q.RawW), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (q F64Quat) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONTuple(nil, q.RawX, q.RawY, q.RawZ, q.RawW), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of decimal or hexadecimal strings or raw integers.
+func (q *F64Quat) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSON("F64Quat", data, f64QuatFields, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *q = QuatFromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (q F64Quat) MarshalText() ([]byte, error) {
+    return f64Format.appendText(nil, q.RawX, q.RawY, q.RawZ, q.RawW), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (q *F64Quat) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalText("F64Quat", text, 4)
+    if err != nil {
+        return err
+    }
+    *q = QuatFromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the raw components as little-endian int64.
+func (q F64Quat) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 32), q.RawX, q.RawY, q.RawZ, q.RawW), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 32 bytes.
+func (q *F64Quat) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Quat", data, 4)
+    if err != nil {
+        return err
+    }
+    *q = QuatFromRaw(raws[0], raws[1], raws[2], raws[3])
+    return nil
+}
+
+/************************************/
+/************* F64Mat3 **************/
+/************************************/
+
+// raws Returns the elements in row-major order.
+func (m F64Mat3) raws() []int64 {
+    raws := make([]int64, 0, 9)
+    for _, row := range m.Raw {
+        for _, v := range row {
+            raws = append(raws, v)
+        }
+    }
+    return raws
+}
+
+// f64Mat3FromRaws Creates a matrix from elements in row-major order.
+func f64Mat3FromRaws(raws []int64) F64Mat3 {
+    var m F64Mat3
+    for i, v := range raws {
+        m.Raw[i/3][i%3] = v
+    }
+    return m
+}
+
+// MarshalJSON Implements json.Marshaler, writing an array of rows.
+func (m F64Mat3) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONRows(nil, 3, m.raws()...), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (m F64Mat3) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONRows(nil, 3, m.raws()...), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of rows.
+func (m *F64Mat3) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSONRows("F64Mat3", data, 3, 3)
+    if err != nil || raws == nil {
+        return err
+    }
+    *m = f64Mat3FromRaws(raws)
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (m F64Mat3) MarshalText() ([]byte, error) {
+    return []byte(m.ToString()), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (m *F64Mat3) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalTextRows("F64Mat3", text, 3, 3)
+    if err != nil {
+        return err
+    }
+    *m = f64Mat3FromRaws(raws)
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the elements in row-major order as little-endian int64.
+func (m F64Mat3) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 72), m.raws()...), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 72 bytes.
+func (m *F64Mat3) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Mat3", data, 9)
+    if err != nil {
+        return err
+    }
+    *m = f64Mat3FromRaws(raws)
+    return nil
+}
+
+/************************************/
+/************* F64Mat4 **************/
+/************************************/
+
+// raws Returns the elements in row-major order.
+func (m F64Mat4) raws() []int64 {
+    raws := make([]int64, 0, 16)
+    for _, row := range m.Raw {
+        for _, v := range row {
+            raws = append(raws, v)
+        }
+    }
+    return raws
+}
+
+// f64Mat4FromRaws Creates a matrix from elements in row-major order.
+func f64Mat4FromRaws(raws []int64) F64Mat4 {
+    var m F64Mat4
+    for i, v := range raws {
+        m.Raw[i/4][i%4] = v
+    }
+    return m
+}
+
+// MarshalJSON Implements json.Marshaler, writing an array of rows.
+func (m F64Mat4) MarshalJSON() ([]byte, error) {
+    return f64Format.appendJSONRows(nil, 4, m.raws()...), nil
+}
+
+// MarshalJSONRaw Returns the JSON form with raw integers instead of decimal strings, see RawJSON.
+func (m F64Mat4) MarshalJSONRaw() ([]byte, error) {
+    return f64RawFormat.appendJSONRows(nil, 4, m.raws()...), nil
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting an array of rows.
+func (m *F64Mat4) UnmarshalJSON(data []byte) error {
+    raws, err := f64Format.unmarshalJSONRows("F64Mat4", data, 4, 4)
+    if err != nil || raws == nil {
+        return err
+    }
+    *m = f64Mat4FromRaws(raws)
+    return nil
+}
+
+// MarshalText Implements encoding.TextMarshaler, using the ToString form.
+func (m F64Mat4) MarshalText() ([]byte, error) {
+    return []byte(m.ToString()), nil
+}
+
+// UnmarshalText Implements encoding.TextUnmarshaler.
+func (m *F64Mat4) UnmarshalText(text []byte) error {
+    raws, err := f64Format.unmarshalTextRows("F64Mat4", text, 4, 4)
+    if err != nil {
+        return err
+    }
+    *m = f64Mat4FromRaws(raws)
+    return nil
+}
+
+// MarshalBinary Implements encoding.BinaryMarshaler, writing the elements in row-major order as little-endian int64.
+func (m F64Mat4) MarshalBinary() ([]byte, error) {
+    return f64Format.appendBinary(make([]byte, 0, 128), m.raws()...), nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, requiring exactly 128 bytes.
+func (m *F64Mat4) UnmarshalBinary(data []byte) error {
+    raws, err := f64Format.unmarshalBinary("F64Mat4", data, 16)
+    if err != nil {
+        return err
+    }
+    *m = f64Mat4FromRaws(raws)
+    return nil
+}
@@ -0,0 +1,227 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix64"
+)
+
+var (
+    F64Mat3Zero     = F64Mat3FromRaw(fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero, fix64.Zero)
+    F64Mat3Identity = F64Mat3FromRaw(fix64.One, fix64.Zero, fix64.Zero, fix64.Zero, fix64.One, fix64.Zero, fix64.Zero, fix64.Zero, fix64.One)
+)
+
+// F64Mat3 Row-major 3x3 matrix with signed 32.32 fixed point elements.
+// Vectors are treated as columns, so m.MulVec3(v) computes m * v.
+type F64Mat3 struct {
+    Raw [3][3]int64 // Raw[row][col]
+}
+
+func F64Mat3FromRaw(m00, m01, m02, m10, m11, m12, m20, m21, m22 int64) F64Mat3 {
+    return F64Mat3{
+        Raw: [3][3]int64{
+            {m00, m01, m02},
+            {m10, m11, m12},
+            {m20, m21, m22},
+        },
+    }
+}
+
+func F64Mat3FromRows(r0, r1, r2 F64Vec3) F64Mat3 {
+    return F64Mat3FromRaw(r0.RawX, r0.RawY, r0.RawZ, r1.RawX, r1.RawY, r1.RawZ, r2.RawX, r2.RawY, r2.RawZ)
+}
+
+func F64Mat3FromCols(c0, c1, c2 F64Vec3) F64Mat3 {
+    return F64Mat3FromRaw(c0.RawX, c1.RawX, c2.RawX, c0.RawY, c1.RawY, c2.RawY, c0.RawZ, c1.RawZ, c2.RawZ)
+}
+
+// F64Mat3FromScale Creates a matrix scaling each axis by the matching component of s.
+func F64Mat3FromScale(s F64Vec3) F64Mat3 {
+    return F64Mat3FromRaw(s.RawX, fix64.Zero, fix64.Zero, fix64.Zero, s.RawY, fix64.Zero, fix64.Zero, fix64.Zero, s.RawZ)
+}
+
+// F64Mat3FromQuat Creates a rotation matrix from the unit quaternion q.
+func F64Mat3FromQuat(q F64Quat) F64Mat3 {
+    xx := fix64.Mul(q.RawX, q.RawX)
+    yy := fix64.Mul(q.RawY, q.RawY)
+    zz := fix64.Mul(q.RawZ, q.RawZ)
+    xy := fix64.Mul(q.RawX, q.RawY)
+    xz := fix64.Mul(q.RawX, q.RawZ)
+    yz := fix64.Mul(q.RawY, q.RawZ)
+    wx := fix64.Mul(q.RawW, q.RawX)
+    wy := fix64.Mul(q.RawW, q.RawY)
+    wz := fix64.Mul(q.RawW, q.RawZ)
+
+    return F64Mat3FromRaw(
+        fix64.One-((yy+zz)<<1), (xy-wz)<<1, (xz+wy)<<1,
+        (xy+wz)<<1, fix64.One-((xx+zz)<<1), (yz-wx)<<1,
+        (xz-wy)<<1, (yz+wx)<<1, fix64.One-((xx+yy)<<1),
+    )
+}
+
+// F64Mat3FromRotationScale Creates a matrix that scales by s and then rotates by q.
+func F64Mat3FromRotationScale(q F64Quat, s F64Vec3) F64Mat3 {
+    r := F64Mat3FromQuat(q)
+    for i := 0; i < 3; i++ {
+        r.Raw[i][0] = fix64.Mul(r.Raw[i][0], s.RawX)
+        r.Raw[i][1] = fix64.Mul(r.Raw[i][1], s.RawY)
+        r.Raw[i][2] = fix64.Mul(r.Raw[i][2], s.RawZ)
+    }
+    return r
+}
+
+// At Returns the element at row r and column c.
+func (m F64Mat3) At(r, c int) F64 {
+    return F64FromRaw(m.Raw[r][c])
+}
+
+func (m F64Mat3) Row(r int) F64Vec3 {
+    return F64Vec3FromRaw(m.Raw[r][0], m.Raw[r][1], m.Raw[r][2])
+}
+
+func (m F64Mat3) Col(c int) F64Vec3 {
+    return F64Vec3FromRaw(m.Raw[0][c], m.Raw[1][c], m.Raw[2][c])
+}
+
+// Add m + b
+func (m F64Mat3) Add(b F64Mat3) F64Mat3 {
+    var r F64Mat3
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            r.Raw[i][j] = m.Raw[i][j] + b.Raw[i][j]
+        }
+    }
+    return r
+}
+
+// Sub m - b
+func (m F64Mat3) Sub(b F64Mat3) F64Mat3 {
+    var r F64Mat3
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            r.Raw[i][j] = m.Raw[i][j] - b.Raw[i][j]
+        }
+    }
+    return r
+}
+
+// Mul m * b
+func (m F64Mat3) Mul(b F64Mat3) F64Mat3 {
+    var r F64Mat3
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            r.Raw[i][j] = fix64.Mul(m.Raw[i][0], b.Raw[0][j]) + fix64.Mul(m.Raw[i][1], b.Raw[1][j]) + fix64.Mul(m.Raw[i][2], b.Raw[2][j])
+        }
+    }
+    return r
+}
+
+// MulF64 m * b
+func (m F64Mat3) MulF64(b F64) F64Mat3 {
+    var r F64Mat3
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            r.Raw[i][j] = fix64.Mul(m.Raw[i][j], b.Raw)
+        }
+    }
+    return r
+}
+
+// MulVec3 m * v
+func (m F64Mat3) MulVec3(v F64Vec3) F64Vec3 {
+    return F64Vec3FromRaw(
+        fix64.Mul(m.Raw[0][0], v.RawX)+fix64.Mul(m.Raw[0][1], v.RawY)+fix64.Mul(m.Raw[0][2], v.RawZ),
+        fix64.Mul(m.Raw[1][0], v.RawX)+fix64.Mul(m.Raw[1][1], v.RawY)+fix64.Mul(m.Raw[1][2], v.RawZ),
+        fix64.Mul(m.Raw[2][0], v.RawX)+fix64.Mul(m.Raw[2][1], v.RawY)+fix64.Mul(m.Raw[2][2], v.RawZ),
+    )
+}
+
+// EQ m == b
+func (m F64Mat3) EQ(b F64Mat3) bool {
+    return m.Raw == b.Raw
+}
+
+// NE m != b
+func (m F64Mat3) NE(b F64Mat3) bool {
+    return m.Raw != b.Raw
+}
+
+func (m F64Mat3) Transpose() F64Mat3 {
+    return F64Mat3FromRaw(
+        m.Raw[0][0], m.Raw[1][0], m.Raw[2][0],
+        m.Raw[0][1], m.Raw[1][1], m.Raw[2][1],
+        m.Raw[0][2], m.Raw[1][2], m.Raw[2][2],
+    )
+}
+
+func (m F64Mat3) Determinant() F64 {
+    c00 := fix64.Mul(m.Raw[1][1], m.Raw[2][2]) - fix64.Mul(m.Raw[1][2], m.Raw[2][1])
+    c01 := fix64.Mul(m.Raw[1][2], m.Raw[2][0]) - fix64.Mul(m.Raw[1][0], m.Raw[2][2])
+    c02 := fix64.Mul(m.Raw[1][0], m.Raw[2][1]) - fix64.Mul(m.Raw[1][1], m.Raw[2][0])
+    return F64FromRaw(fix64.Mul(m.Raw[0][0], c00) + fix64.Mul(m.Raw[0][1], c01) + fix64.Mul(m.Raw[0][2], c02))
+}
+
+// Inverse Returns the inverse of m, or ok=false when m is singular or so close to it that an entry of the
+// inverse leaves the F64 range.
+func (m F64Mat3) Inverse() (F64Mat3, bool) {
+    // Cofactors, already transposed into the adjugate.
+    a00 := fix64.Mul(m.Raw[1][1], m.Raw[2][2]) - fix64.Mul(m.Raw[1][2], m.Raw[2][1])
+    a01 := fix64.Mul(m.Raw[0][2], m.Raw[2][1]) - fix64.Mul(m.Raw[0][1], m.Raw[2][2])
+    a02 := fix64.Mul(m.Raw[0][1], m.Raw[1][2]) - fix64.Mul(m.Raw[0][2], m.Raw[1][1])
+    a10 := fix64.Mul(m.Raw[1][2], m.Raw[2][0]) - fix64.Mul(m.Raw[1][0], m.Raw[2][2])
+    a11 := fix64.Mul(m.Raw[0][0], m.Raw[2][2]) - fix64.Mul(m.Raw[0][2], m.Raw[2][0])
+    a12 := fix64.Mul(m.Raw[0][2], m.Raw[1][0]) - fix64.Mul(m.Raw[0][0], m.Raw[1][2])
+    a20 := fix64.Mul(m.Raw[1][0], m.Raw[2][1]) - fix64.Mul(m.Raw[1][1], m.Raw[2][0])
+    a21 := fix64.Mul(m.Raw[0][1], m.Raw[2][0]) - fix64.Mul(m.Raw[0][0], m.Raw[2][1])
+    a22 := fix64.Mul(m.Raw[0][0], m.Raw[1][1]) - fix64.Mul(m.Raw[0][1], m.Raw[1][0])
+
+    det := fix64.Mul(m.Raw[0][0], a00) + fix64.Mul(m.Raw[0][1], a10) + fix64.Mul(m.Raw[0][2], a20)
+    if det == 0 {
+        return F64Mat3Zero, false
+    }
+
+    adj := [3][3]int64{{a00, a01, a02}, {a10, a11, a12}, {a20, a21, a22}}
+    var r F64Mat3
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            v, ok := fix64.DivChecked(adj[i][j], det)
+            if !ok {
+                return F64Mat3Zero, false
+            }
+            r.Raw[i][j] = v
+        }
+    }
+    return r, true
+}
+
+// Quat Extracts the rotation of a pure rotation matrix as a unit quaternion.
+func (m F64Mat3) Quat() F64Quat {
+    // From: https://www.euclideanspace.com/maths/geometry/rotations/conversions/matrixToQuaternion/
+    m00, m01, m02 := m.Raw[0][0], m.Raw[0][1], m.Raw[0][2]
+    m10, m11, m12 := m.Raw[1][0], m.Raw[1][1], m.Raw[1][2]
+    m20, m21, m22 := m.Raw[2][0], m.Raw[2][1], m.Raw[2][2]
+
+    var q F64Quat
+    if trace := m00 + m11 + m22; trace > 0 {
+        s := fix64.Sqrt(trace+fix64.One) << 1 // s = 4 * w
+        q = QuatFromRaw(fix64.DivPrecise(m21-m12, s), fix64.DivPrecise(m02-m20, s), fix64.DivPrecise(m10-m01, s), s>>2)
+    } else if m00 > m11 && m00 > m22 {
+        s := fix64.Sqrt(fix64.One+m00-m11-m22) << 1 // s = 4 * x
+        q = QuatFromRaw(s>>2, fix64.DivPrecise(m01+m10, s), fix64.DivPrecise(m02+m20, s), fix64.DivPrecise(m21-m12, s))
+    } else if m11 > m22 {
+        s := fix64.Sqrt(fix64.One+m11-m00-m22) << 1 // s = 4 * y
+        q = QuatFromRaw(fix64.DivPrecise(m01+m10, s), s>>2, fix64.DivPrecise(m12+m21, s), fix64.DivPrecise(m02-m20, s))
+    } else {
+        s := fix64.Sqrt(fix64.One+m22-m00-m11) << 1 // s = 4 * z
+        q = QuatFromRaw(fix64.DivPrecise(m02+m20, s), fix64.DivPrecise(m12+m21, s), s>>2, fix64.DivPrecise(m10-m01, s))
+    }
+    return q.Normalize()
+}
+
+func (m F64Mat3) Equals(obj F64Mat3) bool {
+    return m == obj
+}
+
+func (m F64Mat3) ToString() string {
+    return fmt.Sprintf(`(%s, %s, %s)`, m.Row(0).ToString(), m.Row(1).ToString(), m.Row(2).ToString())
+}
@@ -0,0 +1,71 @@
+package fp
+
+import (
+    "fmt"
+)
+
+// F64Plane The points x with Normal.Dot(x) == D. Distances are in units of the normal's length, which
+// makes them Euclidean for a unit normal as created by the constructors.
+type F64Plane struct {
+    Normal F64Vec3
+    D      F64
+}
+
+func F64PlaneFromNormalD(normal F64Vec3, d F64) F64Plane {
+    return F64Plane{Normal: normal, D: d}
+}
+
+// F64PlaneFromPointNormal Creates the plane through p, normalizing normal.
+func F64PlaneFromPointNormal(p, normal F64Vec3) F64Plane {
+    n := normalizePrecise(normal)
+    return F64Plane{Normal: n, D: n.Dot(p)}
+}
+
+// F64PlaneFromPoints Creates the plane through a, b and c, with the normal facing the side from which they
+// appear counter-clockwise. ok is false when the points are collinear.
+func F64PlaneFromPoints(a, b, c F64Vec3) (F64Plane, bool) {
+    n := b.Sub(a).Cross(c.Sub(a))
+    if n.EQ(F64Vec3Zero) {
+        return F64Plane{}, false
+    }
+    return F64PlaneFromPointNormal(a, n), true
+}
+
+// SignedDistance Returns the distance from the plane to p, positive on the side the normal points to.
+func (pl F64Plane) SignedDistance(p F64Vec3) F64 {
+    return pl.Normal.Dot(p).Sub(pl.D)
+}
+
+// Distance Returns the unsigned distance from the plane to p.
+func (pl F64Plane) Distance(p F64Vec3) F64 {
+    return pl.SignedDistance(p).Abs()
+}
+
+// ClosestPoint Returns the projection of p onto the plane.
+func (pl F64Plane) ClosestPoint(p F64Vec3) F64Vec3 {
+    return p.Sub(pl.Normal.MulF64(pl.SignedDistance(p)))
+}
+
+// Flip Returns the same plane with the normal reversed.
+func (pl F64Plane) Flip() F64Plane {
+    return F64Plane{Normal: pl.Normal.Negate(), D: pl.D.Negate()}
+}
+
+func (pl F64Plane) Equals(obj F64Plane) bool {
+    return pl == obj
+}
+
+func (pl F64Plane) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, pl.Normal.ToString(), pl.D.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting the normal and D with the verb, see F64Vec3.Format.
+func (pl F64Plane) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, pl.Normal, pl.D)
+}
+
+// normalizePrecise Returns v scaled to unit length using SqrtPrecise and DivPrecise, so that axis aligned
+// vectors normalize exactly.
+func normalizePrecise(v F64Vec3) F64Vec3 {
+    return v.DivPreciseF64(v.LengthSqr().SqrtPrecise())
+}
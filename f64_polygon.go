@@ -0,0 +1,363 @@
+package fp
+
+import (
+    "fmt"
+    "sort"
+    "strings"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64Polygon Simple polygon given by its vertices in order, with an implicit edge from the last vertex back
+// to the first. Counter-clockwise polygons have a positive signed area.
+//
+// Orientation decisions, and with them Winding, Contains, F64ConvexHull and the choices made while clipping
+// and triangulating, use exact predicates for any coordinates. Areas are exact up to the final rounding,
+// while centroids and clipped intersection points are rounded, but computed in a fixed order, so the
+// results are the same on every platform.
+type F64Polygon []F64Vec2
+
+// F64ConvexHull Returns the convex hull of the points counter-clockwise, starting from the lowest point
+// on the left, using Andrew's monotone chain. Duplicate and collinear points are left out, so fewer than
+// three distinct points give a polygon with fewer than three vertices.
+func F64ConvexHull(points []F64Vec2) F64Polygon {
+    ps := append([]F64Vec2(nil), points...)
+    sort.Slice(ps, func(i, j int) bool {
+        if ps[i].RawX != ps[j].RawX {
+            return ps[i].RawX < ps[j].RawX
+        }
+        return ps[i].RawY < ps[j].RawY
+    })
+    n := 0
+    for i := range ps {
+        if i == 0 || ps[i] != ps[n-1] {
+            ps[n] = ps[i]
+            n++
+        }
+    }
+    ps = ps[:n]
+    if n < 3 {
+        return F64Polygon(ps)
+    }
+
+    hull := make(F64Polygon, 0, n+1)
+    for _, p := range ps { // lower chain
+        for len(hull) >= 2 && orient2(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+            hull = hull[:len(hull)-1]
+        }
+        hull = append(hull, p)
+    }
+    lower := len(hull)
+    for i := n - 2; i >= 0; i-- { // upper chain
+        p := ps[i]
+        for len(hull) > lower && orient2(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+            hull = hull[:len(hull)-1]
+        }
+        hull = append(hull, p)
+    }
+    // The last point closes the loop at the first one.
+    return hull[:len(hull)-1]
+}
+
+// SignedArea Returns the area enclosed by the polygon, positive when it is counter-clockwise and negative
+// when it is clockwise, rounded towards negative infinity and saturated.
+func (p F64Polygon) SignedArea() F64 {
+    // The sum is twice the area in units of 2^-64.
+    return F64FromRaw(p.shoelace().shiftSat(uint(fix64.Shift) + 1))
+}
+
+// Area Returns the absolute area enclosed by the polygon, saturated.
+func (p F64Polygon) Area() F64 {
+    a := p.SignedArea()
+    if a.Raw < 0 {
+        return F64FromRaw(fix64.SubSat(0, a.Raw))
+    }
+    return a
+}
+
+// Winding Returns 1 when the polygon is counter-clockwise, -1 when it is clockwise, and 0 when its area is
+// exactly zero.
+func (p F64Polygon) Winding() int32 {
+    switch sum := p.shoelace(); {
+    case int64(sum[2]) < 0:
+        return -1
+    case sum == wideSum{}:
+        return 0
+    default:
+        return 1
+    }
+}
+
+// IsConvex Reports whether every turn of the polygon goes the same way, ignoring collinear vertices. It
+// does not detect self-intersecting polygons that wind around more than once.
+func (p F64Polygon) IsConvex() bool {
+    turn := 0
+    for i := range p {
+        o := orient2(p[i], p[(i+1)%len(p)], p[(i+2)%len(p)])
+        if o == 0 {
+            continue
+        }
+        if turn != 0 && o != turn {
+            return false
+        }
+        turn = o
+    }
+    return true
+}
+
+// Centroid Returns the center of mass of the enclosed area. A polygon with zero area gives the average of
+// its vertices instead, and no vertices give zero.
+func (p F64Polygon) Centroid() F64Vec2 {
+    if len(p) == 0 {
+        return F64Vec2Zero
+    }
+    // Work relative to the first vertex, weighting each edge by its share of the total area. The cross
+    // products are exact, and are scaled down together until the total fits into 62 bits, so that the
+    // shares keep their precision.
+    o := p[0]
+    rel := func(i int) (int64, int64) {
+        v := p[i%len(p)]
+        return v.RawX - o.RawX, v.RawY - o.RawY
+    }
+    cross := make([]wideSum, len(p))
+    var total wideSum
+    for i := range p {
+        ax, ay := rel(i)
+        bx, by := rel(i + 1)
+        cross[i].addMul(ax, by)
+        cross[i].subMul(bx, ay)
+        total.addMul(ax, by)
+        total.subMul(bx, ay)
+    }
+    var cx, cy int64
+    if total.sign() == 0 {
+        for i := range p {
+            x, y := rel(i)
+            cx += x / int64(len(p))
+            cy += y / int64(len(p))
+        }
+        return F64Vec2FromRaw(o.RawX+cx, o.RawY+cy)
+    }
+    var s uint
+    if n := total.bitLen(); n > 62 {
+        s = n - 62
+    }
+    t := total.shiftSat(s)
+    for i := range p {
+        ax, ay := rel(i)
+        bx, by := rel(i + 1)
+        w := fix64.DivPrecise(cross[i].shiftSat(s), t)
+        cx += fix64.Mul(ax+bx, w)
+        cy += fix64.Mul(ay+by, w)
+    }
+    return F64Vec2FromRaw(o.RawX+cx/3, o.RawY+cy/3)
+}
+
+// Contains Reports whether pt is inside the polygon or on its boundary, using the nonzero winding rule.
+func (p F64Polygon) Contains(pt F64Vec2) bool {
+    wn := 0
+    for i, a := range p {
+        b := p[(i+1)%len(p)]
+        o := orient2(a, b, pt)
+        if o == 0 && onSegment2(a, b, pt) {
+            return true
+        }
+        if a.RawY <= pt.RawY {
+            if b.RawY > pt.RawY && o > 0 {
+                wn++ // upward crossing with pt on the left
+            }
+        } else if b.RawY <= pt.RawY && o < 0 {
+            wn-- // downward crossing with pt on the right
+        }
+    }
+    return wn != 0
+}
+
+// Reverse Returns the polygon with its vertices in the opposite order, which flips its winding.
+func (p F64Polygon) Reverse() F64Polygon {
+    r := make(F64Polygon, len(p))
+    for i, v := range p {
+        r[len(p)-1-i] = v
+    }
+    return r
+}
+
+// Clip Returns the part of the polygon inside the convex polygon clip, using the Sutherland-Hodgman
+// algorithm. clip may wind either way. Where the polygon is concave and the result falls apart into
+// several pieces, they are joined by edges along the boundary of clip. Vertices on the boundary of clip are
+// kept once, without a duplicate for the crossing there. An empty result, or a clip polygon without area,
+// gives nil.
+func (p F64Polygon) Clip(clip F64Polygon) F64Polygon {
+    switch clip.Winding() {
+    case 0:
+        return nil
+    case -1:
+        clip = clip.Reverse()
+    }
+    out := append(F64Polygon(nil), p...)
+    for i, a := range clip {
+        if len(out) == 0 {
+            return nil
+        }
+        b := clip[(i+1)%len(clip)]
+        if a == b {
+            continue
+        }
+        in := out
+        out = make(F64Polygon, 0, len(in)+1)
+        // Vertices on the edge count as inside. An edge only crosses when its ends lie strictly on either
+        // side, otherwise the crossing is the vertex on the edge itself, which is emitted once as inside.
+        s := in[len(in)-1]
+        so := orient2(a, b, s)
+        for _, e := range in {
+            eo := orient2(a, b, e)
+            if so*eo < 0 {
+                out = append(out, clipIntersect(a, b, s, e))
+            }
+            if eo >= 0 {
+                out = append(out, e)
+            }
+            s, so = e, eo
+        }
+    }
+    if len(out) == 0 {
+        return nil
+    }
+    return out
+}
+
+// Triangulate Splits the polygon into triangles by ear clipping, returning the vertex indices of each
+// triangle counter-clockwise. Collinear vertices are skipped rather than producing triangles without
+// area, so a polygon with n vertices gives at most n - 2 triangles. ok is false, with the triangles found
+// so far, when the polygon is self-intersecting and no ear remains, or the last triangle is turned the
+// wrong way.
+func (p F64Polygon) Triangulate() (tris [][3]int, ok bool) {
+    if len(p) < 3 {
+        return nil, true
+    }
+    idx := make([]int, len(p))
+    for i := range idx {
+        idx[i] = i
+    }
+    if p.Winding() < 0 {
+        for i := range idx {
+            idx[i] = len(p) - 1 - i
+        }
+    }
+    tris = make([][3]int, 0, len(p)-2)
+    for len(idx) > 3 {
+        ear, flat := -1, -1
+        for i := range idx {
+            a, b, c := idx[(i+len(idx)-1)%len(idx)], idx[i], idx[(i+1)%len(idx)]
+            o := orient2(p[a], p[b], p[c])
+            if o == 0 && flat < 0 {
+                flat = i
+            }
+            if o > 0 && p.isEar(idx, a, b, c) {
+                ear = i
+                break
+            }
+        }
+        switch {
+        case ear >= 0:
+            tris = append(tris, [3]int{idx[(ear+len(idx)-1)%len(idx)], idx[ear], idx[(ear+1)%len(idx)]})
+        case flat >= 0:
+            ear = flat
+        default:
+            return tris, false
+        }
+        idx = append(idx[:ear], idx[ear+1:]...)
+    }
+    switch orient2(p[idx[0]], p[idx[1]], p[idx[2]]) {
+    case 1:
+        tris = append(tris, [3]int{idx[0], idx[1], idx[2]})
+    case -1:
+        return tris, false
+    }
+    return tris, true
+}
+
+// shoelace Returns the exact sum of the cross products of consecutive vertices, which is twice the
+// signed area.
+func (p F64Polygon) shoelace() wideSum {
+    var sum wideSum
+    for i, a := range p {
+        b := p[(i+1)%len(p)]
+        sum.addMul(a.RawX, b.RawY)
+        sum.subMul(b.RawX, a.RawY)
+    }
+    return sum
+}
+
+// isEar Reports whether no other remaining vertex lies in the counter-clockwise triangle a, b, c.
+func (p F64Polygon) isEar(idx []int, a, b, c int) bool {
+    for _, j := range idx {
+        v := p[j]
+        if j == a || j == b || j == c || v == p[a] || v == p[b] || v == p[c] {
+            continue
+        }
+        if orient2(p[a], p[b], v) >= 0 && orient2(p[b], p[c], v) >= 0 && orient2(p[c], p[a], v) >= 0 {
+            return false
+        }
+    }
+    return true
+}
+
+func (p F64Polygon) Equals(obj F64Polygon) bool {
+    if len(p) != len(obj) {
+        return false
+    }
+    for i := range p {
+        if p[i] != obj[i] {
+            return false
+        }
+    }
+    return true
+}
+
+func (p F64Polygon) ToString() string {
+    s := make([]string, len(p))
+    for i, v := range p {
+        s[i] = v.ToString()
+    }
+    return `(` + strings.Join(s, `, `) + `)`
+}
+
+// Format Implements fmt.Formatter, formatting the vertices with the verb, see F64Vec2.Format.
+func (p F64Polygon) Format(s fmt.State, verb rune) {
+    vs := make([]fmt.Formatter, len(p))
+    for i, v := range p {
+        vs[i] = v
+    }
+    formatTuple(s, verb, vs...)
+}
+
+// onSegment2 Reports whether pt, known to be collinear with a and b, lies between them.
+func onSegment2(a, b, pt F64Vec2) bool {
+    return fix64.Min(a.RawX, b.RawX) <= pt.RawX && pt.RawX <= fix64.Max(a.RawX, b.RawX) &&
+        fix64.Min(a.RawY, b.RawY) <= pt.RawY && pt.RawY <= fix64.Max(a.RawY, b.RawY)
+}
+
+// clipIntersect Returns the point where the edge s, e crosses the line through a and b.
+func clipIntersect(a, b, s, e F64Vec2) F64Vec2 {
+    // Signed distances of s and e from the line, scaled by |b - a|, as exact cross products. s and e lie
+    // on either side, so |ds| + |de| is |ds - de|.
+    var ds wideSum
+    ds.addMulDiff(a.RawX, b.RawX, a.RawY, s.RawY)
+    ds.subMulDiff(a.RawY, b.RawY, a.RawX, s.RawX)
+    den := ds
+    den.subMulDiff(a.RawX, b.RawX, a.RawY, e.RawY)
+    den.addMulDiff(a.RawY, b.RawY, a.RawX, e.RawX)
+
+    // Scale both down together until the sum fits into 64 bits for lerpFrac.
+    num, den := ds.abs(), den.abs()
+    var shift uint
+    if n := den.bitLen(); n > 64 {
+        shift = n - 64
+    }
+    nu, de := num.bitsAt(shift), den.bitsAt(shift)
+    if de == 0 {
+        return s
+    }
+    return F64Vec2FromRaw(lerpFrac(s.RawX, e.RawX, nu, de), lerpFrac(s.RawY, e.RawY, nu, de))
+}
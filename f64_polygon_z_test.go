@@ -0,0 +1,239 @@
+package fp_test
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/rand"
+)
+
+func v2(x, y int32) fp.F64Vec2 {
+    return fp.F64Vec2FromInt32(x, y)
+}
+
+func triangleArea(p fp.F64Polygon, tris [][3]int) fp.F64 {
+    sum := fp.F64Zero
+    for _, tri := range tris {
+        sum = sum.Add(fp.F64Polygon{p[tri[0]], p[tri[1]], p[tri[2]]}.SignedArea())
+    }
+    return sum
+}
+
+func TestF64Polygon(t *testing.T) {
+    sq := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 4), v2(0, 4)}
+    assert.Equal(t, fp.F64FromInt32(16), sq.SignedArea())
+    assert.Equal(t, fp.F64FromInt32(-16), sq.Reverse().SignedArea())
+    assert.Equal(t, fp.F64FromInt32(16), sq.Reverse().Area())
+    assert.Equal(t, int32(1), sq.Winding())
+    assert.Equal(t, int32(-1), sq.Reverse().Winding())
+    assert.True(t, sq.IsConvex())
+    assert.Equal(t, v2(2, 2), sq.Centroid())
+    assert.True(t, sq.Contains(v2(1, 3)))
+    assert.True(t, sq.Contains(v2(4, 2)))
+    assert.True(t, sq.Contains(v2(0, 0)))
+    assert.False(t, sq.Contains(v2(5, 2)))
+    assert.False(t, sq.Contains(v2(2, -1)))
+    assert.True(t, sq.Reverse().Contains(v2(1, 3)))
+    assert.Equal(t, "((0, 0), (4, 0), (4, 4), (0, 4))", fmt.Sprint(sq))
+    assert.Equal(t, "((0, 0), (4, 0), (4, 4), (0, 4))", sq.ToString())
+    assert.True(t, sq.Equals(sq.Reverse().Reverse()))
+    assert.False(t, sq.Equals(sq.Reverse()))
+
+    l := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 1), v2(1, 1), v2(1, 4), v2(0, 4)}
+    assert.Equal(t, fp.F64FromInt32(7), l.SignedArea())
+    assert.False(t, l.IsConvex())
+    c := l.Centroid()
+    assert.InDelta(t, 9.5/7, c.X().Float64(), 1e-8)
+    assert.InDelta(t, 9.5/7, c.Y().Float64(), 1e-8)
+    assert.True(t, l.Contains(v2(3, 1)))
+    assert.False(t, l.Contains(v2(3, 3)))
+
+    var empty fp.F64Polygon
+    assert.Equal(t, fp.F64Zero, empty.SignedArea())
+    assert.Equal(t, int32(0), empty.Winding())
+    assert.Equal(t, fp.F64Vec2Zero, empty.Centroid())
+    assert.False(t, empty.Contains(fp.F64Vec2Zero))
+    assert.Equal(t, v2(2, 0), fp.F64Polygon{v2(0, 0), v2(4, 0)}.Centroid())
+
+    // Far beyond the range of the squared coordinates.
+    huge := fp.F64Polygon{v2(0, 0), v2(100000, 0), v2(100000, 100000), v2(0, 100000)}
+    assert.Equal(t, v2(50000, 50000), huge.Centroid())
+    wide := fp.F64Polygon{v2(-1000000000, -1000000000), v2(1000000000, -1000000000), v2(-1000000000, 1000000000)}
+    c = wide.Centroid()
+    assert.InDelta(t, -1000000000/3.0, c.X().Float64(), 1e-3)
+    assert.InDelta(t, -1000000000/3.0, c.Y().Float64(), 1e-3)
+}
+
+func TestF64Polygon_Exact(t *testing.T) {
+    // The area is 2^-65, which rounds to zero, but the winding is still known.
+    tiny := fp.F64Polygon{fp.F64Vec2FromRaw(0, 0), fp.F64Vec2FromRaw(1, 0), fp.F64Vec2FromRaw(0, 1)}
+    assert.Equal(t, int64(0), tiny.SignedArea().Raw)
+    assert.Equal(t, int64(-1), tiny.Reverse().SignedArea().Raw)
+    assert.Equal(t, int32(1), tiny.Winding())
+    assert.Equal(t, int32(-1), tiny.Reverse().Winding())
+    assert.False(t, tiny.Contains(fp.F64Vec2FromRaw(1, 1)))
+    assert.True(t, tiny.Contains(fp.F64Vec2FromRaw(0, 1)))
+
+    big := fp.F64Polygon{
+        fp.F64Vec2FromRaw(fix64.MinValue, fix64.MinValue),
+        fp.F64Vec2FromRaw(fix64.MaxValue, fix64.MinValue),
+        fp.F64Vec2FromRaw(fix64.MinValue, fix64.MaxValue),
+    }
+    assert.Equal(t, fp.F64MaxValue, big.SignedArea())
+    assert.Equal(t, fp.F64MinValue, big.Reverse().SignedArea())
+    assert.Equal(t, int32(1), big.Winding())
+    // The hypotenuse from (max, min) to (min, max) passes through (-2^-32, 0) and (0, -2^-32).
+    assert.True(t, big.Contains(fp.F64Vec2FromRaw(-1, -1)))
+    assert.True(t, big.Contains(fp.F64Vec2FromRaw(-1, 0)))
+    assert.True(t, big.Contains(fp.F64Vec2FromRaw(0, -1)))
+    assert.False(t, big.Contains(fp.F64Vec2Zero))
+
+    // Nearly collinear points, where a rounded cross product would give zero.
+    a, b := fp.F64Vec2FromRaw(0, 0), fp.F64Vec2FromRaw(fix64.MaxValue, fix64.MaxValue-1)
+    above, below := fp.F64Vec2FromRaw(fix64.MaxValue-1, fix64.MaxValue-1), fp.F64Vec2FromRaw(fix64.MaxValue-1, fix64.MaxValue-3)
+    assert.Equal(t, int32(1), fp.F64Polygon{a, b, above}.Winding())
+    assert.Equal(t, int32(-1), fp.F64Polygon{a, b, below}.Winding())
+}
+
+func TestF64ConvexHull(t *testing.T) {
+    var grid []fp.F64Vec2
+    for y := int32(2); y >= 0; y-- {
+        for x := int32(0); x < 3; x++ {
+            grid = append(grid, v2(x, y), v2(x, y))
+        }
+    }
+    assert.Equal(t, fp.F64Polygon{v2(0, 0), v2(2, 0), v2(2, 2), v2(0, 2)}, fp.F64ConvexHull(grid))
+    assert.Equal(t, fp.F64Polygon{v2(0, 0), v2(2, 2)}, fp.F64ConvexHull([]fp.F64Vec2{v2(2, 2), v2(0, 0), v2(1, 1), v2(2, 2)}))
+    assert.Nil(t, fp.F64ConvexHull(nil))
+
+    r := rand.New(16)
+    scale := fp.F64FromInt32(1000)
+    for i := 0; i < 200; i++ {
+        points := make([]fp.F64Vec2, 3+r.Intn(30))
+        for j := range points {
+            points[j] = r.F64Vec2InDisk().MulF64(scale)
+        }
+        hull := fp.F64ConvexHull(points)
+        assert.Equal(t, int32(1), hull.Winding())
+        assert.True(t, hull.IsConvex())
+        for _, p := range points {
+            assert.True(t, hull.Contains(p))
+        }
+        tris, ok := hull.Triangulate()
+        assert.True(t, ok)
+        assert.Len(t, tris, len(hull)-2)
+        assert.InDelta(t, hull.SignedArea().Raw, triangleArea(hull, tris).Raw, float64(len(tris)))
+    }
+}
+
+func TestF64Polygon_Clip(t *testing.T) {
+    sq := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 4), v2(0, 4)}
+    clip := fp.F64Polygon{v2(2, 2), v2(6, 2), v2(6, 6), v2(2, 6)}
+    got := sq.Clip(clip)
+    assert.Equal(t, fp.F64FromInt32(4), got.SignedArea())
+    assert.Equal(t, got, sq.Clip(clip.Reverse()))
+    assert.Nil(t, sq.Clip(fp.F64Polygon{v2(5, 5), v2(6, 5), v2(6, 6)}))
+    assert.Nil(t, sq.Clip(fp.F64Polygon{v2(1, 1), v2(2, 2)}))
+    assert.Equal(t, sq, sq.Clip(sq))
+
+    // A diamond cut out of the square, with intersections in the middle of the edges.
+    diamond := fp.F64Polygon{v2(2, -1), v2(5, 2), v2(2, 5), v2(-1, 2)}
+    assert.Equal(t, fp.F64FromInt32(14), sq.Clip(diamond).SignedArea())
+
+    // The L shape clipped to its bounding box is unchanged, while a narrow band keeps both arms.
+    l := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 1), v2(1, 1), v2(1, 4), v2(0, 4)}
+    assert.Equal(t, fp.F64FromInt32(7), l.Clip(sq).SignedArea())
+    band := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 2), v2(0, 2)}
+    assert.Equal(t, fp.F64FromInt32(5), l.Clip(band).SignedArea())
+
+    // Vertices on the clip edges appear once, whether the edge enters or leaves there.
+    big := fp.F64Polygon{v2(0, 0), v2(10, 0), v2(10, 10), v2(0, 10)}
+    tri := fp.F64Polygon{v2(-20, -20), v2(20, -20), v2(20, 20)}
+    assert.Equal(t, fp.F64Polygon{v2(0, 0), v2(10, 0), v2(10, 10)}, big.Clip(tri))
+    tri = fp.F64Polygon{v2(-20, -20), v2(20, 20), v2(-20, 20)}
+    assert.Equal(t, fp.F64Polygon{v2(0, 0), v2(10, 10), v2(0, 10)}, big.Clip(tri))
+
+    // Large extents, where the edge distances no longer fit into 64 bits, still cut at the square.
+    strip := fp.F64Polygon{v2(-100, -100), v2(300000, -100), v2(300000, 100), v2(-100, 100)}
+    square := fp.F64Polygon{v2(0, 0), v2(200000, 0), v2(200000, 200000), v2(0, 200000)}
+    got = strip.Clip(square)
+    assert.Equal(t, fp.F64FromInt32(200000*100), got.SignedArea())
+    for _, v := range got {
+        assert.Contains(t, []fp.F64{fp.F64Zero, fp.F64FromInt32(200000)}, v.X())
+        assert.Contains(t, []fp.F64{fp.F64Zero, fp.F64FromInt32(100)}, v.Y())
+    }
+}
+
+func TestF64Polygon_Triangulate(t *testing.T) {
+    l := fp.F64Polygon{v2(0, 0), v2(4, 0), v2(4, 1), v2(1, 1), v2(1, 4), v2(0, 4)}
+    for _, p := range []fp.F64Polygon{l, l.Reverse()} {
+        tris, ok := p.Triangulate()
+        assert.True(t, ok)
+        assert.Len(t, tris, 4)
+        for _, tri := range tris {
+            assert.Equal(t, int32(1), fp.F64Polygon{p[tri[0]], p[tri[1]], p[tri[2]]}.Winding())
+        }
+        assert.Equal(t, fp.F64FromInt32(7), triangleArea(p, tris))
+    }
+
+    // A vertex in the middle of an edge needs no triangle of its own.
+    sq := fp.F64Polygon{v2(0, 0), v2(2, 0), v2(4, 0), v2(4, 4), v2(0, 4)}
+    tris, ok := sq.Triangulate()
+    assert.True(t, ok)
+    assert.LessOrEqual(t, len(tris), 3)
+    assert.Equal(t, fp.F64FromInt32(16), triangleArea(sq, tris))
+
+    _, ok = fp.F64Polygon{v2(0, 0), v2(2, 2), v2(2, 0), v2(0, 2)}.Triangulate()
+    assert.False(t, ok)
+
+    tris, ok = fp.F64Polygon{v2(0, 0), v2(1, 1)}.Triangulate()
+    assert.True(t, ok)
+    assert.Empty(t, tris)
+
+    // A concave star.
+    var star fp.F64Polygon
+    for i := 0; i < 10; i++ {
+        r := fp.F64FromInt32(10)
+        if i%2 == 1 {
+            r = fp.F64FromInt32(4)
+        }
+        a := fp.F64Pi.Mul(fp.F64FromInt32(int32(i))).Div(fp.F64FromInt32(5))
+        star = append(star, fp.F64Vec2FromF64(a.Cos().Mul(r), a.Sin().Mul(r)))
+    }
+    tris, ok = star.Triangulate()
+    assert.True(t, ok)
+    assert.Len(t, tris, 8)
+    assert.InDelta(t, star.SignedArea().Raw, triangleArea(star, tris).Raw, 8)
+}
+
+func TestF32Polygon(t *testing.T) {
+    p := func(x, y int32) fp.F32Vec2 {
+        return fp.F32Vec2FromInt32(x, y)
+    }
+    sq := fp.F32Polygon{p(0, 0), p(4, 0), p(4, 4), p(0, 4)}
+    assert.Equal(t, fp.F32FromInt32(16), sq.SignedArea())
+    assert.Equal(t, fp.F32FromInt32(16), sq.Reverse().Area())
+    assert.Equal(t, int32(-1), sq.Reverse().Winding())
+    assert.True(t, sq.IsConvex())
+    assert.Equal(t, p(2, 2), sq.Centroid())
+    assert.True(t, sq.Contains(p(4, 1)))
+    assert.False(t, sq.Contains(p(-1, 1)))
+    assert.Equal(t, "((0, 0), (4, 0), (4, 4), (0, 4))", fmt.Sprint(sq))
+    assert.True(t, sq.Equals(sq.Reverse().Reverse()))
+
+    assert.Equal(t, sq, fp.F32ConvexHull([]fp.F32Vec2{p(2, 2), p(0, 4), p(4, 4), p(0, 0), p(4, 0), p(2, 0)}))
+    clip := fp.F32Polygon{p(2, 2), p(6, 2), p(6, 6), p(2, 6)}
+    assert.Equal(t, fp.F32FromInt32(4), sq.Clip(clip).SignedArea())
+    tris, ok := sq.Triangulate()
+    assert.True(t, ok)
+    assert.Len(t, tris, 2)
+
+    // The area of a large square saturates instead of wrapping.
+    huge := fp.F32Polygon{p(-30000, -30000), p(30000, -30000), p(30000, 30000), p(-30000, 30000)}
+    assert.Equal(t, fp.F32MaxValue, huge.SignedArea())
+    assert.Equal(t, fp.F32MinValue, huge.Reverse().SignedArea())
+}
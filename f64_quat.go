@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix64"
 )
@@ -100,6 +99,11 @@ func FromTwoVectors(a, b F64Vec3) F64Quat {
     return FromVector(v, realPart).NormalizeFastest()
 }
 
+// F64QuatFromF32Quat Converts a 16.16 quaternion into 32.32 without losing precision.
+func F64QuatFromF32Quat(q F32Quat) F64Quat {
+    return q.F64Quat()
+}
+
 func LookRotation(dir, up F64Vec3) F64Quat {
     // From: https://answers.unity.com/questions/819699/calculate-quaternionlookrotation-manually.html
     if dir == F64Vec3Zero {
@@ -138,6 +142,11 @@ func (q F64Quat) QuatW() F64 {
     return F64FromRaw(q.RawW)
 }
 
+// F32Quat Converts the quaternion into 16.16, dropping the low 16 fraction bits.
+func (q F64Quat) F32Quat() F32Quat {
+    return F32QuatFromF64Quat(q)
+}
+
 func (q F64Quat) Mul(b F64Quat) F64Quat {
     return q.Multiply(b)
 }
@@ -177,28 +186,18 @@ func (q F64Quat) InverseUnit() F64Quat {
 }
 
 func (q F64Quat) Multiply(b F64Quat) F64Quat {
-    q1x := q.QuatX()
-    q1y := q.QuatY()
-    q1z := q.QuatZ()
-    q1w := q.QuatW()
-
-    q2x := b.QuatX()
-    q2y := b.QuatY()
-    q2z := b.QuatZ()
-    q2w := b.QuatW()
-
     // cross(av, bv)
-    cx := q1y.Mul(q2z).Sub(q1z.Mul(q2y))
-    cy := q1z.Mul(q2x).Sub(q1x.Mul(q2z))
-    cz := q1x.Mul(q2y).Sub(q1y.Mul(q2x))
+    cx := fix64.Mul(q.RawY, b.RawZ) - fix64.Mul(q.RawZ, b.RawY)
+    cy := fix64.Mul(q.RawZ, b.RawX) - fix64.Mul(q.RawX, b.RawZ)
+    cz := fix64.Mul(q.RawX, b.RawY) - fix64.Mul(q.RawY, b.RawX)
 
-    dot := q1x.Mul(q2x).Add(q1y.Mul(q2y)).Add(q1z.Mul(q2z))
+    dot := fix64.Mul(q.RawX, b.RawX) + fix64.Mul(q.RawY, b.RawY) + fix64.Mul(q.RawZ, b.RawZ)
 
-    return FromF64(
-        q1x.Mul(q2w).Add(q2x.Mul(q1w)).Add(cx),
-        q1y.Mul(q2w).Add(q2y.Mul(q1w)).Add(cy),
-        q1z.Mul(q2w).Add(q2z.Mul(q1w)).Add(cz),
-        q1w.Mul(q2w).Sub(dot),
+    return QuatFromRaw(
+        fix64.Mul(q.RawX, b.RawW)+fix64.Mul(b.RawX, q.RawW)+cx,
+        fix64.Mul(q.RawY, b.RawW)+fix64.Mul(b.RawY, q.RawW)+cy,
+        fix64.Mul(q.RawZ, b.RawW)+fix64.Mul(b.RawZ, q.RawW)+cz,
+        fix64.Mul(q.RawW, b.RawW)-dot,
     )
 }
 
@@ -249,63 +248,63 @@ func (q F64Quat) NormalizeFastest() F64Quat {
 }
 
 func (q F64Quat) Slerp(q2 F64Quat, t F64) F64Quat {
-    epsilon := F64Ratio(1, 1000000)
-    cosOmega := q.QuatX().Mul(q2.QuatX()).Add(q.QuatY().Mul(q2.QuatY())).Add(q.QuatZ().Mul(q2.QuatZ())).Add(q.QuatW().Mul(q2.QuatW()))
+    const epsilon = fix64.One / 1000000
+    cosOmega := fix64.Mul(q.RawX, q2.RawX) + fix64.Mul(q.RawY, q2.RawY) + fix64.Mul(q.RawZ, q2.RawZ) + fix64.Mul(q.RawW, q2.RawW)
 
     flip := false
 
-    if cosOmega.LT(F64FromInt32(0)) {
+    if cosOmega < 0 {
         flip = true
-        cosOmega = cosOmega.Negate()
+        cosOmega = -cosOmega
     }
 
-    var s1, s2 F64
-    if cosOmega.GT(F64One.Sub(epsilon)) {
+    var s1, s2 int64
+    if cosOmega > fix64.One-epsilon {
         // Too close, do straight linear interpolation.
-        s1 = F64One.Sub(t)
+        s1 = fix64.One - t.Raw
         if flip {
-            s2 = t.Negate()
+            s2 = -t.Raw
         } else {
-            s2 = t
+            s2 = t.Raw
         }
     } else {
-        omega := cosOmega.AcosFastest()
-        invSinOmega := omega.SinFastest().RcpFastest()
+        omega := fix64.AcosFastest(cosOmega)
+        invSinOmega := fix64.RcpFastest(fix64.SinFastest(omega))
 
-        s1 = F64One.Sub(t).Mul(omega).SinFastest().Mul(invSinOmega)
+        s1 = fix64.Mul(fix64.SinFastest(fix64.Mul(fix64.One-t.Raw, omega)), invSinOmega)
+        s2 = fix64.SinFastest(fix64.Mul(t.Raw, omega))
         if flip {
-            s2 = t.Mul(omega).SinFastest().Negate().Mul(invSinOmega)
-        } else {
-            s2 = t.Mul(omega).SinFastest().Mul(invSinOmega)
+            s2 = -s2
         }
+        s2 = fix64.Mul(s2, invSinOmega)
     }
 
-    return FromF64(
-        s1.Mul(q.QuatX()).Add(s2.Mul(q2.QuatX())),
-        s1.Mul(q.QuatY()).Add(s2.Mul(q2.QuatY())),
-        s1.Mul(q.QuatZ()).Add(s2.Mul(q2.QuatZ())),
-        s1.Mul(q.QuatW()).Add(s2.Mul(q2.QuatW())),
+    return QuatFromRaw(
+        fix64.Mul(s1, q.RawX)+fix64.Mul(s2, q2.RawX),
+        fix64.Mul(s1, q.RawY)+fix64.Mul(s2, q2.RawY),
+        fix64.Mul(s1, q.RawZ)+fix64.Mul(s2, q2.RawZ),
+        fix64.Mul(s1, q.RawW)+fix64.Mul(s2, q2.RawW),
     )
 }
 
 func (q F64Quat) Lerp(q2 F64Quat, t F64) F64Quat {
-    t1 := F64One.Sub(t)
-    dot := q.QuatX().Mul(q2.QuatX()).Add(q.QuatY().Mul(q2.QuatY())).Add(q.QuatZ().Mul(q2.QuatZ())).Add(q.QuatW().Mul(q2.QuatW()))
+    t1 := fix64.One - t.Raw
+    dot := fix64.Mul(q.RawX, q2.RawX) + fix64.Mul(q.RawY, q2.RawY) + fix64.Mul(q.RawZ, q2.RawZ) + fix64.Mul(q.RawW, q2.RawW)
 
     var r F64Quat
-    if dot.GE(F64FromInt32(0)) {
-        r = FromF64(
-            t1.Mul(q.QuatX()).Add(t.Mul(q2.QuatX())),
-            t1.Mul(q.QuatY()).Add(t.Mul(q2.QuatY())),
-            t1.Mul(q.QuatZ()).Add(t.Mul(q2.QuatZ())),
-            t1.Mul(q.QuatW()).Add(t.Mul(q2.QuatW())),
+    if dot >= 0 {
+        r = QuatFromRaw(
+            fix64.Mul(t1, q.RawX)+fix64.Mul(t.Raw, q2.RawX),
+            fix64.Mul(t1, q.RawY)+fix64.Mul(t.Raw, q2.RawY),
+            fix64.Mul(t1, q.RawZ)+fix64.Mul(t.Raw, q2.RawZ),
+            fix64.Mul(t1, q.RawW)+fix64.Mul(t.Raw, q2.RawW),
         )
     } else {
-        r = FromF64(
-            t1.Mul(q.QuatX()).Sub(t.Mul(q2.QuatX())),
-            t1.Mul(q.QuatY()).Sub(t.Mul(q2.QuatY())),
-            t1.Mul(q.QuatZ()).Sub(t.Mul(q2.QuatZ())),
-            t1.Mul(q.QuatW()).Sub(t.Mul(q2.QuatW())),
+        r = QuatFromRaw(
+            fix64.Mul(t1, q.RawX)-fix64.Mul(t.Raw, q2.RawX),
+            fix64.Mul(t1, q.RawY)-fix64.Mul(t.Raw, q2.RawY),
+            fix64.Mul(t1, q.RawZ)-fix64.Mul(t.Raw, q2.RawZ),
+            fix64.Mul(t1, q.RawW)-fix64.Mul(t.Raw, q2.RawW),
         )
     }
 
@@ -320,16 +319,31 @@ func (q F64Quat) Concatenate(q2 F64Quat) F64Quat {
 // RotateVector Rotates a vector by the unit quaternion.
 func (q F64Quat) RotateVector(v F64Vec3) F64Vec3 {
     // From https://gamedev.stackexchange.com/questions/28395/rotating-vector3-by-a-quaternion
-    u := F64Vec3FromF64(q.QuatX(), q.QuatY(), q.QuatZ())
-    s := q.QuatW()
-
-    return u.MulF64(F64Two.Mul(u.Dot(v))).Add(v.MulF64(s.Mul(s).Sub(u.Dot(u)))).Add(F64Two.Mul(s).MulVec3(u.Cross(v)))
+    // 2 dot(u, v) u + (s^2 - dot(u, u)) v + 2 s cross(u, v), with u the vector part and s the scalar part.
+    uv := (fix64.Mul(q.RawX, v.RawX) + fix64.Mul(q.RawY, v.RawY) + fix64.Mul(q.RawZ, v.RawZ)) << 1
+    ss := fix64.Mul(q.RawW, q.RawW) - (fix64.Mul(q.RawX, q.RawX) + fix64.Mul(q.RawY, q.RawY) + fix64.Mul(q.RawZ, q.RawZ))
+    s2 := q.RawW << 1
+
+    cx := fix64.Mul(q.RawY, v.RawZ) - fix64.Mul(q.RawZ, v.RawY)
+    cy := fix64.Mul(q.RawZ, v.RawX) - fix64.Mul(q.RawX, v.RawZ)
+    cz := fix64.Mul(q.RawX, v.RawY) - fix64.Mul(q.RawY, v.RawX)
+
+    return F64Vec3FromRaw(
+        fix64.Mul(q.RawX, uv)+fix64.Mul(v.RawX, ss)+fix64.Mul(s2, cx),
+        fix64.Mul(q.RawY, uv)+fix64.Mul(v.RawY, ss)+fix64.Mul(s2, cy),
+        fix64.Mul(q.RawZ, uv)+fix64.Mul(v.RawZ, ss)+fix64.Mul(s2, cz),
+    )
 }
 
 func (q F64Quat) Equals(obj F64Quat) bool {
-    return reflect.DeepEqual(q, obj)
+    return q == obj
 }
 
 func (q F64Quat) ToString() string {
     return fmt.Sprintf(`(%s, %s, %s, %s)`, fix64.ToString(q.RawX), fix64.ToString(q.RawY), fix64.ToString(q.RawZ), fix64.ToString(q.RawW))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F64.Format.
+func (q F64Quat) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, q.QuatX(), q.QuatY(), q.QuatZ(), q.QuatW())
+}
@@ -0,0 +1,199 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64Ray3 Half-line starting at Origin and extending along Dir.
+//
+// The intersection queries return the ray parameter t of the first hit, the point Origin + Dir * t, and
+// whether there is a hit at t >= 0. t is in units of Dir's length, so it is the distance from the origin
+// when Dir is a unit vector. A ray starting inside a sphere or box hits it at t = 0.
+type F64Ray3 struct {
+    Origin F64Vec3
+    Dir    F64Vec3
+}
+
+func F64Ray3FromOriginDir(origin, dir F64Vec3) F64Ray3 {
+    return F64Ray3{Origin: origin, Dir: dir}
+}
+
+// F64Ray3FromPoints Creates the ray from origin through target, with a unit direction.
+func F64Ray3FromPoints(origin, target F64Vec3) F64Ray3 {
+    return F64Ray3{Origin: origin, Dir: normalizePrecise(target.Sub(origin))}
+}
+
+// At Returns the point Origin + Dir * t.
+func (r F64Ray3) At(t F64) F64Vec3 {
+    return r.Origin.Add(r.Dir.MulF64(t))
+}
+
+// IntersectPlane Returns the point where the ray crosses the plane. A ray parallel to the plane misses it,
+// even when it lies in the plane.
+func (r F64Ray3) IntersectPlane(pl F64Plane) (F64, F64Vec3, bool) {
+    denom := pl.Normal.Dot(r.Dir)
+    if denom.Raw == 0 {
+        return F64Zero, F64Vec3Zero, false
+    }
+    t := F64FromRaw(fix64.DivSat(pl.D.Sub(pl.Normal.Dot(r.Origin)).Raw, denom.Raw))
+    if t.Raw < 0 {
+        return F64Zero, F64Vec3Zero, false
+    }
+    return t, r.At(t), true
+}
+
+// IntersectSphere Returns the first point where the ray enters the sphere.
+func (r F64Ray3) IntersectSphere(s F64Sphere) (F64, F64Vec3, bool) {
+    if distSqr(r.Origin, s.Center).cmp(wideSqrOf(absRaw(s.Radius.Raw))) <= 0 {
+        return F64Zero, r.Origin, true // inside
+    }
+    length := wideSqrOf(absRaw(r.Dir.RawX), absRaw(r.Dir.RawY), absRaw(r.Dir.RawZ)).sqrtCeil()
+    if length == 0 {
+        return F64Zero, F64Vec3Zero, false
+    }
+
+    // Move along the unit direction u to the point closest to the center, m + u tc with m = Origin - Center,
+    // whose offset q from the center is small whenever the ray can hit. The hit lies sqrt(r^2 - |q|^2)
+    // before it, and the squares are compared and subtracted exactly. m needs 65 bits and tc may leave the
+    // F64 range, so both are kept in F128.
+    u := r.Dir.DivPreciseF64(F64FromRaw(length))
+    m := [3]F128{
+        F128FromF64(r.Origin.X()).Sub(F128FromF64(s.Center.X())),
+        F128FromF64(r.Origin.Y()).Sub(F128FromF64(s.Center.Y())),
+        F128FromF64(r.Origin.Z()).Sub(F128FromF64(s.Center.Z())),
+    }
+    du := [3]F128{F128FromF64(u.X()), F128FromF64(u.Y()), F128FromF64(u.Z())}
+    tc := m[0].Mul(du[0]).Add(m[1].Mul(du[1])).Add(m[2].Mul(du[2])).Negate()
+    if tc.Sign() < 0 {
+        return F64Zero, F64Vec3Zero, false // outside and pointing away
+    }
+    radius := F128FromF64(s.Radius.Abs())
+    var h wideSum
+    h.addMul(s.Radius.Raw, s.Radius.Raw)
+    for i := range m {
+        qi := m[i].Add(du[i].Mul(tc))
+        if qi.Abs().GT(radius) {
+            return F64Zero, F64Vec3Zero, false
+        }
+        h.subMul(qi.F64().Raw, qi.F64().Raw)
+    }
+    if h.sign() < 0 {
+        return F64Zero, F64Vec3Zero, false
+    }
+    hit := tc.Sub(F128FromF64(F64FromRaw(wideSqr(h).sqrtCeil())))
+    t, ok := hit.Div(F128FromF64(F64FromRaw(length))).F64Checked()
+    switch {
+    case !ok:
+        t = F64MaxValue
+    case t.Raw < 0:
+        t = F64Zero
+    }
+    return t, r.At(t), true
+}
+
+// IntersectAABB Returns the first point where the ray enters the box, using the slab method.
+func (r F64Ray3) IntersectAABB(b F64AABB3) (F64, F64Vec3, bool) {
+    if b.IsEmpty() {
+        return F64Zero, F64Vec3Zero, false
+    }
+    if b.Contains(r.Origin) {
+        return F64Zero, r.Origin, true
+    }
+    tMin, tMax := int64(0), fix64.MaxValue
+    origin := [3]int64{r.Origin.RawX, r.Origin.RawY, r.Origin.RawZ}
+    dir := [3]int64{r.Dir.RawX, r.Dir.RawY, r.Dir.RawZ}
+    lo := [3]int64{b.Min.RawX, b.Min.RawY, b.Min.RawZ}
+    hi := [3]int64{b.Max.RawX, b.Max.RawY, b.Max.RawZ}
+    for i := 0; i < 3; i++ {
+        if dir[i] == 0 {
+            if origin[i] < lo[i] || origin[i] > hi[i] {
+                return F64Zero, F64Vec3Zero, false
+            }
+            continue
+        }
+        t1 := fix64.DivSat(fix64.SubSat(lo[i], origin[i]), dir[i])
+        t2 := fix64.DivSat(fix64.SubSat(hi[i], origin[i]), dir[i])
+        if t1 > t2 {
+            t1, t2 = t2, t1
+        }
+        tMin, tMax = fix64.Max(tMin, t1), fix64.Min(tMax, t2)
+        if tMin > tMax {
+            return F64Zero, F64Vec3Zero, false
+        }
+    }
+    // Keep the point on the box despite the rounding of t.
+    t := F64FromRaw(tMin)
+    return t, b.ClosestPoint(r.At(t)), true
+}
+
+// IntersectTriangle Returns the point where the ray crosses the triangle a, b, c from either side, using
+// the Möller-Trumbore algorithm. Edges and vertices count as hits.
+func (r F64Ray3) IntersectTriangle(a, b, c F64Vec3) (F64, F64Vec3, bool) {
+    o := [3]int64{r.Origin.RawX, r.Origin.RawY, r.Origin.RawZ}
+    d := [3]int64{r.Dir.RawX, r.Dir.RawY, r.Dir.RawZ}
+    va := [3]int64{a.RawX, a.RawY, a.RawZ}
+    vb := [3]int64{b.RawX, b.RawY, b.RawZ}
+    vc := [3]int64{c.RawX, c.RawY, c.RawZ}
+
+    // p = Dir x (c - a) and q = (Origin - a) x (b - a), exact.
+    var p, q [3]wideSum
+    for i := 0; i < 3; i++ {
+        j, k := (i+1)%3, (i+2)%3
+        p[i].addMulDiff(0, d[j], va[k], vc[k])
+        p[i].subMulDiff(0, d[k], va[j], vc[j])
+        q[i].addMulDiff(va[j], o[j], va[k], vb[k])
+        q[i].subMulDiff(va[k], o[k], va[j], vb[j])
+    }
+    // The triple products below take up to 197 bits. Near the edges of the range, drop the low bits of the
+    // cross products together so that they, and u + v - det, fit into the 192-bit sums.
+    var shift uint
+    for i := 0; i < 3; i++ {
+        for _, n := range [2]uint{p[i].bitLen(), q[i].bitLen()} {
+            if n > 123 && n-123 > shift {
+                shift = n - 123
+            }
+        }
+    }
+    for i := 0; i < 3; i++ {
+        p[i], q[i] = p[i].shr(shift), q[i].shr(shift)
+    }
+
+    // det = (b - a) . p, with the barycentric coordinates u and v, and t, all scaled by det, so that the
+    // tests need no division.
+    var det, u, v, t wideSum
+    for i := 0; i < 3; i++ {
+        det.addWideMulDiff(p[i], va[i], vb[i])
+        u.addWideMulDiff(p[i], va[i], o[i])
+        v.addWideMulDiff(q[i], 0, d[i])
+        t.addWideMulDiff(q[i], va[i], vc[i])
+    }
+    if det.sign() == 0 {
+        return F64Zero, F64Vec3Zero, false // parallel or degenerate
+    }
+    if det.sign() < 0 {
+        det, u, v, t = det.negate(), u.negate(), v.negate(), t.negate()
+    }
+    excess := u
+    excess.addSum(v, false)
+    excess.addSum(det, true)
+    if u.sign() < 0 || v.sign() < 0 || excess.sign() > 0 || t.sign() < 0 {
+        return F64Zero, F64Vec3Zero, false
+    }
+    dist := F64FromRaw(divSatWide(t, det))
+    return dist, r.At(dist), true
+}
+
+func (r F64Ray3) Equals(obj F64Ray3) bool {
+    return r == obj
+}
+
+func (r F64Ray3) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, r.Origin.ToString(), r.Dir.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting the origin and direction with the verb, see F64Vec3.Format.
+func (r F64Ray3) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, r.Origin, r.Dir)
+}
@@ -0,0 +1,231 @@
+package fp_test
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/rand"
+)
+
+func v3(x, y, z int32) fp.F64Vec3 {
+    return fp.F64Vec3FromInt32(x, y, z)
+}
+
+func TestF64Plane(t *testing.T) {
+    pl := fp.F64PlaneFromPointNormal(v3(0, 0, 2), v3(0, 0, 5))
+    assert.Equal(t, v3(0, 0, 1), pl.Normal)
+    assert.Equal(t, fp.F64Two, pl.D)
+    assert.Equal(t, fp.F64FromInt32(3), pl.SignedDistance(v3(7, -1, 5)))
+    assert.Equal(t, fp.F64FromInt32(-3), pl.SignedDistance(v3(7, -1, -1)))
+    assert.Equal(t, fp.F64FromInt32(3), pl.Distance(v3(7, -1, -1)))
+    assert.Equal(t, v3(7, -1, 2), pl.ClosestPoint(v3(7, -1, -1)))
+    assert.Equal(t, fp.F64FromInt32(-3), pl.Flip().SignedDistance(v3(7, -1, 5)))
+
+    pl, ok := fp.F64PlaneFromPoints(v3(1, 0, 0), v3(0, 1, 0), v3(0, 0, 1))
+    assert.True(t, ok)
+    assert.InDelta(t, 0.57735027, pl.Normal.X().Float64(), 1e-6)
+    assert.InDelta(t, 0.57735027, pl.D.Float64(), 1e-6)
+    _, ok = fp.F64PlaneFromPoints(v3(1, 0, 0), v3(2, 0, 0), v3(3, 0, 0))
+    assert.False(t, ok)
+    assert.Equal(t, "((0, 0, 1), 2)", fmt.Sprint(fp.F64PlaneFromNormalD(v3(0, 0, 1), fp.F64Two)))
+}
+
+func TestF64Ray3_Plane(t *testing.T) {
+    pl := fp.F64PlaneFromPointNormal(v3(0, 0, 2), v3(0, 0, 1))
+    r := fp.F64Ray3FromOriginDir(v3(1, 1, 0), v3(0, 0, 4))
+    d, p, ok := r.IntersectPlane(pl)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Half, d)
+    assert.Equal(t, v3(1, 1, 2), p)
+
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1, 1, 0), v3(0, 0, -1)).IntersectPlane(pl)
+    assert.False(t, ok) // behind
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1, 1, 0), v3(1, 0, 0)).IntersectPlane(pl)
+    assert.False(t, ok) // parallel
+}
+
+func TestF64Ray3_Sphere(t *testing.T) {
+    s := fp.F64SphereFromCenterRadius(v3(0, 0, 10), fp.F64Two)
+    r := fp.F64Ray3FromPoints(v3(0, 0, 0), v3(0, 0, 1))
+    d, p, ok := r.IntersectSphere(s)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(8), d)
+    assert.Equal(t, v3(0, 0, 8), p)
+
+    // Grazing, missing, pointing away and starting inside.
+    _, p, ok = fp.F64Ray3FromOriginDir(v3(2, 0, 0), v3(0, 0, 1)).IntersectSphere(s)
+    assert.True(t, ok)
+    assert.Equal(t, v3(2, 0, 10), p)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(3, 0, 0), v3(0, 0, 1)).IntersectSphere(s)
+    assert.False(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 0), v3(0, 0, -1)).IntersectSphere(s)
+    assert.False(t, ok)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(0, 1, 10), v3(0, 0, -1)).IntersectSphere(s)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Zero, d)
+    assert.Equal(t, v3(0, 1, 10), p)
+
+    // Far from the sphere, where the squared distances leave the F64 range.
+    unit := fp.F64SphereFromCenterRadius(v3(0, 0, 0), fp.F64One)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(50000, 0, 0), v3(1, 0, 0)).IntersectSphere(unit)
+    assert.False(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(50000, 2, 0), v3(-1, 0, 0)).IntersectSphere(unit)
+    assert.False(t, ok)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(-50000, 0, 0), v3(1000, 0, 0)).IntersectSphere(unit)
+    assert.True(t, ok)
+    assert.InDelta(t, 49.999, d.Float64(), 1e-9)
+    assert.InDelta(t, -1, p.X().Float64(), 1e-6)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 100000), v3(0, 0, -1)).IntersectSphere(s)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(99988), d)
+    assert.Equal(t, v3(0, 0, 12), p)
+
+    // Origin and center further apart than the F64 range, on either side of zero.
+    edge := fp.F64SphereFromCenterRadius(v3(2000000000, 0, 0), fp.F64FromInt32(1000000))
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(-2000000000, 0, 0), v3(1000, 0, 0)).IntersectSphere(edge)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(3999000), d)
+    assert.Equal(t, v3(1999000000, 0, 0), p)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(-2000000000, 0, 0), v3(-1, 0, 0)).IntersectSphere(edge)
+    assert.False(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(-2000000000, 1000001, 0), v3(1000, 0, 0)).IntersectSphere(edge)
+    assert.False(t, ok)
+}
+
+func TestF64Ray3_AABB(t *testing.T) {
+    b := fp.F64AABB3FromMinMax(v3(-1, -1, 4), v3(1, 1, 6))
+    r := fp.F64Ray3FromOriginDir(v3(0, 0, 0), v3(0, 0, 2))
+    d, p, ok := r.IntersectAABB(b)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Two, d)
+    assert.Equal(t, v3(0, 0, 4), p)
+
+    d, p, ok = fp.F64Ray3FromPoints(v3(-5, 0, 5), v3(0, 0, 5)).IntersectAABB(b)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(4), d)
+    assert.Equal(t, v3(-1, 0, 5), p)
+
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(2, 0, 0), v3(0, 0, 1)).IntersectAABB(b)
+    assert.False(t, ok) // parallel to the slab, outside it
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 0), v3(1, 0, 1)).IntersectAABB(b)
+    assert.False(t, ok) // passes beside
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 7), v3(0, 0, 1)).IntersectAABB(b)
+    assert.False(t, ok) // behind
+    d, _, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 5), v3(0, 0, 1)).IntersectAABB(b)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Zero, d)
+    _, p, ok = fp.F64Ray3FromOriginDir(v3(-2, 0, 3), v3(1, 1, 1)).IntersectAABB(b)
+    assert.True(t, ok)
+    assert.Equal(t, v3(-1, 1, 4), p) // through an edge
+}
+
+func TestF64Ray3_Triangle(t *testing.T) {
+    a, b, c := v3(0, 0, 5), v3(4, 0, 5), v3(0, 4, 5)
+    r := fp.F64Ray3FromOriginDir(v3(1, 1, 0), v3(0, 0, 1))
+    d, p, ok := r.IntersectTriangle(a, b, c)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(5), d)
+    assert.Equal(t, v3(1, 1, 5), p)
+
+    // Both windings hit, edges count, outside and behind miss.
+    _, _, ok = r.IntersectTriangle(a, c, b)
+    assert.True(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(2, 2, 0), v3(0, 0, 1)).IntersectTriangle(a, b, c)
+    assert.True(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(3, 2, 0), v3(0, 0, 1)).IntersectTriangle(a, b, c)
+    assert.False(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1, 1, 6), v3(0, 0, 1)).IntersectTriangle(a, b, c)
+    assert.False(t, ok)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1, 1, 0), v3(1, 0, 0)).IntersectTriangle(a, b, c)
+    assert.False(t, ok)
+
+    // Long range, where the triple products no longer fit into 64 bits.
+    a, b, c = v3(0, 0, 0), v3(2000, 0, 0), v3(0, 2000, 0)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(500, 500, 10000), v3(0, 0, -1)).IntersectTriangle(a, b, c)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(10000), d)
+    assert.Equal(t, v3(500, 500, 0), p)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1000, 1000, 10000), v3(0, 0, -1)).IntersectTriangle(a, b, c)
+    assert.True(t, ok) // on the edge
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(1000, 1001, 10000), v3(0, 0, -1)).IntersectTriangle(a, b, c)
+    assert.False(t, ok)
+    a, b, c = v3(-100000, -100000, 1000000), v3(100000, -100000, 1000000), v3(0, 100000, 1000000)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 0), v3(0, 0, 1)).IntersectTriangle(a, b, c)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(1000000), d)
+    assert.Equal(t, v3(0, 0, 1000000), p)
+    _, _, ok = fp.F64Ray3FromOriginDir(v3(0, 100001, 0), v3(0, 0, 1)).IntersectTriangle(a, b, c)
+    assert.False(t, ok)
+
+    // Across the whole range, where the cross products are scaled down.
+    a, b, c = v3(-2000000000, -2000000000, 0), v3(2000000000, -2000000000, 0), v3(0, 2000000000, 0)
+    d, p, ok = fp.F64Ray3FromOriginDir(v3(0, 0, 2000000000), v3(0, 0, -1)).IntersectTriangle(a, b, c)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(2000000000), d)
+    assert.Equal(t, v3(0, 0, 0), p)
+}
+
+func TestF64Ray3_Random(t *testing.T) {
+    // Hits lie on the shape up to rounding, and agree with the exact overlap tests.
+    rnd := rand.New(15)
+    scale := fp.F64FromInt32(100)
+    tol := fp.F64Ratio(1, 10000)
+    for i := 0; i < 300; i++ {
+        r := fp.F64Ray3FromOriginDir(rnd.F64Vec3InBall().MulF64(scale), rnd.F64Vec3OnSphere())
+        s := fp.F64SphereFromCenterRadius(rnd.F64Vec3InBall().MulF64(scale), rnd.F64().Mul(fp.F64FromInt32(50)))
+        if d, p, ok := r.IntersectSphere(s); ok {
+            assert.True(t, s.Expand(tol).Contains(p))
+            assert.True(t, r.At(d).Sub(p).Length().LE(tol))
+        }
+        b := s.AABB()
+        if _, p, ok := r.IntersectAABB(b); ok {
+            assert.True(t, b.Contains(p))
+        } else {
+            assert.False(t, b.Contains(r.Origin))
+        }
+    }
+}
+
+func TestF64Segment3(t *testing.T) {
+    s := fp.F64Segment3FromPoints(v3(0, 0, 0), v3(4, 0, 0))
+    assert.Equal(t, fp.F64FromInt32(4), s.Length())
+    u, p := s.ClosestPoint(v3(1, 3, 0))
+    assert.Equal(t, fp.F64Ratio(1, 4), u)
+    assert.Equal(t, v3(1, 0, 0), p)
+    u, p = s.ClosestPoint(v3(-3, 3, 0))
+    assert.Equal(t, fp.F64Zero, u)
+    assert.Equal(t, v3(0, 0, 0), p)
+
+    // Crossing segments.
+    sa, sb, ca, cb := s.ClosestPoints(fp.F64Segment3FromPoints(v3(1, -1, 2), v3(1, 3, 2)))
+    assert.Equal(t, fp.F64Ratio(1, 4), sa)
+    assert.Equal(t, fp.F64Ratio(1, 4), sb)
+    assert.Equal(t, v3(1, 0, 0), ca)
+    assert.Equal(t, v3(1, 0, 2), cb)
+
+    // Clamped at the end points.
+    sa, sb, ca, cb = s.ClosestPoints(fp.F64Segment3FromPoints(v3(6, 1, 0), v3(9, 5, 0)))
+    assert.Equal(t, fp.F64One, sa)
+    assert.Equal(t, fp.F64Zero, sb)
+    assert.Equal(t, v3(4, 0, 0), ca)
+    assert.Equal(t, v3(6, 1, 0), cb)
+
+    // Parallel and degenerate segments.
+    assert.Equal(t, fp.F64One, s.Distance(fp.F64Segment3FromPoints(v3(2, 1, 0), v3(7, 1, 0))))
+    assert.InDelta(t, 5, s.Distance(fp.F64Segment3FromPoints(v3(7, 4, 0), v3(7, 4, 0))).Float64(), 1e-6)
+    point := fp.F64Segment3FromPoints(v3(1, 1, 1), v3(1, 1, 1))
+    assert.Equal(t, fp.F64Zero, point.Distance(point))
+    assert.Equal(t, "((0, 0, 0), (4, 0, 0))", s.ToString())
+
+    // Long segments, whose squared lengths multiplied leave the F64 range.
+    long := fp.F64Segment3FromPoints(v3(-150, 0, 0), v3(150, 0, 0))
+    sa, sb, ca, cb = long.ClosestPoints(fp.F64Segment3FromPoints(v3(0, -150, 5), v3(0, 150, 5)))
+    assert.Equal(t, fp.F64Half, sa)
+    assert.Equal(t, fp.F64Half, sb)
+    assert.Equal(t, v3(0, 0, 0), ca)
+    assert.Equal(t, v3(0, 0, 5), cb)
+    assert.InDelta(t, 5, long.Distance(fp.F64Segment3FromPoints(v3(-3000, -4000, -5), v3(3000, 4000, -5))).Float64(), 1e-6)
+}
@@ -0,0 +1,111 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64Segment3 Line segment from A to B. Parameters t in [0, 1] select the point A + (B - A) * t.
+type F64Segment3 struct {
+    A F64Vec3
+    B F64Vec3
+}
+
+func F64Segment3FromPoints(a, b F64Vec3) F64Segment3 {
+    return F64Segment3{A: a, B: b}
+}
+
+// At Returns the point A + (B - A) * t.
+func (s F64Segment3) At(t F64) F64Vec3 {
+    return s.A.Add(s.B.Sub(s.A).MulF64(t))
+}
+
+// Length Returns the distance from A to B.
+func (s F64Segment3) Length() F64 {
+    return s.A.Distance(s.B)
+}
+
+// ClosestPoint Returns the parameter and position of the point of the segment nearest to p.
+func (s F64Segment3) ClosestPoint(p F64Vec3) (F64, F64Vec3) {
+    d := s.B.Sub(s.A)
+    dd := d.Dot(d)
+    if dd.Raw == 0 {
+        return F64Zero, s.A
+    }
+    t := clampDiv(p.Sub(s.A).Dot(d), dd)
+    return t, s.At(t)
+}
+
+// ClosestPoints Returns the parameters sa and sb, and the points ca on s and cb on s2, where the segments
+// come closest. When the segments are parallel, the pair starting from s.A is chosen where possible.
+func (s F64Segment3) ClosestPoints(s2 F64Segment3) (sa, sb F64, ca, cb F64Vec3) {
+    // Ericson, Real-Time Collision Detection, 5.1.9.
+    d1, d2, r := s.B.Sub(s.A), s2.B.Sub(s2.A), s.A.Sub(s2.A)
+    a, e, f := d1.Dot(d1), d2.Dot(d2), d2.Dot(r)
+    switch {
+    case a.Raw == 0 && e.Raw == 0:
+        sa, sb = F64Zero, F64Zero
+    case a.Raw == 0:
+        sa, sb = F64Zero, clampDiv(f, e)
+    default:
+        c := d1.Dot(r)
+        if e.Raw == 0 {
+            sa, sb = clampDiv(c.Negate(), a), F64Zero
+            break
+        }
+        // a e - b^2 and b f - c e are products of squared lengths, so they are summed exactly.
+        b := d1.Dot(d2)
+        var num, denom wideSum
+        denom.addMul(a.Raw, e.Raw)
+        denom.subMul(b.Raw, b.Raw)
+        if denom.sign() > 0 {
+            num.addMul(b.Raw, f.Raw)
+            num.subMul(c.Raw, e.Raw)
+            sa = F64FromRaw(clampFrac(num, denom))
+        } else {
+            sa = F64Zero
+        }
+        // sb for the point of s2 closest to s at sa, then sa again if sb had to be clamped.
+        tn := b.Mul(sa).Add(f)
+        switch {
+        case tn.Raw < 0:
+            sa, sb = clampDiv(c.Negate(), a), F64Zero
+        case tn.GT(e):
+            sa, sb = clampDiv(b.Sub(c), a), F64One
+        default:
+            sb = F64FromRaw(fix64.DivPrecise(tn.Raw, e.Raw))
+        }
+    }
+    return sa, sb, s.At(sa), s2.At(sb)
+}
+
+// Distance Returns the smallest distance between the segments.
+func (s F64Segment3) Distance(s2 F64Segment3) F64 {
+    _, _, ca, cb := s.ClosestPoints(s2)
+    return ca.Distance(cb)
+}
+
+func (s F64Segment3) Equals(obj F64Segment3) bool {
+    return s == obj
+}
+
+func (s F64Segment3) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, s.A.ToString(), s.B.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting A and B with the verb, see F64Vec3.Format.
+func (s F64Segment3) Format(st fmt.State, verb rune) {
+    formatTuple(st, verb, s.A, s.B)
+}
+
+// clampDiv Returns num / den clamped to [0, 1], for den > 0.
+func clampDiv(num, den F64) F64 {
+    if num.Raw <= 0 {
+        return F64Zero
+    }
+    if num.GE(den) {
+        return F64One
+    }
+    return F64FromRaw(fix64.DivPrecise(num.Raw, den.Raw))
+}
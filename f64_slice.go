@@ -0,0 +1,83 @@
+package fp
+
+import (
+    "github.com/camry/fp/fix64"
+)
+
+// Batch operations over slices of F64 and of vectors.
+//
+// The Slice types keep the values one after another (array of structs), the SoA types keep each component
+// in a slice of its own (struct of arrays) and run on the kernels of fix64, see fix64.AddSlice. Every batch
+// operation writes one result per element of dst, the receiver and the other operands must be at least as
+// long as dst and may be dst itself. The results are the same as those of the scalar methods element by
+// element.
+
+// F64Slice A slice of F64 with batch operations.
+type F64Slice []F64
+
+// cut Returns s[:n], panicking unless s holds n elements, which slicing alone would take from the capacity.
+// The compiler then knows the length of s and drops the bounds checks from the loops.
+func cut[T any](s []T, n int) []T {
+    if len(s) < n {
+        panic("fp: operand shorter than the result")
+    }
+    return s[:n]
+}
+
+// Add dst[i] = s[i] + b[i]
+func (s F64Slice) Add(dst, b F64Slice) {
+    s, b = cut(s, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Add(b[i])
+    }
+}
+
+// Sub dst[i] = s[i] - b[i]
+func (s F64Slice) Sub(dst, b F64Slice) {
+    s, b = cut(s, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Sub(b[i])
+    }
+}
+
+// Mul dst[i] = s[i] * b[i]
+func (s F64Slice) Mul(dst, b F64Slice) {
+    s, b = cut(s, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Mul(b[i])
+    }
+}
+
+// Scale dst[i] = s[i] * k
+func (s F64Slice) Scale(dst F64Slice, k F64) {
+    s = cut(s, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Mul(k)
+    }
+}
+
+// AddScaled dst[i] = s[i] + b[i] * k
+func (s F64Slice) AddScaled(dst, b F64Slice, k F64) {
+    s, b = cut(s, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Add(b[i].Mul(k))
+    }
+}
+
+// Lerp dst[i] = s[i].Lerp(b[i], t)
+func (s F64Slice) Lerp(dst, b F64Slice, t F64) {
+    s, b = cut(s, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = s[i].Lerp(b[i], t)
+    }
+}
+
+// Dot Returns the sum of s[i] * b[i], b must be at least as long as s.
+func (s F64Slice) Dot(b F64Slice) F64 {
+    b = cut(b, len(s))
+    var sum int64
+    for i := range s {
+        sum += fix64.Mul(s[i].Raw, b[i].Raw)
+    }
+    return F64FromRaw(sum)
+}
@@ -0,0 +1,223 @@
+package fp_test
+
+import (
+    "math/rand"
+    "testing"
+
+    "github.com/camry/fp"
+
+    "github.com/stretchr/testify/assert"
+)
+
+func randomF64s(r *rand.Rand, n int) fp.F64Slice {
+    s := make(fp.F64Slice, n)
+    for i := range s {
+        s[i] = fp.F64FromRaw(r.Int63n(1<<42) - 1<<41)
+    }
+    return s
+}
+
+func randomF64Vec3s(r *rand.Rand, n int) fp.F64Vec3Slice {
+    x, y, z := randomF64s(r, n), randomF64s(r, n), randomF64s(r, n)
+    v := make(fp.F64Vec3Slice, n)
+    for i := range v {
+        v[i] = fp.F64Vec3FromF64(x[i], y[i], z[i])
+    }
+    return v
+}
+
+func TestF64Slice(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    n := 101
+    a, b := randomF64s(r, n), randomF64s(r, n)
+    k, tt := fp.F64FromRaw(r.Int63n(1<<34)-1<<33), fp.F64Ratio(1, 3)
+    dst := make(fp.F64Slice, n)
+
+    a.Add(dst, b)
+    for i := range dst {
+        assert.Equal(t, a[i].Add(b[i]), dst[i])
+    }
+    a.Sub(dst, b)
+    for i := range dst {
+        assert.Equal(t, a[i].Sub(b[i]), dst[i])
+    }
+    a.Mul(dst, b)
+    for i := range dst {
+        assert.Equal(t, a[i].Mul(b[i]), dst[i])
+    }
+    a.Scale(dst, k)
+    for i := range dst {
+        assert.Equal(t, a[i].Mul(k), dst[i])
+    }
+    a.AddScaled(dst, b, k)
+    for i := range dst {
+        assert.Equal(t, a[i].Add(b[i].Mul(k)), dst[i])
+    }
+    a.Lerp(dst, b, tt)
+    for i := range dst {
+        assert.Equal(t, a[i].Lerp(b[i], tt), dst[i])
+    }
+    dot := fp.F64Zero
+    for i := range a {
+        dot = dot.Add(a[i].Mul(b[i]))
+    }
+    assert.Equal(t, dot, a.Dot(b))
+    assert.Panics(t, func() { a[:n-1].Add(dst, b) })
+}
+
+func TestF64Vec3Slice(t *testing.T) {
+    r := rand.New(rand.NewSource(2))
+    n := 101
+    a, b := randomF64Vec3s(r, n), randomF64Vec3s(r, n)
+    k, tt := fp.F64FromRaw(r.Int63n(1<<34)-1<<33), fp.F64Ratio(2, 7)
+    sa, sb := fp.F64Vec3SoAFromSlice(a), fp.F64Vec3SoAFromSlice(b)
+    assert.Equal(t, a, sa.Slice())
+    dst, sdst := make(fp.F64Vec3Slice, n), fp.NewF64Vec3SoA(n)
+
+    check := func(name string, want func(a, b fp.F64Vec3) fp.F64Vec3) {
+        for i := range dst {
+            w := want(a[i], b[i])
+            assert.Equal(t, w, dst[i], "%s %d", name, i)
+            assert.Equal(t, w, sdst.At(i), "%s SoA %d", name, i)
+        }
+    }
+    a.Add(dst, b)
+    sa.Add(sdst, sb)
+    check("Add", fp.F64Vec3.Add)
+    a.Sub(dst, b)
+    sa.Sub(sdst, sb)
+    check("Sub", fp.F64Vec3.Sub)
+    a.Scale(dst, k)
+    sa.Scale(sdst, k)
+    check("Scale", func(a, _ fp.F64Vec3) fp.F64Vec3 { return a.MulF64(k) })
+    a.AddScaled(dst, b, k)
+    sa.AddScaled(sdst, sb, k)
+    check("AddScaled", func(a, b fp.F64Vec3) fp.F64Vec3 { return a.Add(b.MulF64(k)) })
+    a.Lerp(dst, b, tt)
+    sa.Lerp(sdst, sb, tt)
+    check("Lerp", func(a, b fp.F64Vec3) fp.F64Vec3 { return a.Lerp(b, tt) })
+    a.Normalize(dst)
+    sa.Normalize(sdst)
+    check("Normalize", func(a, _ fp.F64Vec3) fp.F64Vec3 { return a.Normalize() })
+    a.NormalizeFast(dst)
+    sa.NormalizeFast(sdst)
+    check("NormalizeFast", func(a, _ fp.F64Vec3) fp.F64Vec3 { return a.NormalizeFast() })
+    a.NormalizeFastest(dst)
+    sa.NormalizeFastest(sdst)
+    check("NormalizeFastest", func(a, _ fp.F64Vec3) fp.F64Vec3 { return a.NormalizeFastest() })
+
+    dots, sdots := make(fp.F64Slice, n), make([]int64, n)
+    a.Dot(dots, b)
+    sa.Dot(sdots, sb)
+    for i := range dots {
+        assert.Equal(t, a[i].Dot(b[i]), dots[i])
+        assert.Equal(t, a[i].Dot(b[i]).Raw, sdots[i])
+    }
+
+    // In place, as positions advanced by velocities.
+    want := make(fp.F64Vec3Slice, n)
+    for i := range want {
+        want[i] = a[i].Add(b[i].MulF64(k))
+    }
+    a.AddScaled(a, b, k)
+    sa.AddScaled(sa, sb, k)
+    assert.Equal(t, want, a)
+    assert.Equal(t, want, sa.Slice())
+    sa.NormalizeFast(sa)
+    for i := range want {
+        assert.Equal(t, want[i].NormalizeFast(), sa.At(i))
+    }
+    assert.Panics(t, func() { sa.Add(fp.NewF64Vec3SoA(n+1), sb) })
+}
+
+func TestF64Vec2Slice(t *testing.T) {
+    r := rand.New(rand.NewSource(3))
+    n := 64
+    x, y := randomF64s(r, 2*n), randomF64s(r, 2*n)
+    a, b := make(fp.F64Vec2Slice, n), make(fp.F64Vec2Slice, n)
+    for i := range a {
+        a[i], b[i] = fp.F64Vec2FromF64(x[i], y[i]), fp.F64Vec2FromF64(x[n+i], y[n+i])
+    }
+    k, tt := fp.F64FromRaw(r.Int63n(1<<34)-1<<33), fp.F64Ratio(5, 9)
+    sa, sb := fp.F64Vec2SoAFromSlice(a), fp.F64Vec2SoAFromSlice(b)
+    dst, sdst := make(fp.F64Vec2Slice, n), fp.NewF64Vec2SoA(n)
+    dots, sdots := make(fp.F64Slice, n), make([]int64, n)
+
+    a.AddScaled(dst, b, k)
+    sa.AddScaled(sdst, sb, k)
+    for i := range dst {
+        assert.Equal(t, a[i].Add(b[i].MulF64(k)), dst[i])
+        assert.Equal(t, dst[i], sdst.At(i))
+    }
+    a.Lerp(dst, b, tt)
+    sa.Lerp(sdst, sb, tt)
+    for i := range dst {
+        assert.Equal(t, a[i].Lerp(b[i], tt), dst[i])
+        assert.Equal(t, dst[i], sdst.At(i))
+    }
+    a.Normalize(dst)
+    sa.Normalize(sdst)
+    for i := range dst {
+        assert.Equal(t, a[i].Normalize(), dst[i])
+        assert.Equal(t, dst[i], sdst.At(i))
+    }
+    a.Dot(dots, b)
+    sa.Dot(sdots, sb)
+    for i := range dots {
+        assert.Equal(t, a[i].Dot(b[i]), dots[i])
+        assert.Equal(t, dots[i].Raw, sdots[i])
+    }
+}
+
+func BenchmarkF64Vec3Slice(b *testing.B) {
+    r := rand.New(rand.NewSource(4))
+    pos, vel := randomF64Vec3s(r, benchInputs), randomF64Vec3s(r, benchInputs)
+    spos, svel := fp.F64Vec3SoAFromSlice(pos), fp.F64Vec3SoAFromSlice(vel)
+    dt := fp.F64Ratio(1, 60)
+    b.Run("AddScaled/Scalar", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            for j := range pos {
+                pos[j] = pos[j].Add(vel[j].MulF64(dt))
+            }
+        }
+    })
+    b.Run("AddScaled/Slice", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            pos.AddScaled(pos, vel, dt)
+        }
+    })
+    b.Run("AddScaled/SoA", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            spos.AddScaled(spos, svel, dt)
+        }
+    })
+    dst, sdst := make(fp.F64Vec3Slice, benchInputs), fp.NewF64Vec3SoA(benchInputs)
+    b.Run("NormalizeFast/Scalar", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            for j := range vel {
+                dst[j] = vel[j].NormalizeFast()
+            }
+        }
+    })
+    b.Run("NormalizeFast/Slice", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            vel.NormalizeFast(dst)
+        }
+    })
+    b.Run("NormalizeFast/SoA", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            svel.NormalizeFast(sdst)
+        }
+    })
+    dots, sdots := make(fp.F64Slice, benchInputs), make([]int64, benchInputs)
+    b.Run("Dot/Slice", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            pos.Dot(dots, vel)
+        }
+    })
+    b.Run("Dot/SoA", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            spos.Dot(sdots, svel)
+        }
+    })
+}
@@ -0,0 +1,142 @@
+package fp
+
+import (
+    "fmt"
+    "math/bits"
+
+    "github.com/camry/fp/fix64"
+)
+
+// F64Sphere Sphere given by its center and a non-negative radius, including its boundary.
+//
+// Containment and overlap tests compare squared distances exactly for any coordinates. Radii computed by
+// F64SphereFromPoints and Union are rounded up, so the results contain their inputs unless the radius
+// saturates at F64MaxValue.
+type F64Sphere struct {
+    Center F64Vec3
+    Radius F64
+}
+
+func F64SphereFromCenterRadius(center F64Vec3, radius F64) F64Sphere {
+    return F64Sphere{Center: center, Radius: radius}
+}
+
+// F64SphereFromPoints Creates a sphere containing the points, centered on their bounding box. It is not
+// the minimal sphere, but within a factor of sqrt(3) of it. No points give the zero sphere.
+func F64SphereFromPoints(points ...F64Vec3) F64Sphere {
+    if len(points) == 0 {
+        return F64Sphere{}
+    }
+    c := F64AABB3FromPoints(points...).Center()
+    var far wideSqr
+    for _, p := range points {
+        if d := distSqr(c, p); d.cmp(far) > 0 {
+            far = d
+        }
+    }
+    return F64Sphere{Center: c, Radius: F64FromRaw(far.sqrtCeil())}
+}
+
+// Contains Reports whether p is inside the sphere or on its boundary.
+func (s F64Sphere) Contains(p F64Vec3) bool {
+    return distSqr(s.Center, p).cmp(wideSqrOf(absRaw(s.Radius.Raw))) <= 0
+}
+
+// ContainsSphere Reports whether s2 lies entirely inside the sphere.
+func (s F64Sphere) ContainsSphere(s2 F64Sphere) bool {
+    if s2.Radius.Raw > s.Radius.Raw {
+        return false
+    }
+    return distSqr(s.Center, s2.Center).cmp(wideSqrOf(absDiff(s.Radius.Raw, s2.Radius.Raw))) <= 0
+}
+
+// Overlaps Reports whether the spheres share at least one point, touching boundaries included.
+func (s F64Sphere) Overlaps(s2 F64Sphere) bool {
+    r := uint64(s.Radius.Raw) + uint64(s2.Radius.Raw)
+    return distSqr(s.Center, s2.Center).cmp(wideSqrOf(r)) <= 0
+}
+
+// OverlapsAABB Reports whether the sphere and the box share at least one point.
+func (s F64Sphere) OverlapsAABB(b F64AABB3) bool {
+    if b.IsEmpty() {
+        return false
+    }
+    return s.Contains(b.ClosestPoint(s.Center))
+}
+
+// Union Returns a sphere containing both spheres. It is the smallest one, up to a few ulps of rounding.
+func (s F64Sphere) Union(s2 F64Sphere) F64Sphere {
+    if s.ContainsSphere(s2) {
+        return s
+    }
+    if s2.ContainsSphere(s) {
+        return s2
+    }
+    // The new sphere spans from the far side of s to the far side of s2, along the line of the centers.
+    d, ok := distSqr(s.Center, s2.Center).sqrtCeilU64()
+    sum, c1 := bits.Add64(d, uint64(s.Radius.Raw), 0)
+    sum, c2 := bits.Add64(sum, uint64(s2.Radius.Raw)+1, 0)
+    r := (c1+c2)<<63 | sum>>1
+    if !ok || c1+c2 > 1 || r > uint64(fix64.MaxValue)-3 {
+        return F64Sphere{Center: F64AABB3FromPoints(s.Center, s2.Center).Center(), Radius: F64MaxValue}
+    }
+    shift := r - uint64(s.Radius.Raw)
+    if shift > d {
+        shift = d
+    }
+    c := F64Vec3FromRaw(
+        lerpFrac(s.Center.RawX, s2.Center.RawX, shift, d),
+        lerpFrac(s.Center.RawY, s2.Center.RawY, shift, d),
+        lerpFrac(s.Center.RawZ, s2.Center.RawZ, shift, d),
+    )
+    // Cover the rounding of the center.
+    return F64Sphere{Center: c, Radius: F64FromRaw(int64(r) + 3)}
+}
+
+// Expand Grows the radius by margin, or shrinks it for a negative margin, clamped to [0, F64MaxValue].
+func (s F64Sphere) Expand(margin F64) F64Sphere {
+    return F64Sphere{Center: s.Center, Radius: F64FromRaw(fix64.Max(fix64.AddSat(s.Radius.Raw, margin.Raw), 0))}
+}
+
+// ClosestPoint Returns the point of the sphere nearest to p, which is p itself when it is inside.
+func (s F64Sphere) ClosestPoint(p F64Vec3) F64Vec3 {
+    if s.Contains(p) {
+        return p
+    }
+    d := uint64(distSqr(s.Center, p).sqrtCeil())
+    r := uint64(s.Radius.Raw)
+    return F64Vec3FromRaw(lerpFrac(s.Center.RawX, p.RawX, r, d), lerpFrac(s.Center.RawY, p.RawY, r, d), lerpFrac(s.Center.RawZ, p.RawZ, r, d))
+}
+
+// AABB Returns the bounding box of the sphere, clamped to the F64 range.
+func (s F64Sphere) AABB() F64AABB3 {
+    return F64AABB3FromCenterExtents(s.Center, F64Vec3FromF64(s.Radius, s.Radius, s.Radius))
+}
+
+// SurfaceArea Returns 4 pi r^2, saturated.
+func (s F64Sphere) SurfaceArea() F64 {
+    return F64Pi.MulSat(F64FromInt32(4)).MulSat(s.Radius).MulSat(s.Radius)
+}
+
+// Volume Returns 4/3 pi r^3, saturated.
+func (s F64Sphere) Volume() F64 {
+    return F64Pi.MulSat(F64Ratio(4, 3)).MulSat(s.Radius).MulSat(s.Radius).MulSat(s.Radius)
+}
+
+func (s F64Sphere) Equals(obj F64Sphere) bool {
+    return s == obj
+}
+
+func (s F64Sphere) ToString() string {
+    return fmt.Sprintf(`(%s, %s)`, s.Center.ToString(), s.Radius.ToString())
+}
+
+// Format Implements fmt.Formatter, formatting the center and radius with the verb, see F64Vec3.Format.
+func (s F64Sphere) Format(st fmt.State, verb rune) {
+    formatTuple(st, verb, s.Center, s.Radius)
+}
+
+// distSqr Returns the exact squared distance between a and b.
+func distSqr(a, b F64Vec3) wideSqr {
+    return wideSqrOf(absDiff(a.RawX, b.RawX), absDiff(a.RawY, b.RawY), absDiff(a.RawZ, b.RawZ))
+}
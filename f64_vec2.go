@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix64"
 )
@@ -126,6 +125,11 @@ func (v F64Vec2) MulF64(b F64) F64Vec2 {
     return F64Vec2FromRaw(fix64.Mul(v.RawX, b.Raw), fix64.Mul(v.RawY, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F64Vec2) Scale(k F64) F64Vec2 {
+    return v.MulF64(k)
+}
+
 // DivPreciseF64 v / b
 func (v F64Vec2) DivPreciseF64(b F64) F64Vec2 {
     return F64Vec2FromRaw(fix64.DivPrecise(v.RawX, b.Raw), fix64.DivPrecise(v.RawY, b.Raw))
@@ -282,6 +286,25 @@ func (v F64Vec2) PowFastest(b F64Vec2) F64Vec2 {
     return F64Vec2FromRaw(fix64.PowFastest(v.RawX, b.RawX), fix64.PowFastest(v.RawY, b.RawY))
 }
 
+// PowInt Each component to the integer power n.
+func (v F64Vec2) PowInt(n int32) F64Vec2 {
+    return F64Vec2FromRaw(fix64.PowInt(v.RawX, n), fix64.PowInt(v.RawY, n))
+}
+
+func (v F64Vec2) Cbrt() F64Vec2 {
+    return F64Vec2FromRaw(fix64.Cbrt(v.RawX), fix64.Cbrt(v.RawY))
+}
+
+// NthRoot The n-th root of each component.
+func (v F64Vec2) NthRoot(n int32) F64Vec2 {
+    return F64Vec2FromRaw(fix64.NthRoot(v.RawX, n), fix64.NthRoot(v.RawY, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix64.Hypot.
+func (v F64Vec2) Hypot() F64 {
+    return F64FromRaw(fix64.Hypot(v.RawX, v.RawY))
+}
+
 func (v F64Vec2) Length() F64 {
     return F64FromRaw(fix64.Sqrt(fix64.Mul(v.RawX, v.RawX) + fix64.Mul(v.RawY, v.RawY)))
 }
@@ -340,9 +363,14 @@ func (v F64Vec2) Lerp(b F64Vec2, t F64) F64Vec2 {
 }
 
 func (v F64Vec2) Equals(obj F64Vec2) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F64Vec2) ToString() string {
     return fmt.Sprintf(`(%s, %s)`, fix64.ToString(v.RawX), fix64.ToString(v.RawY))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F64.Format.
+func (v F64Vec2) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y())
+}
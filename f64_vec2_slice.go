@@ -0,0 +1,185 @@
+package fp
+
+import (
+    "github.com/camry/fp/fix64"
+)
+
+// F64Vec2Slice A slice of F64Vec2 with batch operations, see F64Slice.
+type F64Vec2Slice []F64Vec2
+
+// Add dst[i] = v[i] + b[i]
+func (v F64Vec2Slice) Add(dst, b F64Vec2Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Add(b[i])
+    }
+}
+
+// Sub dst[i] = v[i] - b[i]
+func (v F64Vec2Slice) Sub(dst, b F64Vec2Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Sub(b[i])
+    }
+}
+
+// Scale dst[i] = v[i] * k
+func (v F64Vec2Slice) Scale(dst F64Vec2Slice, k F64) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].MulF64(k)
+    }
+}
+
+// AddScaled dst[i] = v[i] + b[i] * k, such as positions advanced by velocities over a time step.
+func (v F64Vec2Slice) AddScaled(dst, b F64Vec2Slice, k F64) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Add(b[i].MulF64(k))
+    }
+}
+
+// Lerp dst[i] = v[i].Lerp(b[i], t)
+func (v F64Vec2Slice) Lerp(dst, b F64Vec2Slice, t F64) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Lerp(b[i], t)
+    }
+}
+
+// Dot dst[i] = v[i].Dot(b[i])
+func (v F64Vec2Slice) Dot(dst F64Slice, b F64Vec2Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Dot(b[i])
+    }
+}
+
+// Normalize dst[i] = v[i].Normalize()
+func (v F64Vec2Slice) Normalize(dst F64Vec2Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Normalize()
+    }
+}
+
+// NormalizeFast dst[i] = v[i].NormalizeFast()
+func (v F64Vec2Slice) NormalizeFast(dst F64Vec2Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].NormalizeFast()
+    }
+}
+
+// NormalizeFastest dst[i] = v[i].NormalizeFastest()
+func (v F64Vec2Slice) NormalizeFastest(dst F64Vec2Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].NormalizeFastest()
+    }
+}
+
+// F64Vec2SoA Vectors kept as one slice of raw values per component, all of the same length.
+type F64Vec2SoA struct {
+    RawX []int64
+    RawY []int64
+}
+
+func NewF64Vec2SoA(n int) F64Vec2SoA {
+    return F64Vec2SoA{
+        RawX: make([]int64, n),
+        RawY: make([]int64, n),
+    }
+}
+
+// F64Vec2SoAFromSlice Copies the vectors into a new F64Vec2SoA.
+func F64Vec2SoAFromSlice(v []F64Vec2) F64Vec2SoA {
+    s := NewF64Vec2SoA(len(v))
+    for i, e := range v {
+        s.Set(i, e)
+    }
+    return s
+}
+
+func (s F64Vec2SoA) Len() int {
+    return len(s.RawX)
+}
+
+func (s F64Vec2SoA) At(i int) F64Vec2 {
+    return F64Vec2FromRaw(s.RawX[i], s.RawY[i])
+}
+
+func (s F64Vec2SoA) Set(i int, v F64Vec2) {
+    s.RawX[i], s.RawY[i] = v.RawX, v.RawY
+}
+
+// Slice Copies the vectors into a new F64Vec2Slice.
+func (s F64Vec2SoA) Slice() F64Vec2Slice {
+    v := make(F64Vec2Slice, s.Len())
+    for i := range v {
+        v[i] = s.At(i)
+    }
+    return v
+}
+
+// Add dst[i] = s[i] + b[i]
+func (s F64Vec2SoA) Add(dst, b F64Vec2SoA) {
+    fix64.AddSlice(dst.RawX, s.RawX, b.RawX)
+    fix64.AddSlice(dst.RawY, s.RawY, b.RawY)
+}
+
+// Sub dst[i] = s[i] - b[i]
+func (s F64Vec2SoA) Sub(dst, b F64Vec2SoA) {
+    fix64.SubSlice(dst.RawX, s.RawX, b.RawX)
+    fix64.SubSlice(dst.RawY, s.RawY, b.RawY)
+}
+
+// Scale dst[i] = s[i] * k
+func (s F64Vec2SoA) Scale(dst F64Vec2SoA, k F64) {
+    fix64.ScaleSlice(dst.RawX, s.RawX, k.Raw)
+    fix64.ScaleSlice(dst.RawY, s.RawY, k.Raw)
+}
+
+// AddScaled dst[i] = s[i] + b[i] * k
+func (s F64Vec2SoA) AddScaled(dst, b F64Vec2SoA, k F64) {
+    fix64.AddScaledSlice(dst.RawX, s.RawX, b.RawX, k.Raw)
+    fix64.AddScaledSlice(dst.RawY, s.RawY, b.RawY, k.Raw)
+}
+
+// Lerp dst[i] = s[i].Lerp(b[i], t)
+func (s F64Vec2SoA) Lerp(dst, b F64Vec2SoA, t F64) {
+    // fix64.Lerp weighs its first operand by t.
+    fix64.LerpSlice(dst.RawX, b.RawX, s.RawX, t.Raw)
+    fix64.LerpSlice(dst.RawY, b.RawY, s.RawY, t.Raw)
+}
+
+// Dot dst[i] = s[i].Dot(b[i]).Raw
+func (s F64Vec2SoA) Dot(dst []int64, b F64Vec2SoA) {
+    fix64.MulSlice(dst, s.RawX, b.RawX)
+    fix64.MulAddSlice(dst, dst, s.RawY, b.RawY)
+}
+
+// Normalize dst[i] = s[i].Normalize()
+func (s F64Vec2SoA) Normalize(dst F64Vec2SoA) {
+    s.normalize(dst, fix64.RSqrt)
+}
+
+// NormalizeFast dst[i] = s[i].NormalizeFast()
+func (s F64Vec2SoA) NormalizeFast(dst F64Vec2SoA) {
+    s.normalize(dst, fix64.RSqrtFast)
+}
+
+// NormalizeFastest dst[i] = s[i].NormalizeFastest()
+func (s F64Vec2SoA) NormalizeFastest(dst F64Vec2SoA) {
+    s.normalize(dst, fix64.RSqrtFastest)
+}
+
+func (s F64Vec2SoA) normalize(dst F64Vec2SoA, rsqrt func(int64) int64) {
+    n := dst.Len()
+    x, y := cut(s.RawX, n), cut(s.RawY, n)
+    dx, dy := cut(dst.RawX, n), cut(dst.RawY, n)
+    for i := range x {
+        ooLen := rsqrt(fix64.Mul(x[i], x[i]) + fix64.Mul(y[i], y[i]))
+        dx[i], dy[i] = fix64.Mul(ooLen, x[i]), fix64.Mul(ooLen, y[i])
+    }
+}
@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix64"
 )
@@ -75,7 +74,7 @@ func F64Vec3Min(v0 F64Vec3, v1 F64Vec3) F64Vec3 {
 //
 // This makes it harder to accidentally call F64Vec3Max with 0 arguments.
 func F64Vec3Max(v0 F64Vec3, v1 F64Vec3) F64Vec3 {
-    return F64Vec3FromRaw(fix64.Max(v0.RawX, v1.RawX), fix64.Max(v0.RawY, v1.RawY), fix64.Min(v0.RawZ, v1.RawZ))
+    return F64Vec3FromRaw(fix64.Max(v0.RawX, v1.RawX), fix64.Max(v0.RawY, v1.RawY), fix64.Max(v0.RawZ, v1.RawZ))
 }
 
 func (v F64Vec3) X() F64 {
@@ -135,6 +134,11 @@ func (v F64Vec3) MulF64(b F64) F64Vec3 {
     return F64Vec3FromRaw(fix64.Mul(v.RawX, b.Raw), fix64.Mul(v.RawY, b.Raw), fix64.Mul(v.RawZ, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F64Vec3) Scale(k F64) F64Vec3 {
+    return v.MulF64(k)
+}
+
 // DivPreciseF64 v / b
 func (v F64Vec3) DivPreciseF64(b F64) F64Vec3 {
     return F64Vec3FromRaw(fix64.DivPrecise(v.RawX, b.Raw), fix64.DivPrecise(v.RawY, b.Raw), fix64.DivPrecise(v.RawZ, b.Raw))
@@ -303,6 +307,25 @@ func (v F64Vec3) PowFastest(b F64Vec3) F64Vec3 {
     return F64Vec3FromRaw(fix64.PowFastest(v.RawX, b.RawX), fix64.PowFastest(v.RawY, b.RawY), fix64.PowFastest(v.RawZ, b.RawZ))
 }
 
+// PowInt Each component to the integer power n.
+func (v F64Vec3) PowInt(n int32) F64Vec3 {
+    return F64Vec3FromRaw(fix64.PowInt(v.RawX, n), fix64.PowInt(v.RawY, n), fix64.PowInt(v.RawZ, n))
+}
+
+func (v F64Vec3) Cbrt() F64Vec3 {
+    return F64Vec3FromRaw(fix64.Cbrt(v.RawX), fix64.Cbrt(v.RawY), fix64.Cbrt(v.RawZ))
+}
+
+// NthRoot The n-th root of each component.
+func (v F64Vec3) NthRoot(n int32) F64Vec3 {
+    return F64Vec3FromRaw(fix64.NthRoot(v.RawX, n), fix64.NthRoot(v.RawY, n), fix64.NthRoot(v.RawZ, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix64.Hypot3.
+func (v F64Vec3) Hypot() F64 {
+    return F64FromRaw(fix64.Hypot3(v.RawX, v.RawY, v.RawZ))
+}
+
 func (v F64Vec3) Length() F64 {
     return F64FromRaw(fix64.Sqrt(fix64.Mul(v.RawX, v.RawX) + fix64.Mul(v.RawY, v.RawY) + fix64.Mul(v.RawZ, v.RawZ)))
 }
@@ -365,9 +388,14 @@ func (v F64Vec3) Cross(b F64Vec3) F64Vec3 {
 }
 
 func (v F64Vec3) Equals(obj F64Vec3) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F64Vec3) ToString() string {
     return fmt.Sprintf(`(%s, %s, %s)`, fix64.ToString(v.RawX), fix64.ToString(v.RawY), fix64.ToString(v.RawZ))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F64.Format.
+func (v F64Vec3) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y(), v.Z())
+}
@@ -0,0 +1,193 @@
+package fp
+
+import (
+    "github.com/camry/fp/fix64"
+)
+
+// F64Vec3Slice A slice of F64Vec3 with batch operations, see F64Slice.
+type F64Vec3Slice []F64Vec3
+
+// Add dst[i] = v[i] + b[i]
+func (v F64Vec3Slice) Add(dst, b F64Vec3Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Add(b[i])
+    }
+}
+
+// Sub dst[i] = v[i] - b[i]
+func (v F64Vec3Slice) Sub(dst, b F64Vec3Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Sub(b[i])
+    }
+}
+
+// Scale dst[i] = v[i] * k
+func (v F64Vec3Slice) Scale(dst F64Vec3Slice, k F64) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].MulF64(k)
+    }
+}
+
+// AddScaled dst[i] = v[i] + b[i] * k, such as positions advanced by velocities over a time step.
+func (v F64Vec3Slice) AddScaled(dst, b F64Vec3Slice, k F64) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Add(b[i].MulF64(k))
+    }
+}
+
+// Lerp dst[i] = v[i].Lerp(b[i], t)
+func (v F64Vec3Slice) Lerp(dst, b F64Vec3Slice, t F64) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Lerp(b[i], t)
+    }
+}
+
+// Dot dst[i] = v[i].Dot(b[i])
+func (v F64Vec3Slice) Dot(dst F64Slice, b F64Vec3Slice) {
+    v, b = cut(v, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Dot(b[i])
+    }
+}
+
+// Normalize dst[i] = v[i].Normalize()
+func (v F64Vec3Slice) Normalize(dst F64Vec3Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].Normalize()
+    }
+}
+
+// NormalizeFast dst[i] = v[i].NormalizeFast()
+func (v F64Vec3Slice) NormalizeFast(dst F64Vec3Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].NormalizeFast()
+    }
+}
+
+// NormalizeFastest dst[i] = v[i].NormalizeFastest()
+func (v F64Vec3Slice) NormalizeFastest(dst F64Vec3Slice) {
+    v = cut(v, len(dst))
+    for i := range dst {
+        dst[i] = v[i].NormalizeFastest()
+    }
+}
+
+// F64Vec3SoA Vectors kept as one slice of raw values per component, all of the same length.
+type F64Vec3SoA struct {
+    RawX []int64
+    RawY []int64
+    RawZ []int64
+}
+
+func NewF64Vec3SoA(n int) F64Vec3SoA {
+    return F64Vec3SoA{
+        RawX: make([]int64, n),
+        RawY: make([]int64, n),
+        RawZ: make([]int64, n),
+    }
+}
+
+// F64Vec3SoAFromSlice Copies the vectors into a new F64Vec3SoA.
+func F64Vec3SoAFromSlice(v []F64Vec3) F64Vec3SoA {
+    s := NewF64Vec3SoA(len(v))
+    for i, e := range v {
+        s.Set(i, e)
+    }
+    return s
+}
+
+func (s F64Vec3SoA) Len() int {
+    return len(s.RawX)
+}
+
+func (s F64Vec3SoA) At(i int) F64Vec3 {
+    return F64Vec3FromRaw(s.RawX[i], s.RawY[i], s.RawZ[i])
+}
+
+func (s F64Vec3SoA) Set(i int, v F64Vec3) {
+    s.RawX[i], s.RawY[i], s.RawZ[i] = v.RawX, v.RawY, v.RawZ
+}
+
+// Slice Copies the vectors into a new F64Vec3Slice.
+func (s F64Vec3SoA) Slice() F64Vec3Slice {
+    v := make(F64Vec3Slice, s.Len())
+    for i := range v {
+        v[i] = s.At(i)
+    }
+    return v
+}
+
+// Add dst[i] = s[i] + b[i]
+func (s F64Vec3SoA) Add(dst, b F64Vec3SoA) {
+    fix64.AddSlice(dst.RawX, s.RawX, b.RawX)
+    fix64.AddSlice(dst.RawY, s.RawY, b.RawY)
+    fix64.AddSlice(dst.RawZ, s.RawZ, b.RawZ)
+}
+
+// Sub dst[i] = s[i] - b[i]
+func (s F64Vec3SoA) Sub(dst, b F64Vec3SoA) {
+    fix64.SubSlice(dst.RawX, s.RawX, b.RawX)
+    fix64.SubSlice(dst.RawY, s.RawY, b.RawY)
+    fix64.SubSlice(dst.RawZ, s.RawZ, b.RawZ)
+}
+
+// Scale dst[i] = s[i] * k
+func (s F64Vec3SoA) Scale(dst F64Vec3SoA, k F64) {
+    fix64.ScaleSlice(dst.RawX, s.RawX, k.Raw)
+    fix64.ScaleSlice(dst.RawY, s.RawY, k.Raw)
+    fix64.ScaleSlice(dst.RawZ, s.RawZ, k.Raw)
+}
+
+// AddScaled dst[i] = s[i] + b[i] * k
+func (s F64Vec3SoA) AddScaled(dst, b F64Vec3SoA, k F64) {
+    fix64.AddScaledSlice(dst.RawX, s.RawX, b.RawX, k.Raw)
+    fix64.AddScaledSlice(dst.RawY, s.RawY, b.RawY, k.Raw)
+    fix64.AddScaledSlice(dst.RawZ, s.RawZ, b.RawZ, k.Raw)
+}
+
+// Lerp dst[i] = s[i].Lerp(b[i], t)
+func (s F64Vec3SoA) Lerp(dst, b F64Vec3SoA, t F64) {
+    // fix64.Lerp weighs its first operand by t.
+    fix64.LerpSlice(dst.RawX, b.RawX, s.RawX, t.Raw)
+    fix64.LerpSlice(dst.RawY, b.RawY, s.RawY, t.Raw)
+    fix64.LerpSlice(dst.RawZ, b.RawZ, s.RawZ, t.Raw)
+}
+
+// Dot dst[i] = s[i].Dot(b[i]).Raw
+func (s F64Vec3SoA) Dot(dst []int64, b F64Vec3SoA) {
+    fix64.MulSlice(dst, s.RawX, b.RawX)
+    fix64.MulAddSlice(dst, dst, s.RawY, b.RawY)
+    fix64.MulAddSlice(dst, dst, s.RawZ, b.RawZ)
+}
+
+// Normalize dst[i] = s[i].Normalize()
+func (s F64Vec3SoA) Normalize(dst F64Vec3SoA) {
+    s.normalize(dst, fix64.RSqrt)
+}
+
+// NormalizeFast dst[i] = s[i].NormalizeFast()
+func (s F64Vec3SoA) NormalizeFast(dst F64Vec3SoA) {
+    s.normalize(dst, fix64.RSqrtFast)
+}
+
+// NormalizeFastest dst[i] = s[i].NormalizeFastest()
+func (s F64Vec3SoA) NormalizeFastest(dst F64Vec3SoA) {
+    s.normalize(dst, fix64.RSqrtFastest)
+}
+
+func (s F64Vec3SoA) normalize(dst F64Vec3SoA, rsqrt func(int64) int64) {
+    n := dst.Len()
+    x, y, z := cut(s.RawX, n), cut(s.RawY, n), cut(s.RawZ, n)
+    dx, dy, dz := cut(dst.RawX, n), cut(dst.RawY, n), cut(dst.RawZ, n)
+    for i := range x {
+        ooLen := rsqrt(fix64.Mul(x[i], x[i]) + fix64.Mul(y[i], y[i]) + fix64.Mul(z[i], z[i]))
+        dx[i], dy[i], dz[i] = fix64.Mul(ooLen, x[i]), fix64.Mul(ooLen, y[i]), fix64.Mul(ooLen, z[i])
+    }
+}
@@ -2,7 +2,6 @@ package fp
 
 import (
     "fmt"
-    "reflect"
 
     "github.com/camry/fp/fix64"
 )
@@ -136,6 +135,11 @@ func (v F64Vec4) MulF64(b F64) F64Vec4 {
     return F64Vec4FromRaw(fix64.Mul(v.RawX, b.Raw), fix64.Mul(v.RawY, b.Raw), fix64.Mul(v.RawZ, b.Raw), fix64.Mul(v.RawW, b.Raw))
 }
 
+// Scale v * k, see Vector
+func (v F64Vec4) Scale(k F64) F64Vec4 {
+    return v.MulF64(k)
+}
+
 // DivPreciseF64 v / b
 func (v F64Vec4) DivPreciseF64(b F64) F64Vec4 {
     return F64Vec4FromRaw(fix64.DivPrecise(v.RawX, b.Raw), fix64.DivPrecise(v.RawY, b.Raw), fix64.DivPrecise(v.RawZ, b.Raw), fix64.DivPrecise(v.RawW, b.Raw))
@@ -292,6 +296,25 @@ func (v F64Vec4) PowFastest(b F64Vec4) F64Vec4 {
     return F64Vec4FromRaw(fix64.PowFastest(v.RawX, b.RawX), fix64.PowFastest(v.RawY, b.RawY), fix64.PowFastest(v.RawZ, b.RawZ), fix64.PowFastest(v.RawW, b.RawW))
 }
 
+// PowInt Each component to the integer power n.
+func (v F64Vec4) PowInt(n int32) F64Vec4 {
+    return F64Vec4FromRaw(fix64.PowInt(v.RawX, n), fix64.PowInt(v.RawY, n), fix64.PowInt(v.RawZ, n), fix64.PowInt(v.RawW, n))
+}
+
+func (v F64Vec4) Cbrt() F64Vec4 {
+    return F64Vec4FromRaw(fix64.Cbrt(v.RawX), fix64.Cbrt(v.RawY), fix64.Cbrt(v.RawZ), fix64.Cbrt(v.RawW))
+}
+
+// NthRoot The n-th root of each component.
+func (v F64Vec4) NthRoot(n int32) F64Vec4 {
+    return F64Vec4FromRaw(fix64.NthRoot(v.RawX, n), fix64.NthRoot(v.RawY, n), fix64.NthRoot(v.RawZ, n), fix64.NthRoot(v.RawW, n))
+}
+
+// Hypot The length of v without overflow in the squares of the components, see fix64.Hypot4.
+func (v F64Vec4) Hypot() F64 {
+    return F64FromRaw(fix64.Hypot4(v.RawX, v.RawY, v.RawZ, v.RawW))
+}
+
 func (v F64Vec4) Length() F64 {
     return F64FromRaw(fix64.Sqrt(fix64.Mul(v.RawX, v.RawX) + fix64.Mul(v.RawY, v.RawY) + fix64.Mul(v.RawZ, v.RawZ) + fix64.Mul(v.RawW, v.RawW)))
 }
@@ -350,9 +373,14 @@ func (v F64Vec4) Lerp(b F64Vec4, t F64) F64Vec4 {
 }
 
 func (v F64Vec4) Equals(obj F64Vec4) bool {
-    return reflect.DeepEqual(v, obj)
+    return v == obj
 }
 
 func (v F64Vec4) ToString() string {
     return fmt.Sprintf(`(%s, %s, %s, %s)`, fix64.ToString(v.RawX), fix64.ToString(v.RawY), fix64.ToString(v.RawZ), fix64.ToString(v.RawW))
 }
+
+// Format Implements fmt.Formatter, formatting each component with the verb, see F64.Format.
+func (v F64Vec4) Format(s fmt.State, verb rune) {
+    formatTuple(s, verb, v.X(), v.Y(), v.Z(), v.W())
+}
@@ -1,6 +1,8 @@
 package fp_test
 
 import (
+    "fmt"
+    "math"
     "testing"
 
     "github.com/camry/fp"
@@ -50,3 +52,186 @@ func TestF64_Pow(t *testing.T) {
     f1 := fp.F64FromInt32(1).Add(fp.F64FromFloat32(0.08)).Pow(fp.F64FromInt32(3))
     assert.Equal(t, f1.Float32(), float32(1.259712))
 }
+
+func TestF64_PowInt(t *testing.T) {
+    x := fp.F64FromInt32(1).Add(fp.F64FromFloat32(0.08))
+    assert.Equal(t, x.Pow(fp.F64FromInt32(3)), x.PowInt(3))
+    assert.Equal(t, fp.F64FromInt32(-27), fp.F64FromInt32(-3).PowInt(3))
+    assert.Equal(t, fp.F64FromInt32(-27), fp.F64FromInt32(-3).Pow(fp.F64FromInt32(3)))
+    assert.Equal(t, fp.F64FromInt32(-3), fp.F64FromInt32(-27).Cbrt())
+    assert.Equal(t, fp.F64FromInt32(-2), fp.F64FromInt32(-32).NthRoot(5))
+    assert.Equal(t, fp.F64FromInt32(5), fp.F64FromInt32(3).Hypot(fp.F64FromInt32(-4)))
+    assert.Equal(t, fp.F64MaxValue, fp.F64FromInt32(2000000000).Hypot(fp.F64FromInt32(2000000000)))
+
+    v := fp.F64Vec3FromInt32(-2, 8, 27)
+    assert.Equal(t, fp.F64Vec3FromInt32(4, 64, 729), v.PowInt(2))
+    assert.Equal(t, fp.F64Vec3FromInt32(-8, 512, 19683), v.Pow(fp.F64Vec3FromInt32(3, 3, 3)))
+    assert.Equal(t, fp.F64Vec3FromInt32(-2, 8, 27), v.PowInt(3).Cbrt())
+    assert.Equal(t, fp.F64Vec3FromInt32(-2, 4, 3), fp.F64Vec3FromInt32(-8, 64, 27).Cbrt())
+    assert.Equal(t, fp.F64Vec3FromInt32(0, 2, 0), fp.F64Vec3FromInt32(-4, 4, 0).NthRoot(2))
+    assert.Equal(t, fp.F64FromInt32(13), fp.F64Vec3FromInt32(3, 4, 12).Hypot())
+    assert.Equal(t, fp.F64FromInt32(13), fp.F64Vec2FromInt32(-5, 12).Hypot())
+    assert.Equal(t, fp.F64FromInt32(2), fp.F64Vec4FromInt32(1, -1, 1, -1).Hypot())
+    assert.Equal(t, fp.F64FromInt32(2000000000), fp.F64Vec3FromInt32(1200000000, 0, -1600000000).Hypot())
+}
+
+func TestF64_Hyperbolic(t *testing.T) {
+    x := fp.F64FromFloat64(0.75)
+    sin, cos := x.SinCos()
+    assert.Equal(t, x.Sin(), sin)
+    assert.InDelta(t, math.Cos(0.75), cos.Float64(), 1e-4)
+    assert.InDelta(t, math.Sinh(0.75), x.Sinh().Float64(), 1e-4)
+    assert.InDelta(t, math.Cosh(0.75), x.CoshFast().Float64(), 1e-4)
+    assert.InDelta(t, math.Tanh(0.75), x.TanhFastest().Float64(), 1e-3)
+    assert.InDelta(t, math.Asinh(0.75), x.Asinh().Float64(), 1e-4)
+    assert.InDelta(t, math.Atanh(0.75), x.Atanh().Float64(), 1e-4)
+    assert.InDelta(t, math.Acosh(1.75), x.Add(fp.F64One).Acosh().Float64(), 1e-4)
+    assert.Equal(t, fp.F64Zero, x.Acosh())
+    assert.Equal(t, fp.F64Zero, fp.F64One.Atanh())
+}
+
+func TestF64Mat3_FromQuat(t *testing.T) {
+    q := fp.FromYawPitchRoll(fp.F64FromFloat64(0.4), fp.F64FromFloat64(-0.7), fp.F64FromFloat64(1.3)).Normalize()
+    v := fp.F64Vec3FromFloat64(1.5, -2, 0.25)
+    a := fp.F64Mat3FromQuat(q).MulVec3(v)
+    b := q.RotateVector(v)
+    assert.InDelta(t, a.X().Float64(), b.X().Float64(), 0.00001)
+    assert.InDelta(t, a.Y().Float64(), b.Y().Float64(), 0.00001)
+    assert.InDelta(t, a.Z().Float64(), b.Z().Float64(), 0.00001)
+}
+
+func TestF64Mat3_Inverse(t *testing.T) {
+    m := fp.F64Mat3FromRaw(
+        fp.F64FromInt32(2).Raw, fp.F64FromInt32(-1).Raw, 0,
+        fp.F64FromInt32(-1).Raw, fp.F64FromInt32(2).Raw, fp.F64FromInt32(-1).Raw,
+        0, fp.F64FromInt32(-1).Raw, fp.F64FromInt32(2).Raw,
+    )
+    assert.Equal(t, m.Determinant(), fp.F64FromInt32(4))
+    inv, ok := m.Inverse()
+    assert.True(t, ok)
+    assert.Equal(t, inv.At(0, 0), fp.F64Ratio(3, 4))
+    assert.Equal(t, inv.At(1, 1), fp.F64One)
+    assert.Equal(t, m.Mul(inv), fp.F64Mat3Identity)
+
+    _, ok = fp.F64Mat3FromRows(fp.F64Vec3One, fp.F64Vec3One, fp.F64Vec3AxisZ).Inverse()
+    assert.False(t, ok)
+
+    // Nearly singular: det is a single ulp and the inverse leaves the F64 range, while a small scale fits.
+    one := fp.F64One.Raw
+    _, ok = fp.F64Mat3FromRows(fp.F64Vec3FromRaw(one, one, 0), fp.F64Vec3FromRaw(one, one+1, 0), fp.F64Vec3AxisZ).Inverse()
+    assert.False(t, ok)
+    inv, ok = fp.F64Mat3FromScale(fp.F64Vec3FromRaw(one, one>>10, one)).Inverse()
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64FromInt32(1024), inv.At(1, 1))
+}
+
+func TestF64Mat4_TRS(t *testing.T) {
+    tr := fp.F64Vec3FromFloat64(10, -3.5, 7)
+    rot := fp.FromAxisAngle(fp.F64Vec3AxisY, fp.F64FromFloat64(0.9)).Normalize()
+    sc := fp.F64Vec3FromFloat64(2, 0.5, 3)
+    m := fp.F64Mat4FromTRS(tr, rot, sc)
+
+    p := fp.F64Vec3FromFloat64(1, 2, 3)
+    want := rot.RotateVector(p.Mul(sc)).Add(tr)
+    got := m.TransformPoint(p)
+    assert.InDelta(t, got.X().Float64(), want.X().Float64(), 0.00001)
+    assert.InDelta(t, got.Y().Float64(), want.Y().Float64(), 0.00001)
+    assert.InDelta(t, got.Z().Float64(), want.Z().Float64(), 0.00001)
+    assert.Equal(t, m.TransformDirection(p), m.TransformPoint(p).Sub(tr))
+
+    inv, ok := m.Inverse()
+    assert.True(t, ok)
+    back := inv.TransformPoint(got)
+    assert.InDelta(t, back.X().Float64(), 1, 0.00001)
+    assert.InDelta(t, back.Y().Float64(), 2, 0.00001)
+    assert.InDelta(t, back.Z().Float64(), 3, 0.00001)
+
+    dt, dr, ds, ok := m.Decompose()
+    assert.True(t, ok)
+    assert.Equal(t, dt, tr)
+    assert.InDelta(t, ds.X().Float64(), 2, 0.00001)
+    assert.InDelta(t, ds.Y().Float64(), 0.5, 0.00001)
+    assert.InDelta(t, ds.Z().Float64(), 3, 0.00001)
+    assert.InDelta(t, dr.QuatY().Float64(), rot.QuatY().Float64(), 0.00001)
+    assert.InDelta(t, dr.QuatW().Float64(), rot.QuatW().Float64(), 0.00001)
+
+    _, ok = fp.F64Mat4FromScale(fp.F64Vec3FromInt32(1, 0, 1)).Inverse()
+    assert.False(t, ok)
+    _, ok = fp.F64Mat4FromScale(fp.F64Vec3FromRaw(fp.F64One.Raw, 1, fp.F64One.Raw)).Inverse()
+    assert.False(t, ok) // nearly singular, the inverse leaves the F64 range
+}
+
+func TestF64_Checked(t *testing.T) {
+    _, ok := fp.F64MaxValue.AddChecked(fp.F64One)
+    assert.False(t, ok)
+    r, ok := fp.F64FromInt32(3).MulChecked(fp.F64Half)
+    assert.True(t, ok)
+    assert.Equal(t, r, fp.F64Ratio(3, 2))
+    _, ok = fp.F64One.DivChecked(fp.F64Zero)
+    assert.False(t, ok)
+    assert.Equal(t, fp.F64FromInt32(2147483647).MulSat(fp.F64Two), fp.F64MaxValue)
+    assert.Equal(t, fp.F64MinValue.SubSat(fp.F64One), fp.F64MinValue)
+}
+
+func TestF64_Round(t *testing.T) {
+    ulp := fp.F64FromRaw(1)
+    assert.Equal(t, fp.F64Zero, ulp.MulRound(fp.F64Half, fp.RoundNearestEven))
+    assert.Equal(t, ulp, ulp.MulRound(fp.F64Half, fp.RoundNearestAway))
+    assert.Equal(t, ulp.Negate(), ulp.Negate().MulRound(fp.F64Half, fp.RoundFloor))
+    assert.Equal(t, fp.F64Zero, ulp.Negate().MulRound(fp.F64Half, fp.RoundTowardZero))
+    assert.Equal(t, fp.F64FromRaw(2), fp.F64FromRaw(3).DivRound(fp.F64Two, fp.RoundNearestEven))
+    assert.Equal(t, fp.F64FromRaw(1), fp.F64FromRaw(3).DivRound(fp.F64Two, fp.RoundTowardZero))
+    assert.Equal(t, fp.F64MaxValue, fp.F64One.DivRound(ulp, fp.RoundCeil))
+    assert.Equal(t, "RoundNearestEven", fp.RoundNearestEven.String())
+}
+
+func TestF64FromString(t *testing.T) {
+    f, err := fp.F64FromString("-1.25")
+    assert.NoError(t, err)
+    assert.Equal(t, f, fp.F64Ratio(-5, 4))
+    f, err = fp.F64FromString(fp.F64Pi.ToString())
+    assert.NoError(t, err)
+    assert.Equal(t, f, fp.F64Pi)
+    _, err = fp.F64FromString("1.0f")
+    assert.Error(t, err)
+}
+
+func TestF64_Format(t *testing.T) {
+    f := fp.F64Ratio(-5, 4)
+    assert.Equal(t, "-1.25", fmt.Sprint(f))
+    assert.Equal(t, "-1.25", fmt.Sprintf("%v", f))
+    assert.Equal(t, "-1.250", fmt.Sprintf("%.3f", f))
+    assert.Equal(t, "-1.250000", fmt.Sprintf("%f", f))
+    assert.Equal(t, "+1.2", fmt.Sprintf("%+.1f", fp.F64Ratio(5, 4)))
+    assert.Equal(t, "  -1.25", fmt.Sprintf("%7v", f))
+    assert.Equal(t, "-001.25", fmt.Sprintf("%07v", f))
+    assert.Equal(t, "-1.25  ", fmt.Sprintf("%-7v", f))
+    assert.Equal(t, "-140000000", fmt.Sprintf("%x", f))
+    assert.Equal(t, "0X3243F6A89", fmt.Sprintf("%#X", fp.F64Pi))
+    assert.Equal(t, "%!d(fp.F64=-1.25)", fmt.Sprintf("%d", f))
+    assert.Equal(t, "3.14159", fp.F64Pi.Text('f', 5))
+    assert.Equal(t, "0x100000000", fp.F64One.Text('x', -1))
+
+    v := fp.F64Vec3FromInt32(1, -2, 3).DivF64(fp.F64Two)
+    assert.Equal(t, "(0.5, -1, 1.5)", fmt.Sprint(v))
+    assert.Equal(t, "(0.50, -1.00, 1.50)", fmt.Sprintf("%.2f", v))
+    assert.Equal(t, "(80000000, -100000000, 180000000)", fmt.Sprintf("%x", v))
+    assert.Equal(t, "(0, 0, 0, 1)", fmt.Sprint(fp.Identity))
+    assert.Equal(t, "(1, 2)", fmt.Sprint(fp.F64Vec2FromInt32(1, 2)))
+    assert.Equal(t, "(1, 2, 3, 4)", fmt.Sprint(fp.F64Vec4FromInt32(1, 2, 3, 4)))
+}
+
+func TestF64_Allocs(t *testing.T) {
+    a, b := fp.FromYawPitchRoll(fp.F64One, fp.F64Half, fp.F64Two), fp.FromYawPitchRoll(fp.F64Pi, fp.F64One, fp.F64Half)
+    v := fp.F64Vec3FromInt32(1, -2, 3)
+    m := fp.F64Mat3FromQuat(a)
+    assert.Zero(t, testing.AllocsPerRun(100, func() {
+        boolSink = fp.F64One.Equals(fp.F64Two) || v.Equals(v.Negate()) || fp.F64Vec2One.Equals(fp.F64Vec2Zero) ||
+            fp.F64Vec4One.Equals(fp.F64Vec4Zero) || a.Equals(b) || m.Equals(fp.F64Mat3Identity) || fp.F64Mat4Identity.Equals(fp.F64Mat4Zero)
+        f64QuatSink = a.Multiply(b).Slerp(b, fp.F64Half).Lerp(a, fp.F64Half)
+        f64Vec3Sink = a.RotateVector(v)
+    }))
+    assert.True(t, a.Equals(a))
+    assert.True(t, m.Equals(m))
+    assert.False(t, boolSink)
+}
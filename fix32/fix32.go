@@ -1,8 +1,6 @@
 package fix32
 
 import (
-    "strconv"
-
     "github.com/camry/fp/fix64"
     "github.com/camry/fp/fixutil"
 )
@@ -82,9 +80,24 @@ func ToFloat32(v int32) float32 {
     return float32(v) * (1.0 / 65536.0)
 }
 
-// ToString Converts the value to a human readable string.
+// ToString Converts the value to the shortest decimal string that parses back to the same value.
 func ToString(v int32) string {
-    return strconv.FormatFloat(ToFloat64(v), 'f', 16, 32)
+    return fixutil.FormatFixed(int64(v), uint(Shift), 'f', -1)
+}
+
+// Format Converts the value to a string using integer arithmetic only. fmt 'f' gives prec fraction digits,
+// rounded half to even, or the shortest round-tripping form when prec < 0; fmt 'x' gives the raw value as a
+// hexadecimal literal such as "0x10000". Both forms are accepted by Parse.
+func Format(v int32, fmt byte, prec int) string {
+    return fixutil.FormatFixed(int64(v), uint(Shift), fmt, prec)
+}
+
+// Parse Converts a decimal string such as "-12.5e-3", or a raw hexadecimal literal such as "0x18000",
+// into a fixed-point value. Decimal input is rounded to the nearest value, ties to even, without going through
+// floating point. Errors are *fixutil.ParseError wrapping fixutil.ErrSyntax or fixutil.ErrRange.
+func Parse(s string) (int32, error) {
+    v, err := fixutil.ParseFixed("fix32.Parse", s, uint(Shift), 32)
+    return int32(v), err
 }
 
 // Abs Returns the absolute (positive) value of v.
@@ -294,12 +307,15 @@ func SqrtPrecise(a int32) int32 {
     var r = uint32(a)
     var b uint32 = 0x40000000
     var q uint32 = 0
+    var carry bool
     for b > 0x40 {
+        // The remainder can reach twice the range before the shift, carry then holds its top bit.
         t := q + b
-        if r >= t {
+        if carry || r >= t {
             r -= t
             q = t + b
         }
+        carry = r>>31 != 0
         r <<= 1
         b >>= 1
     }
@@ -741,9 +757,10 @@ func Log2Fastest(x int32) int32 {
 
 // Pow Calculates x to the power of the exponent.
 func Pow(x, exponent int32) int32 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, exponent>>Shift)
     }
     // Return 0 for invalid values
     if x <= 0 {
@@ -754,9 +771,10 @@ func Pow(x, exponent int32) int32 {
 
 // PowFast Calculates x to the power of the exponent.
 func PowFast(x, exponent int32) int32 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, exponent>>Shift)
     }
     // Return 0 for invalid values
     if x <= 0 {
@@ -767,9 +785,10 @@ func PowFast(x, exponent int32) int32 {
 
 // PowFastest Calculates x to the power of the exponent.
 func PowFastest(x, exponent int32) int32 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, exponent>>Shift)
     }
     // Return 0 for invalid values
     if x <= 0 {
@@ -898,6 +917,23 @@ func TanFastest(x int32) int32 {
     return DivFastest(sinX, cosX)
 }
 
+// SinCos Returns Sin(x) and the cosine of x, both from one reduction of x to the period as in Tan. The cosine
+// may differ from Cos(x) in the last bits, as Cos reduces x + pi/2 instead.
+func SinCos(x int32) (sin, cos int32) {
+    z := Mul(RcpTwoPi, x)
+    return UnitSin(z) >> 14, UnitSin(z+(1<<30)) >> 14
+}
+
+func SinCosFast(x int32) (sin, cos int32) {
+    z := Mul(RcpTwoPi, x)
+    return UnitSinFast(z) >> 14, UnitSinFast(z+(1<<30)) >> 14
+}
+
+func SinCosFastest(x int32) (sin, cos int32) {
+    z := Mul(RcpTwoPi, x)
+    return UnitSinFastest(z) >> 14, UnitSinFastest(z+(1<<30)) >> 14
+}
+
 func Atan2Div(y, x int32) int32 {
     // Normalize input into [1.0, 2.0( range (convert to s2.30).
     const ONE int32 = 1 << 30
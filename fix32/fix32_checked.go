@@ -0,0 +1,82 @@
+package fix32
+
+// Overflow-checked and saturating variants of the basic operators.
+//
+// The Checked functions report with ok=false when the true result does not fit into s16.16. AddChecked,
+// SubChecked and MulChecked still return the wrapped value of their unchecked counterparts, while
+// DivChecked returns 0 on overflow and on division by zero, as in fix64. The Sat functions clamp to
+// MinValue/MaxValue instead.
+
+// narrow Converts a widened result back to s16.16, reporting whether it fits.
+func narrow(v int64) (int32, bool) {
+    return int32(v), v >= int64(MinValue) && v <= int64(MaxValue)
+}
+
+// saturate Clamps a widened result into the s16.16 range.
+func saturate(v int64) int32 {
+    if v > int64(MaxValue) {
+        return MaxValue
+    }
+    if v < int64(MinValue) {
+        return MinValue
+    }
+    return int32(v)
+}
+
+// AddChecked Adds the two FP numbers together, reporting whether the result overflowed.
+func AddChecked(a, b int32) (int32, bool) {
+    return narrow(int64(a) + int64(b))
+}
+
+// SubChecked Subtracts the two FP numbers from each other, reporting whether the result overflowed.
+func SubChecked(a, b int32) (int32, bool) {
+    return narrow(int64(a) - int64(b))
+}
+
+// MulChecked Multiplies two FP values together, reporting whether the result overflowed.
+func MulChecked(a, b int32) (int32, bool) {
+    return narrow((int64(a) * int64(b)) >> Shift)
+}
+
+// DivChecked Divides two FP values, reporting division by zero and overflow with ok=false and a result
+// of 0.
+func DivChecked(a, b int32) (int32, bool) {
+    if b == 0 {
+        return 0, false
+    }
+    r, ok := narrow((int64(a) << Shift) / int64(b))
+    if !ok {
+        return 0, false
+    }
+    return r, true
+}
+
+// AddSat Adds the two FP numbers together, clamping to MinValue/MaxValue on overflow.
+func AddSat(a, b int32) int32 {
+    return saturate(int64(a) + int64(b))
+}
+
+// SubSat Subtracts the two FP numbers from each other, clamping to MinValue/MaxValue on overflow.
+func SubSat(a, b int32) int32 {
+    return saturate(int64(a) - int64(b))
+}
+
+// MulSat Multiplies two FP values together, clamping to MinValue/MaxValue on overflow.
+func MulSat(a, b int32) int32 {
+    return saturate((int64(a) * int64(b)) >> Shift)
+}
+
+// DivSat Divides two FP values, clamping to MinValue/MaxValue on overflow.
+// Division by zero saturates towards the sign of a, and 0 / 0 returns 0.
+func DivSat(a, b int32) int32 {
+    if b == 0 {
+        if a > 0 {
+            return MaxValue
+        }
+        if a < 0 {
+            return MinValue
+        }
+        return 0
+    }
+    return saturate((int64(a) << Shift) / int64(b))
+}
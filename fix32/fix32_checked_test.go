@@ -0,0 +1,74 @@
+package fix32_test
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+)
+
+var boundaryValues = []int32{
+    fix32.MinValue, fix32.MinValue + 1, fix32.MinValue / 2, -fix32.One - 1, fix32.Neg1, -fix32.Half, -1, 0,
+    1, fix32.Half, fix32.One, fix32.One + 1, fix32.Two, fix32.FromInt32(181), fix32.FromInt32(-181),
+    fix32.FromInt32(256), fix32.FromInt32(-256), fix32.MaxValue / 2, fix32.MaxValue - 1, fix32.MaxValue,
+}
+
+func fitsInt32(v int64) bool {
+    return v >= int64(fix32.MinValue) && v <= int64(fix32.MaxValue)
+}
+
+func clamp32(v int64) int32 {
+    if v > int64(fix32.MaxValue) {
+        return fix32.MaxValue
+    }
+    if v < int64(fix32.MinValue) {
+        return fix32.MinValue
+    }
+    return int32(v)
+}
+
+func TestChecked(t *testing.T) {
+    for _, a := range boundaryValues {
+        for _, b := range boundaryValues {
+            sum := int64(a) + int64(b)
+            r, ok := fix32.AddChecked(a, b)
+            assert.Equal(t, ok, fitsInt32(sum), "AddChecked(%d, %d)", a, b)
+            assert.Equal(t, r, fix32.Add(a, b))
+            assert.Equal(t, fix32.AddSat(a, b), clamp32(sum))
+
+            diff := int64(a) - int64(b)
+            r, ok = fix32.SubChecked(a, b)
+            assert.Equal(t, ok, fitsInt32(diff), "SubChecked(%d, %d)", a, b)
+            assert.Equal(t, r, fix32.Sub(a, b))
+            assert.Equal(t, fix32.SubSat(a, b), clamp32(diff))
+
+            prod := (int64(a) * int64(b)) >> 16
+            r, ok = fix32.MulChecked(a, b)
+            assert.Equal(t, ok, fitsInt32(prod), "MulChecked(%d, %d)", a, b)
+            assert.Equal(t, r, fix32.Mul(a, b))
+            assert.Equal(t, fix32.MulSat(a, b), clamp32(prod))
+
+            r, ok = fix32.DivChecked(a, b)
+            if b == 0 {
+                assert.False(t, ok)
+                assert.Zero(t, r)
+                continue
+            }
+            quo := (int64(a) << 16) / int64(b)
+            assert.Equal(t, ok, fitsInt32(quo), "DivChecked(%d, %d)", a, b)
+            if ok {
+                assert.Equal(t, r, int32(quo))
+            } else {
+                assert.Zero(t, r, "DivChecked(%d, %d)", a, b)
+            }
+            assert.Equal(t, fix32.DivSat(a, b), clamp32(quo))
+        }
+    }
+}
+
+func TestDivSatByZero(t *testing.T) {
+    assert.Equal(t, fix32.DivSat(fix32.One, 0), fix32.MaxValue)
+    assert.Equal(t, fix32.DivSat(fix32.Neg1, 0), fix32.MinValue)
+    assert.Equal(t, fix32.DivSat(0, 0), fix32.Zero)
+}
@@ -0,0 +1,58 @@
+package fix32_test
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+)
+
+func TestToString(t *testing.T) {
+    tests := []struct {
+        in   int32
+        want string
+    }{
+        {0, "0"},
+        {fix32.One, "1"},
+        {fix32.Neg1, "-1"},
+        {fix32.Half, "0.5"},
+        {fix32.Pi, "3.14159"},
+        {fix32.One / 3, "0.33333"},
+        {1, "0.00002"},
+        {fix32.MaxValue, "32767.99998"},
+        {fix32.MinValue, "-32768"},
+    }
+    for _, tt := range tests {
+        assert.Equal(t, tt.want, fix32.ToString(tt.in))
+    }
+}
+
+func TestFormat(t *testing.T) {
+    tests := []struct {
+        in   int32
+        fmt  byte
+        prec int
+        want string
+    }{
+        {fix32.Pi, 'f', 2, "3.14"},
+        {fix32.Half, 'f', 0, "0"},
+        {fix32.One + fix32.Half, 'f', 0, "2"},
+        {fix32.MaxValue, 'f', 2, "32768.00"},
+        {1, 'f', 16, "0.0000152587890625"},
+        {fix32.One, 'x', -1, "0x10000"},
+        {fix32.MinValue, 'x', -1, "-0x80000000"},
+    }
+    for _, tt := range tests {
+        assert.Equal(t, tt.want, fix32.Format(tt.in, tt.fmt, tt.prec))
+    }
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+    // Stride through the whole range.
+    for v := int64(-1 << 31); v < 1<<31; v += 65537 {
+        got, err := fix32.Parse(fix32.ToString(int32(v)))
+        assert.NoError(t, err)
+        assert.Equal(t, int32(v), got)
+    }
+}
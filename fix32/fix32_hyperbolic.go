@@ -0,0 +1,88 @@
+package fix32
+
+import (
+    "github.com/camry/fp/fix64"
+)
+
+// Hyperbolic functions and their inverses, run in s32.32 on the fix64 tier of the same name.
+//
+// Sinh and Cosh clamp to MinValue/MaxValue beyond |x| of 16 ln(2) ~= 11.09, where e^x / 2 leaves the s16.16
+// range. The inverses return 0 outside of their domain: Acosh for x < 1 and Atanh for |x| >= 1.
+
+// Sinh Hyperbolic sine, (e^x - e^-x) / 2.
+func Sinh(x int32) int32 {
+    return saturate(fix64.Sinh(int64(x)<<16) >> 16)
+}
+
+func SinhFast(x int32) int32 {
+    return saturate(fix64.SinhFast(int64(x)<<16) >> 16)
+}
+
+func SinhFastest(x int32) int32 {
+    return saturate(fix64.SinhFastest(int64(x)<<16) >> 16)
+}
+
+// Cosh Hyperbolic cosine, (e^x + e^-x) / 2.
+func Cosh(x int32) int32 {
+    return saturate(fix64.Cosh(int64(x)<<16) >> 16)
+}
+
+func CoshFast(x int32) int32 {
+    return saturate(fix64.CoshFast(int64(x)<<16) >> 16)
+}
+
+func CoshFastest(x int32) int32 {
+    return saturate(fix64.CoshFastest(int64(x)<<16) >> 16)
+}
+
+// Tanh Hyperbolic tangent, sinh(x) / cosh(x), in [-1, 1].
+func Tanh(x int32) int32 {
+    return int32(fix64.Tanh(int64(x)<<16) >> 16)
+}
+
+func TanhFast(x int32) int32 {
+    return int32(fix64.TanhFast(int64(x)<<16) >> 16)
+}
+
+func TanhFastest(x int32) int32 {
+    return int32(fix64.TanhFastest(int64(x)<<16) >> 16)
+}
+
+// Asinh Inverse hyperbolic sine, ln(x + sqrt(x^2 + 1)).
+func Asinh(x int32) int32 {
+    return int32(fix64.Asinh(int64(x)<<16) >> 16)
+}
+
+func AsinhFast(x int32) int32 {
+    return int32(fix64.AsinhFast(int64(x)<<16) >> 16)
+}
+
+func AsinhFastest(x int32) int32 {
+    return int32(fix64.AsinhFastest(int64(x)<<16) >> 16)
+}
+
+// Acosh Inverse hyperbolic cosine of x >= 1, ln(x + sqrt(x^2 - 1)), 0 below one.
+func Acosh(x int32) int32 {
+    return int32(fix64.Acosh(int64(x)<<16) >> 16)
+}
+
+func AcoshFast(x int32) int32 {
+    return int32(fix64.AcoshFast(int64(x)<<16) >> 16)
+}
+
+func AcoshFastest(x int32) int32 {
+    return int32(fix64.AcoshFastest(int64(x)<<16) >> 16)
+}
+
+// Atanh Inverse hyperbolic tangent of |x| < 1, ln((1 + x) / (1 - x)) / 2, 0 beyond.
+func Atanh(x int32) int32 {
+    return int32(fix64.Atanh(int64(x)<<16) >> 16)
+}
+
+func AtanhFast(x int32) int32 {
+    return int32(fix64.AtanhFast(int64(x)<<16) >> 16)
+}
+
+func AtanhFastest(x int32) int32 {
+    return int32(fix64.AtanhFastest(int64(x)<<16) >> 16)
+}
@@ -0,0 +1,60 @@
+package fix32_test
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+)
+
+func TestHyperbolic_Domain(t *testing.T) {
+    for _, f := range []func(int32) int32{fix32.Sinh, fix32.SinhFast, fix32.SinhFastest} {
+        assert.Equal(t, fix32.Zero, f(0))
+        assert.Equal(t, fix32.MaxValue, f(fix32.FromInt32(12)))
+        assert.Equal(t, fix32.MinValue, f(fix32.FromInt32(-12)))
+        assert.Equal(t, fix32.MinValue, f(fix32.MinValue))
+        assert.Equal(t, fix32.MaxValue, f(fix32.MaxValue))
+    }
+    for _, f := range []func(int32) int32{fix32.Cosh, fix32.CoshFast, fix32.CoshFastest} {
+        assert.Equal(t, fix32.MaxValue, f(fix32.FromInt32(12)))
+        assert.Equal(t, fix32.MaxValue, f(fix32.MinValue))
+    }
+    for _, f := range []func(int32) int32{fix32.Tanh, fix32.TanhFast, fix32.TanhFastest} {
+        assert.Equal(t, fix32.Zero, f(0))
+        assert.Equal(t, fix32.One, f(fix32.MaxValue))
+        assert.Equal(t, -fix32.One, f(fix32.MinValue))
+    }
+    for _, f := range []func(int32) int32{fix32.Asinh, fix32.AsinhFast, fix32.AsinhFastest} {
+        assert.Equal(t, fix32.Zero, f(0))
+        assert.InDelta(t, math.Asinh(fix32.ToFloat64(fix32.MinValue)), fix32.ToFloat64(f(fix32.MinValue)), 1e-3)
+    }
+    for _, f := range []func(int32) int32{fix32.Acosh, fix32.AcoshFast, fix32.AcoshFastest} {
+        assert.Equal(t, fix32.Zero, f(fix32.One))
+        assert.Equal(t, fix32.Zero, f(fix32.One-1))
+        assert.Equal(t, fix32.Zero, f(fix32.MinValue))
+        assert.InDelta(t, math.Acosh(fix32.ToFloat64(fix32.MaxValue)), fix32.ToFloat64(f(fix32.MaxValue)), 1e-3)
+    }
+    for _, f := range []func(int32) int32{fix32.Atanh, fix32.AtanhFast, fix32.AtanhFastest} {
+        assert.Equal(t, fix32.Zero, f(0))
+        assert.Equal(t, fix32.Zero, f(fix32.One))
+        assert.Equal(t, fix32.Zero, f(-fix32.One))
+        assert.InDelta(t, 17*math.Ln2/2, fix32.ToFloat64(f(fix32.One-1)), 1e-3)
+    }
+}
+
+func TestSinCos(t *testing.T) {
+    for i := -1000; i <= 1000; i++ {
+        x := fix32.FromFloat64(float64(i) / 100)
+        sin, cos := fix32.SinCos(x)
+        assert.Equal(t, fix32.Sin(x), sin)
+        assert.InDelta(t, fix32.Cos(x), cos, 2)
+        sin, cos = fix32.SinCosFast(x)
+        assert.Equal(t, fix32.SinFast(x), sin)
+        assert.InDelta(t, fix32.CosFast(x), cos, 2)
+        sin, cos = fix32.SinCosFastest(x)
+        assert.Equal(t, fix32.SinFastest(x), sin)
+        assert.InDelta(t, fix32.CosFastest(x), cos, 2)
+    }
+}
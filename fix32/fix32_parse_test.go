@@ -0,0 +1,78 @@
+package fix32_test
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+    "github.com/camry/fp/fixutil"
+)
+
+func TestParse(t *testing.T) {
+    tests := []struct {
+        in   string
+        want int32
+    }{
+        {"0", 0},
+        {"-0", 0},
+        {"1", fix32.One},
+        {"+1", fix32.One},
+        {"-1", fix32.Neg1},
+        {"0.5", fix32.Half},
+        {".5", fix32.Half},
+        {"2.", fix32.Two},
+        {"-2.5", -fix32.Two - fix32.Half},
+        {"25e-1", fix32.Two + fix32.Half},
+        {"3.14159265358979", fix32.Pi},
+        {"2.71828182845904", fix32.E},
+        {"0.0000152587890625", 1},    // exactly 2^-16
+        {"0.00000762939453125", 0},   // exactly half an ulp, ties to even
+        {"0.00002288818359375", 2},   // 1.5 ulp, ties to even
+        {"0.000007629394531251", 1},
+        {"32767.9999847412109375", fix32.MaxValue},
+        {"-32768", fix32.MinValue},
+        {"0x10000", fix32.One},
+        {"-0x8000", -fix32.Half},
+        {"0x7fffffff", fix32.MaxValue},
+        {"-0x80000000", fix32.MinValue},
+    }
+    for _, tt := range tests {
+        got, err := fix32.Parse(tt.in)
+        assert.NoError(t, err, tt.in)
+        assert.Equal(t, tt.want, got, tt.in)
+    }
+}
+
+func TestParse_Errors(t *testing.T) {
+    for _, in := range []string{"", "-", ".", "1..2", "e5", "1e", "0x", "0x1p4", "NaN"} {
+        _, err := fix32.Parse(in)
+        assert.True(t, errors.Is(err, fixutil.ErrSyntax), in)
+    }
+    for _, tt := range []struct {
+        in   string
+        want int32
+    }{
+        {"32768", fix32.MaxValue},
+        {"32767.99999999", fix32.MaxValue},
+        {"-32768.00001", fix32.MinValue},
+        {"0x80000000", fix32.MaxValue},
+        {"-0x100000000", fix32.MinValue},
+    } {
+        got, err := fix32.Parse(tt.in)
+        assert.True(t, errors.Is(err, fixutil.ErrRange), tt.in)
+        assert.Equal(t, tt.want, got, tt.in)
+    }
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    for i := 0; i < 10000; i++ {
+        v := int32(r.Uint32())
+        got, err := fix32.Parse(fix32.ToString(v))
+        assert.NoError(t, err)
+        assert.Equal(t, v, got)
+    }
+}
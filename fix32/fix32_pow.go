@@ -0,0 +1,89 @@
+package fix32
+
+import (
+    "math/bits"
+
+    "github.com/camry/fp/fix64"
+)
+
+// Powers and roots that don't go through Exp and Log.
+//
+// PowInt and NthRoot run in s32.32 on their fix64 counterparts, whose extra 16 fractional bits absorb the
+// rounding of the intermediate products, and clamp the result back into range. Cbrt and Hypot are exact.
+
+// PowInt Calculates x to the integer power n by repeated squaring, clamping to MinValue/MaxValue on
+// overflow. Negative powers are the reciprocal of the positive one, and 0 to a negative power is MaxValue.
+func PowInt(x, n int32) int32 {
+    return saturate(fix64.PowInt(int64(x)<<16, n) >> 16)
+}
+
+// Cbrt Calculates the cube root of the given number, negative for negative x. The result is exact, truncated
+// towards zero.
+func Cbrt(x int32) int32 {
+    if x < 0 {
+        // uint32 of MinValue is its magnitude.
+        return -cbrt(uint32(-x))
+    }
+    return cbrt(uint32(x))
+}
+
+// cbrt Returns the largest r with r^3 <= x * 2^32, the s16.16 cube root of x, one bit at a time.
+func cbrt(x uint32) int32 {
+    n := uint64(x) << 32
+    var r uint64
+    // The root of 2^63 is 2^21, the cube of the candidates above it can take 66 bits.
+    for b := 21; b >= 0; b-- {
+        c := r | 1<<b
+        hi, lo := bits.Mul64(c*c, c)
+        if hi == 0 && lo <= n {
+            r = c
+        }
+    }
+    return int32(r)
+}
+
+// NthRoot Calculates the n-th root of x, x to the power of 1 / n. Odd roots of negative x are negative, even
+// roots of negative x and roots with n <= 0 return 0.
+func NthRoot(x, n int32) int32 {
+    if n == 3 {
+        return Cbrt(x)
+    }
+    // A root is no larger than x above one, and below one otherwise, so it fits.
+    return int32(fix64.NthRoot(int64(x)<<16, n) >> 16)
+}
+
+// Hypot Calculates sqrt(a^2 + b^2) from the 64-bit sum of the squares, so that it neither overflows nor
+// underflows. The result is exact, truncated, and clamps to MaxValue when out of range.
+func Hypot(a, b int32) int32 {
+    return hypot(sqr(a) + sqr(b))
+}
+
+// Hypot3 Calculates sqrt(a^2 + b^2 + c^2), see Hypot.
+func Hypot3(a, b, c int32) int32 {
+    return hypot(sqr(a) + sqr(b) + sqr(c))
+}
+
+// Hypot4 Calculates sqrt(a^2 + b^2 + c^2 + d^2), see Hypot.
+func Hypot4(a, b, c, d int32) int32 {
+    return hypot(sqr(a) + sqr(b) + sqr(c) + sqr(d))
+}
+
+// sqr Returns the square of the raw value of v, taking MinValue as MaxValue so that four squares fit.
+func sqr(v int32) uint64 {
+    if v == MinValue {
+        v = MaxValue
+    }
+    return uint64(int64(v) * int64(v))
+}
+
+// hypot Returns the largest r with r^2 <= n, one bit at a time, which is the s16.16 root of the sum of
+// squares of raw values.
+func hypot(n uint64) int32 {
+    var r uint64
+    for b := 31; b >= 0; b-- {
+        if c := r | 1<<b; c*c <= n {
+            r = c
+        }
+    }
+    return saturate(int64(r))
+}
@@ -0,0 +1,126 @@
+package fix32_test
+
+import (
+    "math"
+    "math/big"
+    "math/rand"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+)
+
+func TestPowInt(t *testing.T) {
+    assert.Equal(t, fix32.FromInt32(-8), fix32.PowInt(fix32.FromInt32(-2), 3))
+    assert.Equal(t, fix32.FromInt32(16), fix32.PowInt(fix32.FromInt32(-2), 4))
+    assert.Equal(t, fix32.One>>3, fix32.PowInt(fix32.FromInt32(2), -3))
+    assert.Equal(t, -fix32.One>>3, fix32.PowInt(fix32.FromInt32(-2), -3))
+    assert.Equal(t, fix32.One, fix32.PowInt(0, 0))
+    assert.Equal(t, fix32.Zero, fix32.PowInt(0, 5))
+    assert.Equal(t, fix32.MaxValue, fix32.PowInt(0, -1))
+    assert.Equal(t, fix32.MaxValue, fix32.PowInt(fix32.FromInt32(3), 40))
+    assert.Equal(t, fix32.MinValue, fix32.PowInt(fix32.FromInt32(-3), 41))
+    assert.Equal(t, fix32.Zero, fix32.PowInt(fix32.FromInt32(3), -40))
+    assert.Equal(t, fix32.Zero, fix32.PowInt(fix32.FromInt32(2), math.MinInt32))
+    assert.Equal(t, fix32.One, fix32.PowInt(fix32.One, math.MaxInt32))
+
+    r := rand.New(rand.NewSource(1))
+    for i := 0; i < 10000; i++ {
+        x := r.Float64()*8 - 4
+        n := int32(r.Intn(21) - 10)
+        want := math.Pow(fix32.ToFloat64(fix32.FromFloat64(x)), float64(n))
+        if math.Abs(want) > 1<<14 {
+            continue
+        }
+        got := fix32.ToFloat64(fix32.PowInt(fix32.FromFloat64(x), n))
+        assert.InDelta(t, want, got, 2e-5*math.Max(1, math.Abs(want)), "PowInt(%v, %d)", x, n)
+    }
+}
+
+func TestPow_IntegerExponent(t *testing.T) {
+    for _, pow := range []func(x, exponent int32) int32{fix32.Pow, fix32.PowFast, fix32.PowFastest} {
+        assert.Equal(t, fix32.FromInt32(-32), pow(fix32.FromInt32(-2), fix32.FromInt32(5)))
+        assert.Equal(t, fix32.One>>2, pow(fix32.FromInt32(-2), fix32.FromInt32(-2)))
+        assert.Equal(t, fix32.One, pow(fix32.FromInt32(-7), 0))
+        assert.Equal(t, fix32.Zero, pow(fix32.FromInt32(-2), fix32.Half))
+    }
+}
+
+func TestCbrt(t *testing.T) {
+    assert.Equal(t, fix32.FromInt32(3), fix32.Cbrt(fix32.FromInt32(27)))
+    assert.Equal(t, fix32.FromInt32(-2), fix32.Cbrt(fix32.FromInt32(-8)))
+    assert.Equal(t, fix32.One>>2, fix32.Cbrt(fix32.One>>6))
+
+    // The result is the largest r with r^3 <= x * 2^32.
+    check := func(x int32) {
+        r := fix32.Cbrt(x)
+        assert.Equal(t, -r, fix32.Cbrt(-x), "Cbrt(%d)", -x)
+        n := new(big.Int).Lsh(big.NewInt(int64(x)), 32)
+        cube := func(v int32) *big.Int {
+            b := big.NewInt(int64(v))
+            return new(big.Int).Mul(b, new(big.Int).Mul(b, b))
+        }
+        assert.True(t, cube(r).Cmp(n) <= 0 && cube(r+1).Cmp(n) > 0, "Cbrt(%d) = %d", x, r)
+    }
+    r := rand.New(rand.NewSource(2))
+    for i := 0; i < 2000; i++ {
+        check(r.Int31() >> r.Intn(31))
+    }
+    for _, x := range []int32{0, 1, 2, fix32.One - 1, fix32.One, fix32.MaxValue} {
+        check(x)
+    }
+    assert.Equal(t, fix32.FromInt32(-32), fix32.Cbrt(fix32.MinValue))
+}
+
+func TestNthRoot(t *testing.T) {
+    assert.Equal(t, fix32.Zero, fix32.NthRoot(fix32.FromInt32(16), 0))
+    assert.Equal(t, fix32.Zero, fix32.NthRoot(fix32.FromInt32(16), -2))
+    assert.Equal(t, fix32.Zero, fix32.NthRoot(fix32.FromInt32(-16), 4))
+    assert.Equal(t, fix32.FromInt32(-5), fix32.NthRoot(fix32.FromInt32(-5), 1))
+    assert.Equal(t, fix32.SqrtPrecise(fix32.FromInt32(2)), fix32.NthRoot(fix32.FromInt32(2), 2))
+    assert.Equal(t, fix32.Cbrt(fix32.FromInt32(-2)), fix32.NthRoot(fix32.FromInt32(-2), 3))
+
+    r := rand.New(rand.NewSource(3))
+    for i := 0; i < 10000; i++ {
+        x := math.Exp(r.Float64()*20 - 10)
+        n := int32(r.Intn(30) + 4)
+        if n&1 != 0 && r.Intn(2) == 0 {
+            x = -x
+        }
+        fx := fix32.FromFloat64(x)
+        want := math.Copysign(math.Pow(math.Abs(fix32.ToFloat64(fx)), 1/float64(n)), x)
+        got := fix32.ToFloat64(fix32.NthRoot(fx, n))
+        assert.InDelta(t, want, got, 2e-5*math.Max(1, math.Abs(want)), "NthRoot(%v, %d)", x, n)
+    }
+}
+
+func TestHypot(t *testing.T) {
+    assert.Equal(t, fix32.FromInt32(5), fix32.Hypot(fix32.FromInt32(3), fix32.FromInt32(-4)))
+    assert.Equal(t, int32(5), fix32.Hypot(-3, 4))
+    assert.Equal(t, fix32.Zero, fix32.Hypot(0, 0))
+    assert.Equal(t, fix32.FromInt32(7), fix32.Hypot(0, fix32.FromInt32(-7)))
+    assert.Equal(t, fix32.FromInt32(13), fix32.Hypot3(fix32.FromInt32(3), fix32.FromInt32(4), fix32.FromInt32(-12)))
+    assert.Equal(t, fix32.FromInt32(2), fix32.Hypot4(fix32.One, -fix32.One, fix32.One, -fix32.One))
+    assert.Equal(t, fix32.MaxValue, fix32.Hypot(fix32.MaxValue, fix32.MaxValue))
+    assert.Equal(t, fix32.MaxValue, fix32.Hypot(fix32.MinValue, 0))
+    assert.Equal(t, fix32.MaxValue, fix32.Hypot4(fix32.MinValue, fix32.MinValue, fix32.MinValue, fix32.MinValue))
+
+    // The result is the largest r with r^2 <= a^2 + b^2 + c^2 + d^2 in raw values.
+    sqr := func(v int32) *big.Int {
+        return new(big.Int).Mul(big.NewInt(int64(v)), big.NewInt(int64(v)))
+    }
+    r := rand.New(rand.NewSource(4))
+    for i := 0; i < 2000; i++ {
+        a, b, c, d := r.Int31() >> r.Intn(31), -(r.Int31() >> r.Intn(31)), r.Int31() >> r.Intn(31), -(r.Int31() >> r.Intn(31))
+        check := func(h int32, n *big.Int) {
+            assert.True(t, sqr(h).Cmp(n) <= 0 && (h == fix32.MaxValue || sqr(h+1).Cmp(n) > 0), "Hypot(%d, %d, %d, %d) = %d", a, b, c, d, h)
+        }
+        n := new(big.Int).Add(sqr(a), sqr(b))
+        check(fix32.Hypot(a, b), n)
+        n.Add(n, sqr(c))
+        check(fix32.Hypot3(a, b, c), n)
+        n.Add(n, sqr(d))
+        check(fix32.Hypot4(a, b, c, d), n)
+    }
+}
@@ -0,0 +1,54 @@
+package fix32
+
+import (
+    "github.com/camry/fp/fixutil"
+)
+
+// Multiply and divide with an explicit rounding mode. Mul rounds toward negative infinity and DivPrecise
+// toward zero, which makes long accumulations drift; fixutil.RoundNearestEven has no such bias.
+
+// MulRound Multiplies two FP values together, rounding the exact product according to mode. Overflow
+// wraps around like Mul.
+func MulRound(a, b int32, mode fixutil.RoundingMode) int32 {
+    p := int64(a) * int64(b)
+    frac := p & int64(FractionMask)
+    return int32(fixutil.RoundFromFloor(p>>Shift, frac != 0, cmp64(frac, 1<<(Shift-1)), p < 0, mode))
+}
+
+// DivRound Divides two FP values, rounding the exact quotient according to mode. Division by zero
+// returns 0 like DivPrecise, and overflow clamps to MinValue/MaxValue.
+func DivRound(a, b int32, mode fixutil.RoundingMode) int32 {
+    if b == 0 {
+        return 0
+    }
+    n, d := int64(a)<<Shift, int64(b)
+    if d < 0 {
+        n, d = -n, -d
+    }
+
+    // Floor of the quotient, with the remainder in [0, d).
+    q, r := n/d, n%d
+    if r < 0 {
+        q--
+        r += d
+    }
+    v := fixutil.RoundFromFloor(q, r != 0, cmp64(r, d-r), n < 0, mode)
+    if v > int64(MaxValue) {
+        return MaxValue
+    }
+    if v < int64(MinValue) {
+        return MinValue
+    }
+    return int32(v)
+}
+
+// cmp64 Returns the sign of a - b.
+func cmp64(a, b int64) int {
+    if a < b {
+        return -1
+    }
+    if a > b {
+        return 1
+    }
+    return 0
+}
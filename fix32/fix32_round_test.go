@@ -0,0 +1,125 @@
+package fix32_test
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+    "github.com/camry/fp/fixutil"
+)
+
+var roundingModes = []fixutil.RoundingMode{
+    fixutil.RoundNearestEven, fixutil.RoundNearestAway, fixutil.RoundTowardZero, fixutil.RoundFloor, fixutil.RoundCeil,
+}
+
+// roundRat Rounds num / den to an integer according to mode, as the reference for MulRound and DivRound.
+func roundRat(num, den int64, mode fixutil.RoundingMode) int64 {
+    if den < 0 {
+        num, den = -num, -den
+    }
+    floor, rem := num/den, num%den
+    if rem < 0 {
+        floor--
+        rem += den
+    }
+    if rem == 0 {
+        return floor
+    }
+    switch {
+    case mode == fixutil.RoundFloor:
+        return floor
+    case mode == fixutil.RoundCeil:
+        return floor + 1
+    case mode == fixutil.RoundTowardZero:
+        if num < 0 {
+            return floor + 1
+        }
+        return floor
+    case 2*rem > den:
+        return floor + 1
+    case 2*rem < den:
+        return floor
+    case mode == fixutil.RoundNearestAway:
+        if num > 0 {
+            return floor + 1
+        }
+        return floor
+    }
+    return floor + floor&1
+}
+
+func TestMulRound(t *testing.T) {
+    values := append([]int32{fix32.Half + 1, -fix32.Half - 1, 3, -3, 0x18000, -0x18000}, boundaryValues...)
+    for _, mode := range roundingModes {
+        for _, a := range values {
+            for _, b := range values {
+                want := roundRat(int64(a)*int64(b), int64(fix32.One), mode)
+                if !fitsInt32(want) {
+                    continue
+                }
+                assert.Equal(t, int32(want), fix32.MulRound(a, b, mode), "MulRound(%d, %d, %v)", a, b, mode)
+            }
+        }
+    }
+    assert.Equal(t, fix32.Mul(-3, fix32.Half), fix32.MulRound(-3, fix32.Half, fixutil.RoundFloor))
+}
+
+func TestDivRound(t *testing.T) {
+    values := append([]int32{3, -3, 7, -7, fix32.FromInt32(3), fix32.FromInt32(-10)}, boundaryValues...)
+    for _, mode := range roundingModes {
+        for _, a := range values {
+            for _, b := range values {
+                if b == 0 {
+                    assert.Equal(t, int32(0), fix32.DivRound(a, b, mode))
+                    continue
+                }
+                want := roundRat(int64(a)<<16, int64(b), mode)
+                assert.Equal(t, clamp32(want), fix32.DivRound(a, b, mode), "DivRound(%d, %d, %v)", a, b, mode)
+            }
+        }
+    }
+    // Ties: 1/2 ulp.
+    assert.Equal(t, int32(0), fix32.DivRound(1, fix32.Two, fixutil.RoundNearestEven))
+    assert.Equal(t, int32(1), fix32.DivRound(1, fix32.Two, fixutil.RoundNearestAway))
+    assert.Equal(t, int32(-1), fix32.DivRound(-1, fix32.Two, fixutil.RoundNearestAway))
+    assert.Equal(t, int32(2), fix32.DivRound(3, fix32.Two, fixutil.RoundNearestEven))
+}
+
+// TestRound_Unbiased Accumulates many products and quotients and compares the drift from the exact sum.
+// Truncating operators lose half an ulp per step on average; round-half-even stays within a few
+// multiples of the random walk bound sqrt(n).
+func TestRound_Unbiased(t *testing.T) {
+    const n = 100000
+    seed := uint64(0x9e3779b97f4a7c15)
+    next := func() int32 {
+        seed = seed*6364136223846793005 + 1442695040888963407
+        return int32(seed>>41) - 1<<22 // about +-64
+    }
+
+    var exactMul, sumMul, sumMulRound int64 // exactMul in units of 2^-32
+    var errDiv, errDivRound float64         // in ulps
+    divErr := func(q, a, b int32) float64 {
+        e, _ := big.NewRat(int64(q)*int64(b)-int64(a)<<16, int64(b)).Float64()
+        return e
+    }
+    for i := 0; i < n; i++ {
+        a, b := next(), next()
+        exactMul += int64(a) * int64(b)
+        sumMul += int64(fix32.Mul(a, b))
+        sumMulRound += int64(fix32.MulRound(a, b, fixutil.RoundNearestEven))
+
+        // Positive operands, where truncation always errs downward.
+        a, b = a&0x3fffff, b&0x3fffff|fix32.One
+        errDiv += divErr(fix32.DivPrecise(a, b), a, b)
+        errDivRound += divErr(fix32.DivRound(a, b, fixutil.RoundNearestEven), a, b)
+    }
+
+    errMul := float64(sumMul<<16-exactMul) / float64(fix32.One)
+    errMulRound := float64(sumMulRound<<16-exactMul) / float64(fix32.One)
+    assert.Less(t, errMul, -0.4*n) // floor drifts down by n/2
+    assert.InDelta(t, 0, errMulRound, 5*316)
+    assert.Less(t, errDiv, -0.4*n)
+    assert.InDelta(t, 0, errDivRound, 5*316)
+}
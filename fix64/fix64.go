@@ -1,8 +1,6 @@
 package fix64
 
 import (
-    "strconv"
-
     "github.com/camry/fp/fixutil"
 )
 
@@ -86,9 +84,23 @@ func ToFloat32(v int64) float32 {
     return float32(v) * (1.0 / 4294967296.0)
 }
 
-// ToString Converts the value to a human readable string.
+// ToString Converts the value to the shortest decimal string that parses back to the same value.
 func ToString(v int64) string {
-    return strconv.FormatFloat(ToFloat64(v), 'f', 32, 64)
+    return fixutil.FormatFixed(int64(v), uint(Shift), 'f', -1)
+}
+
+// Format Converts the value to a string using integer arithmetic only. fmt 'f' gives prec fraction digits,
+// rounded half to even, or the shortest round-tripping form when prec < 0; fmt 'x' gives the raw value as a
+// hexadecimal literal such as "0x100000000". Both forms are accepted by Parse.
+func Format(v int64, fmt byte, prec int) string {
+    return fixutil.FormatFixed(int64(v), uint(Shift), fmt, prec)
+}
+
+// Parse Converts a decimal string such as "-12.5e-3", or a raw hexadecimal literal such as "0x180000000",
+// into a fixed-point value. Decimal input is rounded to the nearest value, ties to even, without going through
+// floating point. Errors are *fixutil.ParseError wrapping fixutil.ErrSyntax or fixutil.ErrRange.
+func Parse(s string) (int64, error) {
+    return fixutil.ParseFixed("fix64.Parse", s, uint(Shift), 64)
 }
 
 // Abs Returns the absolute (positive) value of v.
@@ -405,12 +417,15 @@ func SqrtPrecise(a int64) int64 {
     r := uint64(a)
     b := uint64(0x4000000000000000)
     q := uint64(0)
+    carry := false
     for b > 0x40 {
+        // The remainder can reach twice the range before the shift, carry then holds its top bit.
         t := q + b
-        if r >= t {
+        if carry || r >= t {
             r -= t
             q = t + b
         }
+        carry = r>>63 != 0
         r <<= 1
         b >>= 1
     }
@@ -431,11 +446,10 @@ func Sqrt(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.SqrtPoly3Lut8(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -449,7 +463,7 @@ func Sqrt(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr << offset
     } else {
@@ -470,11 +484,10 @@ func SqrtFast(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.SqrtPoly4(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -487,7 +500,7 @@ func SqrtFast(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr << offset
     } else {
@@ -508,11 +521,10 @@ func SqrtFastest(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.SqrtPoly3(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -525,7 +537,7 @@ func SqrtFastest(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr << offset
     } else {
@@ -547,11 +559,10 @@ func RSqrt(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.RSqrtPoly3Lut16(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -564,7 +575,7 @@ func RSqrt(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr >> offset
     } else {
@@ -586,11 +597,10 @@ func RSqrtFast(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.RSqrtPoly5(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -603,7 +613,7 @@ func RSqrtFast(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr >> offset
     } else {
@@ -625,11 +635,10 @@ func RSqrtFastest(x int64) int64 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     y := fixutil.RSqrtPoly3(n - ONE)
 
     // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
@@ -642,7 +651,7 @@ func RSqrtFastest(x int64) int64 {
     offset = offset >> 1
 
     // Apply exponent, convert back to s32.32.
-    yr := int64(fixutil.Qmul30(adjust, y) << 2)
+    yr := int64(fixutil.Qmul30(adjust, y)) << 2
     if offset >= 0 {
         return yr >> offset
     } else {
@@ -834,7 +843,7 @@ func Log(x int64) int64 {
         n = int32(x << -offset >> 2)
     }
 
-    y := int64(fixutil.LogPoly5Lut8(n-ONE) << 2)
+    y := int64(fixutil.LogPoly5Lut8(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return int64(offset)*RcpLog2E + y
@@ -856,7 +865,7 @@ func LogFast(x int64) int64 {
         n = int32(x << -offset >> 2)
     }
 
-    y := int64(fixutil.LogPoly3Lut8(n-ONE) << 2)
+    y := int64(fixutil.LogPoly3Lut8(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return int64(offset)*RcpLog2E + y
@@ -878,7 +887,7 @@ func LogFastest(x int64) int64 {
         n = int32(x << -offset >> 2)
     }
 
-    y := int64(fixutil.LogPoly5(n-ONE) << 2)
+    y := int64(fixutil.LogPoly5(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return int64(offset)*RcpLog2E + y
@@ -900,7 +909,7 @@ func Log2(x int64) int64 {
 
     // Polynomial approximation of mantissa.
     const ONE int32 = 1 << 30
-    y := int64(fixutil.Log2Poly4Lut16(n-ONE) << 2)
+    y := int64(fixutil.Log2Poly4Lut16(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return (int64(offset) << Shift) + y
@@ -922,7 +931,7 @@ func Log2Fast(x int64) int64 {
 
     // Polynomial approximation of mantissa.
     const ONE int32 = 1 << 30
-    y := int64(fixutil.Log2Poly3Lut16(n-ONE) << 2)
+    y := int64(fixutil.Log2Poly3Lut16(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return (int64(offset) << Shift) + y
@@ -944,7 +953,7 @@ func Log2Fastest(x int64) int64 {
 
     // Polynomial approximation of mantissa.
     const ONE int32 = 1 << 30
-    y := int64(fixutil.Log2Poly5(n-ONE) << 2)
+    y := int64(fixutil.Log2Poly5(n-ONE)) << 2
 
     // Combine integer and fractional parts (into s32.32).
     return (int64(offset) << Shift) + y
@@ -952,9 +961,10 @@ func Log2Fastest(x int64) int64 {
 
 // Pow Calculates x to the power of the exponent.
 func Pow(x, exponent int64) int64 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, int32(exponent>>Shift))
     }
 
     // Return 0 for invalid values
@@ -967,9 +977,10 @@ func Pow(x, exponent int64) int64 {
 
 // PowFast Calculates x to the power of the exponent.
 func PowFast(x, exponent int64) int64 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, int32(exponent>>Shift))
     }
 
     // Return 0 for invalid values
@@ -982,9 +993,10 @@ func PowFast(x, exponent int64) int64 {
 
 // PowFastest Calculates x to the power of the exponent.
 func PowFastest(x, exponent int64) int64 {
-    // n^0 == 1
-    if exponent == 0 {
-        return One
+    // Integer exponents, n^0 == 1 among them, multiply instead, which is exact up to rounding and defined
+    // for negative x.
+    if exponent&FractionMask == 0 {
+        return PowInt(x, int32(exponent>>Shift))
     }
 
     // Return 0 for invalid values
@@ -1106,17 +1118,33 @@ func TanFastest(x int64) int64 {
     return DivFastest(sinX, cosX)
 }
 
+// SinCos Returns Sin(x) and the cosine of x, both from one reduction of x to the period as in Tan. The cosine
+// may differ from Cos(x) in the last bits, as Cos reduces x + pi/2 instead.
+func SinCos(x int64) (sin, cos int64) {
+    z := MulIntLongLow(RcpHalfPi, x)
+    return int64(unitSin(z)) << 2, int64(unitSin(z+(1<<30))) << 2
+}
+
+func SinCosFast(x int64) (sin, cos int64) {
+    z := MulIntLongLow(RcpHalfPi, x)
+    return int64(unitSinFast(z)) << 2, int64(unitSinFast(z+(1<<30))) << 2
+}
+
+func SinCosFastest(x int64) (sin, cos int64) {
+    z := MulIntLongLow(RcpHalfPi, x)
+    return int64(unitSinFastest(z)) << 2, int64(unitSinFastest(z+(1<<30))) << 2
+}
+
 func atan2Div(y, x int64) int32 {
     // Normalize input into [1.0, 2.0( range (convert to s2.30).
     const ONE int32 = 1 << 30
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     k := n - ONE
 
     // Polynomial approximation of reciprocal.
@@ -1138,11 +1166,10 @@ func atan2DivFast(y, x int64) int32 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     k := n - ONE
 
     // Polynomial approximation of reciprocal.
@@ -1164,11 +1191,10 @@ func atan2DivFastest(y, x int64) int32 {
     offset := 31 - nlz(uint64(x))
     var n int32
     if offset >= 0 {
-        n = int32(x >> offset)
+        n = int32(x >> offset >> 2)
     } else {
-        n = int32(x << -offset)
+        n = int32(x << -offset >> 2)
     }
-    n >>= 2
     k := n - ONE
 
     // Polynomial approximation of reciprocal.
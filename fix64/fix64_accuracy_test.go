@@ -0,0 +1,410 @@
+package fix64_test
+
+import (
+    "math"
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/internal/accuracy"
+)
+
+// kernel A polynomial of fixutil in a tier, whose error reaches the result scaled by the weight.
+type kernel struct {
+    name   string
+    weight float64
+}
+
+// tier One precision tier of a function and the polynomials it relies on, none for the exact ones.
+type tier struct {
+    name    string
+    fn      func(x, y int64) int64
+    kernels []kernel
+}
+
+// sweep The inputs of a function and the tiers measured on them.
+type sweep struct {
+    name   string
+    inputs func(n int) [][2]int64 // ascending in the first argument
+    exact  func(x, y *big.Float) *big.Float
+    mode   accuracy.Mode
+    dir    int
+    q30    float64 // the s2.30 roundings on the way, each an error of up to 2^-30 of the scale
+    slack  float64 // the ulps of rounding of the result
+    tiers  []tier
+}
+
+func unary(f func(int64) int64) func(x, y int64) int64 {
+    return func(x, _ int64) int64 { return f(x) }
+}
+
+func exact1(f func(*big.Float) *big.Float) func(x, y *big.Float) *big.Float {
+    return func(x, _ *big.Float) *big.Float { return f(x) }
+}
+
+func first(f func(int64) (int64, int64)) func(int64) int64 {
+    return func(x int64) int64 {
+        a, _ := f(x)
+        return a
+    }
+}
+
+func second(f func(int64) (int64, int64)) func(int64) int64 {
+    return func(x int64) int64 {
+        _, b := f(x)
+        return b
+    }
+}
+
+func k(name string, weight float64) kernel {
+    return kernel{name, weight}
+}
+
+// single Returns the inputs of a function of one argument.
+func single(in []int64) [][2]int64 {
+    out := make([][2]int64, len(in))
+    for i, x := range in {
+        out[i][0] = x
+    }
+    return out
+}
+
+// mirrored Returns in preceded by its negation, in ascending order.
+func mirrored(in []int64) []int64 {
+    out := make([]int64, 0, 2*len(in))
+    for i := len(in) - 1; i >= 0; i-- {
+        out = append(out, -in[i])
+    }
+    return append(out, in...)
+}
+
+func fixed(v float64) int64 {
+    return int64(math.Round(math.Ldexp(v, 32)))
+}
+
+var sweeps = []sweep{
+    {
+        name: "1/x", mode: accuracy.Relative, dir: -1, q30: 2, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(0x1p-15, 0x1p31, n, 32)) },
+        exact:  exact1(func(x *big.Float) *big.Float { return new(big.Float).Quo(accuracy.Float(1), x) }),
+        tiers: []tier{
+            {"Rcp", unary(fix64.Rcp), []kernel{k("RcpPoly4Lut8", 1)}},
+            {"RcpFast", unary(fix64.RcpFast), []kernel{k("RcpPoly6", 1)}},
+            {"RcpFastest", unary(fix64.RcpFastest), []kernel{k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        // Divisors below 1 would scale the truncation of the product by up to 1/|x| ulps.
+        name: "y/x", mode: accuracy.Relative, q30: 2, slack: 4,
+        inputs: func(n int) [][2]int64 {
+            in := single(mirrored(accuracy.Geometric(1, 0x1p15, n/2, 32)))
+            for i, y := range accuracy.Linear(-0x1p10, 0x1p10, len(in), 32) {
+                in[i][1] = y
+            }
+            return in
+        },
+        exact: func(x, y *big.Float) *big.Float { return new(big.Float).Quo(y, x) },
+        tiers: []tier{
+            {"DivPrecise", func(x, y int64) int64 { return fix64.DivPrecise(y, x) }, nil},
+            {"Div", func(x, y int64) int64 { return fix64.Div(y, x) }, []kernel{k("RcpPoly4Lut8", 1)}},
+            {"DivFast", func(x, y int64) int64 { return fix64.DivFast(y, x) }, []kernel{k("RcpPoly6", 1)}},
+            {"DivFastest", func(x, y int64) int64 { return fix64.DivFastest(y, x) }, []kernel{k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        name: "sqrt(x)", mode: accuracy.Relative, dir: 1, q30: 3, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(0x1p-24, 0x1p31, n, 32)) },
+        exact:  exact1(accuracy.Sqrt),
+        tiers: []tier{
+            {"SqrtPrecise", unary(fix64.SqrtPrecise), nil},
+            {"Sqrt", unary(fix64.Sqrt), []kernel{k("SqrtPoly3Lut8", 1)}},
+            {"SqrtFast", unary(fix64.SqrtFast), []kernel{k("SqrtPoly4", 1)}},
+            {"SqrtFastest", unary(fix64.SqrtFastest), []kernel{k("SqrtPoly3", 1)}},
+        },
+    },
+    {
+        name: "1/sqrt(x)", mode: accuracy.Relative, dir: -1, q30: 3, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(0x1p-24, 0x1p31, n, 32)) },
+        exact:  exact1(func(x *big.Float) *big.Float { return new(big.Float).Quo(accuracy.Float(1), accuracy.Sqrt(x)) }),
+        tiers: []tier{
+            {"RSqrt", unary(fix64.RSqrt), []kernel{k("RSqrtPoly3Lut16", 1)}},
+            {"RSqrtFast", unary(fix64.RSqrtFast), []kernel{k("RSqrtPoly5", 1)}},
+            {"RSqrtFastest", unary(fix64.RSqrtFastest), []kernel{k("RSqrtPoly3", 1)}},
+        },
+    },
+    {
+        name: "2^x", mode: accuracy.Relative, dir: 1, q30: 3, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-32, 31, n, 32)) },
+        exact:  exact1(accuracy.Exp2),
+        tiers: []tier{
+            {"Exp2", unary(fix64.Exp2), []kernel{k("Exp2Poly5", 1)}},
+            {"Exp2Fast", unary(fix64.Exp2Fast), []kernel{k("Exp2Poly4", 1)}},
+            {"Exp2Fastest", unary(fix64.Exp2Fastest), []kernel{k("Exp2Poly3", 1)}},
+        },
+    },
+    {
+        // x / ln(2) is rounded with a constant off by 2^-33, which costs up to |x| 2^-33 more.
+        name: "e^x", mode: accuracy.Relative, dir: 1, q30: 6, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-22, 21.4, n, 32)) },
+        exact:  exact1(accuracy.Exp),
+        tiers: []tier{
+            {"Exp", unary(fix64.Exp), []kernel{k("Exp2Poly5", 1)}},
+            {"ExpFast", unary(fix64.ExpFast), []kernel{k("Exp2Poly4", 1)}},
+            {"ExpFastest", unary(fix64.ExpFastest), []kernel{k("Exp2Poly3", 1)}},
+        },
+    },
+    {
+        name: "ln(x)", mode: accuracy.Absolute, dir: 1, q30: 2, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(0x1p-32, 0x1p31, n, 32)) },
+        exact:  exact1(accuracy.Log),
+        tiers: []tier{
+            {"Log", unary(fix64.Log), []kernel{k("LogPoly5Lut8", 1)}},
+            {"LogFast", unary(fix64.LogFast), []kernel{k("LogPoly3Lut8", 1)}},
+            {"LogFastest", unary(fix64.LogFastest), []kernel{k("LogPoly5", 1)}},
+        },
+    },
+    {
+        name: "log2(x)", mode: accuracy.Absolute, dir: 1, q30: 2, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(0x1p-32, 0x1p31, n, 32)) },
+        exact:  exact1(accuracy.Log2),
+        tiers: []tier{
+            {"Log2", unary(fix64.Log2), []kernel{k("Log2Poly4Lut16", 1)}},
+            {"Log2Fast", unary(fix64.Log2Fast), []kernel{k("Log2Poly3Lut16", 1)}},
+            {"Log2Fastest", unary(fix64.Log2Fastest), []kernel{k("Log2Poly5", 1)}},
+        },
+    },
+    {
+        // The error of ln(x) reaches the result scaled by |y| <= 4.
+        name: "x^y", mode: accuracy.Relative, q30: 8, slack: 2,
+        inputs: func(n int) [][2]int64 {
+            in := single(accuracy.Geometric(1.0/16, 16, n, 32))
+            for i, y := range accuracy.Linear(-4, 4, n, 32) {
+                in[(i*7919)%n][1] = y
+            }
+            return in
+        },
+        exact: func(x, y *big.Float) *big.Float { return accuracy.Exp(new(big.Float).Mul(y, accuracy.Log(x))) },
+        tiers: []tier{
+            {"Pow", fix64.Pow, []kernel{k("Exp2Poly5", 1), k("LogPoly5Lut8", 4)}},
+            {"PowFast", fix64.PowFast, []kernel{k("Exp2Poly4", 1), k("LogPoly3Lut8", 4)}},
+            {"PowFastest", fix64.PowFastest, []kernel{k("Exp2Poly3", 1), k("LogPoly5", 4)}},
+        },
+    },
+    {
+        name: "sin(x)", mode: accuracy.Absolute, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-2*math.Pi, 2*math.Pi, n, 32)) },
+        exact:  exact1(accuracy.Sin),
+        tiers: []tier{
+            {"Sin", unary(fix64.Sin), []kernel{k("SinPoly4", 1)}},
+            {"SinFast", unary(fix64.SinFast), []kernel{k("SinPoly3", 1)}},
+            {"SinFastest", unary(fix64.SinFastest), []kernel{k("SinPoly2", 1)}},
+            {"SinCos", unary(first(fix64.SinCos)), []kernel{k("SinPoly4", 1)}},
+            {"SinCosFast", unary(first(fix64.SinCosFast)), []kernel{k("SinPoly3", 1)}},
+            {"SinCosFastest", unary(first(fix64.SinCosFastest)), []kernel{k("SinPoly2", 1)}},
+        },
+    },
+    {
+        name: "cos(x)", mode: accuracy.Absolute, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-2*math.Pi, 2*math.Pi, n, 32)) },
+        exact:  exact1(accuracy.Cos),
+        tiers: []tier{
+            {"Cos", unary(fix64.Cos), []kernel{k("SinPoly4", 1)}},
+            {"CosFast", unary(fix64.CosFast), []kernel{k("SinPoly3", 1)}},
+            {"CosFastest", unary(fix64.CosFastest), []kernel{k("SinPoly2", 1)}},
+            {"SinCos", unary(second(fix64.SinCos)), []kernel{k("SinPoly4", 1)}},
+            {"SinCosFast", unary(second(fix64.SinCosFast)), []kernel{k("SinPoly3", 1)}},
+            {"SinCosFastest", unary(second(fix64.SinCosFastest)), []kernel{k("SinPoly2", 1)}},
+        },
+    },
+    {
+        // Over |x| <= 1 the errors of sin and cos reach tan(x) scaled by up to 1/cos(1) and tan(1)/cos(1),
+        // and the error of the reciprocal by up to tan(1).
+        name: "tan(x)", mode: accuracy.Absolute, dir: 1, q30: 8, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-1, 1, n, 32)) },
+        exact:  exact1(accuracy.Tan),
+        tiers: []tier{
+            {"Tan", unary(fix64.Tan), []kernel{k("SinPoly4", 5), k("RcpPoly4Lut8", 2)}},
+            {"TanFast", unary(fix64.TanFast), []kernel{k("SinPoly3", 5), k("RcpPoly6", 2)}},
+            {"TanFastest", unary(fix64.TanFastest), []kernel{k("SinPoly2", 5), k("RcpPoly4", 2)}},
+        },
+    },
+    {
+        name: "atan(x)", mode: accuracy.Absolute, dir: 1, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(mirrored(accuracy.Geometric(0x1p-16, 0x1p16, n/2, 32))) },
+        exact:  exact1(accuracy.Atan),
+        tiers: []tier{
+            {"Atan", unary(fix64.Atan), []kernel{k("AtanPoly5Lut8", 1), k("RcpPoly4Lut8", 1)}},
+            {"AtanFast", unary(fix64.AtanFast), []kernel{k("AtanPoly3Lut8", 1), k("RcpPoly6", 1)}},
+            {"AtanFastest", unary(fix64.AtanFastest), []kernel{k("AtanPoly4", 1), k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        // Points at every angle and at distances from 2^-4 to 2^16, as nearer the origin the quotient loses
+        // a few more bits.
+        name: "atan2(y, x)", mode: accuracy.Absolute, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 {
+            in := make([][2]int64, n)
+            for i, a := range accuracy.Linear(-math.Pi, math.Pi, n, 32) {
+                r := math.Exp2(20*float64((i*7919)%n)/float64(n) - 4)
+                theta := fix64.ToFloat64(a)
+                in[i] = [2]int64{fixed(r * math.Cos(theta)), fixed(r * math.Sin(theta))}
+            }
+            return in
+        },
+        exact: func(x, y *big.Float) *big.Float { return accuracy.Atan2(y, x) },
+        tiers: []tier{
+            {"Atan2", func(x, y int64) int64 { return fix64.Atan2(y, x) }, []kernel{k("AtanPoly5Lut8", 1), k("RcpPoly4Lut8", 1)}},
+            {"Atan2Fast", func(x, y int64) int64 { return fix64.Atan2Fast(y, x) }, []kernel{k("AtanPoly3Lut8", 1), k("RcpPoly6", 1)}},
+            {"Atan2Fastest", func(x, y int64) int64 { return fix64.Atan2Fastest(y, x) }, []kernel{k("AtanPoly4", 1), k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        // The relative error of sqrt(1 - x^2) reaches the result scaled by |x| <= 1.
+        name: "asin(x)", mode: accuracy.Absolute, dir: 1, q30: 6, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-0.999, 0.999, n, 32)) },
+        exact:  exact1(accuracy.Asin),
+        tiers: []tier{
+            {"Asin", unary(fix64.Asin), []kernel{k("SqrtPoly3Lut8", 1), k("AtanPoly5Lut8", 1), k("RcpPoly4Lut8", 1)}},
+            {"AsinFast", unary(fix64.AsinFast), []kernel{k("SqrtPoly4", 1), k("AtanPoly3Lut8", 1), k("RcpPoly6", 1)}},
+            {"AsinFastest", unary(fix64.AsinFastest), []kernel{k("SqrtPoly3", 1), k("AtanPoly4", 1), k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        name: "acos(x)", mode: accuracy.Absolute, dir: -1, q30: 6, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-0.999, 0.999, n, 32)) },
+        exact:  exact1(accuracy.Acos),
+        tiers: []tier{
+            {"Acos", unary(fix64.Acos), []kernel{k("SqrtPoly3Lut8", 1), k("AtanPoly5Lut8", 1), k("RcpPoly4Lut8", 1)}},
+            {"AcosFast", unary(fix64.AcosFast), []kernel{k("SqrtPoly4", 1), k("AtanPoly3Lut8", 1), k("RcpPoly6", 1)}},
+            {"AcosFastest", unary(fix64.AcosFastest), []kernel{k("SqrtPoly3", 1), k("AtanPoly4", 1), k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        // Below one the polynomial, above it the errors of e^x and e^-x reach the result scaled by up to
+        // coth(1) < 2, and x / ln(2) is rounded as for e^x.
+        name: "sinh(x)", mode: accuracy.Relative, dir: 1, q30: 8, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(mirrored(accuracy.Geometric(0x1p-16, 22, n/2, 32))) },
+        exact:  exact1(accuracy.Sinh),
+        tiers: []tier{
+            {"Sinh", unary(fix64.Sinh), []kernel{k("SinhPoly4", 1), k("Exp2Poly5", 2)}},
+            {"SinhFast", unary(fix64.SinhFast), []kernel{k("SinhPoly3", 1), k("Exp2Poly4", 2)}},
+            {"SinhFastest", unary(fix64.SinhFastest), []kernel{k("SinhPoly2", 1), k("Exp2Poly3", 2)}},
+        },
+    },
+    {
+        name: "cosh(x)", mode: accuracy.Relative, q30: 6, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(mirrored(accuracy.Geometric(0x1p-16, 22, n/2, 32))) },
+        exact:  exact1(accuracy.Cosh),
+        tiers: []tier{
+            {"Cosh", unary(fix64.Cosh), []kernel{k("Exp2Poly5", 1)}},
+            {"CoshFast", unary(fix64.CoshFast), []kernel{k("Exp2Poly4", 1)}},
+            {"CoshFastest", unary(fix64.CoshFastest), []kernel{k("Exp2Poly3", 1)}},
+        },
+    },
+    {
+        // The error of e^-2x reaches the result scaled by up to 1/2.
+        name: "tanh(x)", mode: accuracy.Absolute, dir: 1, q30: 6, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-8, 8, n, 32)) },
+        exact:  exact1(accuracy.Tanh),
+        tiers: []tier{
+            {"Tanh", unary(fix64.Tanh), []kernel{k("Exp2Poly5", 1), k("RcpPoly4Lut8", 1)}},
+            {"TanhFast", unary(fix64.TanhFast), []kernel{k("Exp2Poly4", 1), k("RcpPoly6", 1)}},
+            {"TanhFastest", unary(fix64.TanhFastest), []kernel{k("Exp2Poly3", 1), k("RcpPoly4", 1)}},
+        },
+    },
+    {
+        // The relative error of the square root reaches the result scaled by up to 1.
+        name: "asinh(x)", mode: accuracy.Absolute, dir: 1, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(mirrored(accuracy.Geometric(0x1p-16, 0x1p30, n/2, 32))) },
+        exact:  exact1(accuracy.Asinh),
+        tiers: []tier{
+            {"Asinh", unary(fix64.Asinh), []kernel{k("LogPoly5Lut8", 1), k("SqrtPoly3Lut8", 1)}},
+            {"AsinhFast", unary(fix64.AsinhFast), []kernel{k("LogPoly3Lut8", 1), k("SqrtPoly4", 1)}},
+            {"AsinhFastest", unary(fix64.AsinhFastest), []kernel{k("LogPoly5", 1), k("SqrtPoly3", 1)}},
+        },
+    },
+    {
+        name: "acosh(x)", mode: accuracy.Absolute, dir: 1, q30: 4, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Geometric(1, 0x1p30, n, 32)) },
+        exact:  exact1(accuracy.Acosh),
+        tiers: []tier{
+            {"Acosh", unary(fix64.Acosh), []kernel{k("LogPoly5Lut8", 1), k("SqrtPoly3Lut8", 1)}},
+            {"AcoshFast", unary(fix64.AcoshFast), []kernel{k("LogPoly3Lut8", 1), k("SqrtPoly4", 1)}},
+            {"AcoshFastest", unary(fix64.AcoshFastest), []kernel{k("LogPoly5", 1), k("SqrtPoly3", 1)}},
+        },
+    },
+    {
+        // Half of the difference of two logarithms.
+        name: "atanh(x)", mode: accuracy.Absolute, dir: 1, q30: 2, slack: 2,
+        inputs: func(n int) [][2]int64 { return single(accuracy.Linear(-0.999, 0.999, n, 32)) },
+        exact:  exact1(accuracy.Atanh),
+        tiers: []tier{
+            {"Atanh", unary(fix64.Atanh), []kernel{k("LogPoly5Lut8", 1)}},
+            {"AtanhFast", unary(fix64.AtanhFast), []kernel{k("LogPoly3Lut8", 1)}},
+            {"AtanhFastest", unary(fix64.AtanhFastest), []kernel{k("LogPoly5", 1)}},
+        },
+    },
+}
+
+// precision Returns the precision documented for a tier by the polynomials it relies on and the s2.30
+// roundings of the sweep.
+func precision(docs map[string]float64, s sweep, tr tier) float64 {
+    if tr.kernels == nil {
+        return math.Inf(1)
+    }
+    bits, weights := []float64{30}, []float64{s.q30}
+    for _, k := range tr.kernels {
+        bits, weights = append(bits, docs[k.name]), append(weights, k.weight)
+    }
+    return accuracy.Combined(bits, weights)
+}
+
+func TestAccuracy(t *testing.T) {
+    n := 2048
+    if testing.Short() {
+        n = 256
+    }
+    docs, err := accuracy.Documented("../fixutil/fix_util.go")
+    assert.NoError(t, err)
+    for _, s := range sweeps {
+        in := s.inputs(n)
+        want := make([]*big.Float, len(in))
+        for i, xy := range in {
+            want[i] = s.exact(accuracy.Fixed(xy[0], 32), accuracy.Fixed(xy[1], 32))
+        }
+        for _, tr := range s.tiers {
+            got := make([]int64, len(in))
+            for i, xy := range in {
+                got[i] = tr.fn(xy[0], xy[1])
+            }
+            bits := precision(docs, s, tr)
+            r := accuracy.Sweep{Shift: 32, Mode: s.mode, Bits: bits, Slack: s.slack, Monotone: s.dir}.Run(got, want)
+            t.Logf("%-13s %-12s needs %6.2f  %v", tr.name, s.name, bits, r)
+            w := in[r.Worst]
+            assert.Zero(t, r.Failures, "%s misses its precision, worst at (%v, %v) with %v for %v", tr.name,
+                fix64.ToFloat64(w[0]), fix64.ToFloat64(w[1]), fix64.ToFloat64(got[r.Worst]), want[r.Worst])
+            assert.Zero(t, r.Violations, "%s is not monotone", tr.name)
+        }
+    }
+}
+
+func BenchmarkAccuracy(b *testing.B) {
+    for _, s := range sweeps {
+        in := s.inputs(1024)
+        for _, tr := range s.tiers {
+            fn := tr.fn
+            b.Run(tr.name, func(b *testing.B) {
+                var sink int64
+                for i := 0; i < b.N; i++ {
+                    xy := in[i&(len(in)-1)]
+                    sink += fn(xy[0], xy[1])
+                }
+                benchSink = sink
+            })
+        }
+    }
+}
+
+var benchSink int64
@@ -0,0 +1,129 @@
+package fix64
+
+import (
+    "math/bits"
+)
+
+// Overflow-checked and saturating variants of the basic operators.
+//
+// The Checked functions report with ok=false when the true result does not fit into s32.32. AddChecked,
+// SubChecked and MulChecked still return the wrapped value of their unchecked counterparts, while
+// DivChecked returns 0 on overflow and on division by zero. The Sat functions clamp to MinValue/MaxValue
+// instead.
+
+// mul128 Returns the full signed 128-bit product of a and b.
+func mul128(a, b int64) (hi int64, lo uint64) {
+    uhi, lo := bits.Mul64(uint64(a), uint64(b))
+    hi = int64(uhi)
+    if a < 0 {
+        hi -= b
+    }
+    if b < 0 {
+        hi -= a
+    }
+    return hi, lo
+}
+
+// AddChecked Adds the two FP numbers together, reporting whether the result overflowed.
+func AddChecked(a, b int64) (int64, bool) {
+    r := a + b
+    return r, ((a ^ r) & (b ^ r)) >= 0
+}
+
+// SubChecked Subtracts the two FP numbers from each other, reporting whether the result overflowed.
+func SubChecked(a, b int64) (int64, bool) {
+    r := a - b
+    return r, ((a ^ b) & (a ^ r)) >= 0
+}
+
+// MulChecked Multiplies two FP values together, reporting whether the result overflowed.
+func MulChecked(a, b int64) (int64, bool) {
+    hi, _ := mul128(a, b)
+    // The result is the 128-bit product shifted right by 32, so it fits when the top 33 bits are all equal.
+    return Mul(a, b), hi>>31 == 0 || hi>>31 == -1
+}
+
+// DivChecked Divides two FP values like DivPrecise, truncating towards zero, but reports division by zero
+// and overflow with ok=false and a result of 0. Unlike DivPrecise, a divisor of MinValue is valid.
+func DivChecked(a, b int64) (int64, bool) {
+    if b == 0 {
+        return 0, false
+    }
+
+    var ua, ub uint64
+    if a < 0 {
+        ua = uint64(-a)
+    } else {
+        ua = uint64(a)
+    }
+    if b < 0 {
+        ub = uint64(-b)
+    } else {
+        ub = uint64(b)
+    }
+
+    hi, lo := ua>>32, ua<<32
+    if hi >= ub {
+        return 0, false
+    }
+    q, _ := bits.Div64(hi, lo, ub)
+
+    if (a ^ b) < 0 {
+        if q > 1<<63 {
+            return 0, false
+        }
+        return -int64(q), true
+    }
+    if q >= 1<<63 {
+        return 0, false
+    }
+    return int64(q), true
+}
+
+// saturate Returns the bound matching the sign of an overflowed result.
+func saturate(negative bool) int64 {
+    if negative {
+        return MinValue
+    }
+    return MaxValue
+}
+
+// AddSat Adds the two FP numbers together, clamping to MinValue/MaxValue on overflow.
+func AddSat(a, b int64) int64 {
+    r, ok := AddChecked(a, b)
+    if !ok {
+        return saturate(a < 0)
+    }
+    return r
+}
+
+// SubSat Subtracts the two FP numbers from each other, clamping to MinValue/MaxValue on overflow.
+func SubSat(a, b int64) int64 {
+    r, ok := SubChecked(a, b)
+    if !ok {
+        return saturate(a < 0)
+    }
+    return r
+}
+
+// MulSat Multiplies two FP values together, clamping to MinValue/MaxValue on overflow.
+func MulSat(a, b int64) int64 {
+    r, ok := MulChecked(a, b)
+    if !ok {
+        return saturate((a ^ b) < 0)
+    }
+    return r
+}
+
+// DivSat Divides two FP values, clamping to MinValue/MaxValue on overflow.
+// Division by zero saturates towards the sign of a, and 0 / 0 returns 0.
+func DivSat(a, b int64) int64 {
+    r, ok := DivChecked(a, b)
+    if !ok {
+        if b == 0 && a == 0 {
+            return 0
+        }
+        return saturate((a ^ b) < 0)
+    }
+    return r
+}
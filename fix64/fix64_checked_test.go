@@ -0,0 +1,113 @@
+package fix64_test
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+)
+
+var boundaryValues = []int64{
+    fix64.MinValue, fix64.MinValue + 1, fix64.MinValue / 2, -fix64.One - 1, fix64.Neg1, -fix64.Half, -1, 0,
+    1, fix64.Half, fix64.One, fix64.One + 1, fix64.Two, fix64.FromInt32(46341), fix64.FromInt32(-46341),
+    fix64.FromInt32(65536), fix64.FromInt32(-65536), fix64.MaxValue / 2, fix64.MaxValue - 1, fix64.MaxValue,
+}
+
+var (
+    bigMin = big.NewInt(fix64.MinValue)
+    bigMax = big.NewInt(fix64.MaxValue)
+)
+
+func fitsInt64(v *big.Int) bool {
+    return v.Cmp(bigMin) >= 0 && v.Cmp(bigMax) <= 0
+}
+
+func TestAddSubChecked(t *testing.T) {
+    for _, a := range boundaryValues {
+        for _, b := range boundaryValues {
+            sum := new(big.Int).Add(big.NewInt(a), big.NewInt(b))
+            r, ok := fix64.AddChecked(a, b)
+            assert.Equal(t, ok, fitsInt64(sum), "AddChecked(%d, %d)", a, b)
+            assert.Equal(t, r, fix64.Add(a, b))
+
+            diff := new(big.Int).Sub(big.NewInt(a), big.NewInt(b))
+            r, ok = fix64.SubChecked(a, b)
+            assert.Equal(t, ok, fitsInt64(diff), "SubChecked(%d, %d)", a, b)
+            assert.Equal(t, r, fix64.Sub(a, b))
+        }
+    }
+}
+
+func TestMulChecked(t *testing.T) {
+    for _, a := range boundaryValues {
+        for _, b := range boundaryValues {
+            // floor(a * b / 2^32)
+            p := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+            p.Rsh(p, 32)
+            r, ok := fix64.MulChecked(a, b)
+            assert.Equal(t, ok, fitsInt64(p), "MulChecked(%d, %d)", a, b)
+            if ok {
+                assert.Equal(t, r, p.Int64(), "MulChecked(%d, %d)", a, b)
+            }
+        }
+    }
+}
+
+func TestDivChecked(t *testing.T) {
+    for _, a := range boundaryValues {
+        for _, b := range boundaryValues {
+            r, ok := fix64.DivChecked(a, b)
+            if b == 0 {
+                assert.False(t, ok)
+                assert.Zero(t, r)
+                continue
+            }
+            // trunc(a * 2^32 / b)
+            q := new(big.Int).Lsh(big.NewInt(a), 32)
+            q.Quo(q, big.NewInt(b))
+            assert.Equal(t, ok, fitsInt64(q), "DivChecked(%d, %d)", a, b)
+            if ok {
+                assert.Equal(t, r, q.Int64(), "DivChecked(%d, %d)", a, b)
+            } else {
+                assert.Zero(t, r, "DivChecked(%d, %d)", a, b)
+            }
+        }
+    }
+}
+
+func TestSaturating(t *testing.T) {
+    assert.Equal(t, fix64.AddSat(fix64.MaxValue, fix64.One), fix64.MaxValue)
+    assert.Equal(t, fix64.AddSat(fix64.MinValue, fix64.Neg1), fix64.MinValue)
+    assert.Equal(t, fix64.AddSat(fix64.One, fix64.Two), fix64.Three)
+    assert.Equal(t, fix64.SubSat(fix64.MinValue, fix64.One), fix64.MinValue)
+    assert.Equal(t, fix64.SubSat(fix64.MaxValue, fix64.Neg1), fix64.MaxValue)
+    assert.Equal(t, fix64.SubSat(fix64.Zero, fix64.MinValue), fix64.MaxValue)
+    assert.Equal(t, fix64.MulSat(fix64.FromInt32(65536), fix64.FromInt32(65536)), fix64.MaxValue)
+    assert.Equal(t, fix64.MulSat(fix64.FromInt32(-65536), fix64.FromInt32(65536)), fix64.MinValue)
+    assert.Equal(t, fix64.MulSat(fix64.MinValue, fix64.MinValue), fix64.MaxValue)
+    assert.Equal(t, fix64.MulSat(fix64.Two, fix64.Three), fix64.FromInt32(6))
+    assert.Equal(t, fix64.DivSat(fix64.One, 0), fix64.MaxValue)
+    assert.Equal(t, fix64.DivSat(fix64.Neg1, 0), fix64.MinValue)
+    assert.Equal(t, fix64.DivSat(0, 0), fix64.Zero)
+    assert.Equal(t, fix64.DivSat(fix64.MaxValue, fix64.Half), fix64.MaxValue)
+    assert.Equal(t, fix64.DivSat(fix64.MaxValue, -fix64.Half), fix64.MinValue)
+    assert.Equal(t, fix64.DivSat(fix64.Three, fix64.Two), fix64.One+fix64.Half)
+    assert.Equal(t, fix64.DivSat(fix64.MinValue, fix64.One), fix64.MinValue)
+
+    for _, a := range boundaryValues {
+        for _, b := range boundaryValues {
+            p := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+            p.Rsh(p, 32)
+            switch {
+            case p.Cmp(bigMax) > 0:
+                assert.Equal(t, fix64.MulSat(a, b), fix64.MaxValue)
+            case p.Cmp(bigMin) < 0:
+                assert.Equal(t, fix64.MulSat(a, b), fix64.MinValue)
+            default:
+                assert.Equal(t, fix64.MulSat(a, b), p.Int64())
+            }
+        }
+    }
+}
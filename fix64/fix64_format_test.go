@@ -0,0 +1,84 @@
+package fix64_test
+
+import (
+    "math/rand"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+)
+
+func TestToString(t *testing.T) {
+    tests := []struct {
+        in   int64
+        want string
+    }{
+        {0, "0"},
+        {fix64.One, "1"},
+        {fix64.Neg1, "-1"},
+        {fix64.Half, "0.5"},
+        {-fix64.Two - fix64.Half, "-2.5"},
+        {fix64.Pi, "3.1415926537"},
+        {fix64.One / 3, "0.3333333333"},
+        {1, "0.0000000002"},
+        {-1, "-0.0000000002"},
+        {fix64.MaxValue, "2147483647.9999999998"},
+        {fix64.MinValue, "-2147483648"},
+    }
+    for _, tt := range tests {
+        assert.Equal(t, tt.want, fix64.ToString(tt.in))
+    }
+}
+
+func TestFormat(t *testing.T) {
+    tests := []struct {
+        in   int64
+        fmt  byte
+        prec int
+        want string
+    }{
+        {fix64.Pi, 'f', 0, "3"},
+        {fix64.Pi, 'f', 3, "3.142"},
+        {fix64.Pi, 'f', 4, "3.1416"},
+        {fix64.Half, 'f', 0, "0"},                           // ties to even
+        {fix64.One + fix64.Half, 'f', 0, "2"},               // ties to even
+        {fix64.One / 8, 'f', 2, "0.12"},                     // 0.125, ties to even
+        {fix64.One*3/8, 'f', 2, "0.38"},                     // 0.375, ties to even
+        {fix64.MaxValue, 'f', 4, "2147483648.0000"},         // carry into the integer part
+        {1, 'f', 32, "0.00000000023283064365386962890625"},  // exact
+        {fix64.One, 'f', 34, "1.0000000000000000000000000000000000"},
+        {-fix64.Half, 'f', 1, "-0.5"},
+        {fix64.One, 'x', -1, "0x100000000"},
+        {fix64.MinValue, 'x', -1, "-0x8000000000000000"},
+        {fix64.One, 'q', -1, "%q"},
+    }
+    for _, tt := range tests {
+        assert.Equal(t, tt.want, fix64.Format(tt.in, tt.fmt, tt.prec))
+    }
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    values := append([]int64{}, boundaryValues...)
+    for i := 0; i < 10000; i++ {
+        values = append(values, int64(r.Uint64()), int64(r.Uint64())>>r.Intn(64))
+    }
+    for _, v := range values {
+        s := fix64.ToString(v)
+        got, err := fix64.Parse(s)
+        assert.NoError(t, err)
+        assert.Equal(t, v, got, s)
+
+        // Shortest: dropping the last digit never round-trips.
+        if strings.IndexByte(s, '.') >= 0 {
+            shorter, _ := fix64.Parse(s[:len(s)-1])
+            assert.NotEqual(t, v, shorter, s)
+        }
+
+        got, err = fix64.Parse(fix64.Format(v, 'x', -1))
+        assert.NoError(t, err)
+        assert.Equal(t, v, got)
+    }
+}
@@ -0,0 +1,171 @@
+package fix64
+
+import (
+    "github.com/camry/fp/fixutil"
+)
+
+// Hyperbolic functions and their inverses, each tier built on the tier of the same name of Exp, Log, Sqrt
+// and Div.
+//
+// Sinh, Cosh and Tanh are defined for all x, Sinh and Cosh clamp to -MaxValue/MaxValue beyond |x| of
+// 32 ln(2) ~= 22.18. The inverses return 0 outside of their domain, as Log does: Acosh for x < 1 and Atanh
+// for |x| >= 1, including the ends where it is infinite.
+
+// sinhMax 32 ln(2), where e^x / 2 reaches 2^31.
+const sinhMax = 32 * RcpLog2E
+
+// Sinh Hyperbolic sine, (e^x - e^-x) / 2.
+func Sinh(x int64) int64 {
+    return sinh(x, Exp, fixutil.SinhPoly4)
+}
+
+func SinhFast(x int64) int64 {
+    return sinh(x, ExpFast, fixutil.SinhPoly3)
+}
+
+func SinhFastest(x int64) int64 {
+    return sinh(x, ExpFastest, fixutil.SinhPoly2)
+}
+
+func sinh(x int64, exp func(int64) int64, poly func(int32) int32) int64 {
+    if x < 0 {
+        return -sinh(-Max(x, -sinhMax), exp, poly)
+    }
+    if x >= sinhMax {
+        return MaxValue
+    }
+
+    // Below one, where e^x and e^-x cancel, x (1 + x^2 / 6 + ...) with the polynomial as s2.30.
+    if x < One {
+        const ONE int32 = 1 << 30
+        z := int32(x >> 2)
+        p := poly(fixutil.Qmul30(z, z)) - ONE
+        return x + Mul(x, int64(p)<<2)
+    }
+
+    // e^x / 2 as e^(x - ln(2)), which stays in range up to sinhMax.
+    return exp(x-RcpLog2E) - exp(-x-RcpLog2E)
+}
+
+// Cosh Hyperbolic cosine, (e^x + e^-x) / 2.
+func Cosh(x int64) int64 {
+    return cosh(x, Exp)
+}
+
+func CoshFast(x int64) int64 {
+    return cosh(x, ExpFast)
+}
+
+func CoshFastest(x int64) int64 {
+    return cosh(x, ExpFastest)
+}
+
+func cosh(x int64, exp func(int64) int64) int64 {
+    if x <= -sinhMax || x >= sinhMax {
+        return MaxValue
+    }
+    x = Abs(x)
+    return exp(x-RcpLog2E) + exp(-x-RcpLog2E)
+}
+
+// Tanh Hyperbolic tangent, sinh(x) / cosh(x), in [-1, 1].
+func Tanh(x int64) int64 {
+    return tanh(x, Exp, Div)
+}
+
+func TanhFast(x int64) int64 {
+    return tanh(x, ExpFast, DivFast)
+}
+
+func TanhFastest(x int64) int64 {
+    return tanh(x, ExpFastest, DivFastest)
+}
+
+func tanh(x int64, exp func(int64) int64, div func(a, b int64) int64) int64 {
+    // Beyond 16, 1 - tanh(x) is below 2^-45.
+    x = Clamp(x, -16*One, 16*One)
+    if x < 0 {
+        return -tanh(-x, exp, div)
+    }
+
+    // (1 - e^-2x) / (1 + e^-2x), where e^-2x <= 1 can't overflow.
+    e := exp(-x << 1)
+    return div(One-e, One+e)
+}
+
+// Asinh Inverse hyperbolic sine, ln(x + sqrt(x^2 + 1)).
+func Asinh(x int64) int64 {
+    return asinh(x, Log, Sqrt)
+}
+
+func AsinhFast(x int64) int64 {
+    return asinh(x, LogFast, SqrtFast)
+}
+
+func AsinhFastest(x int64) int64 {
+    return asinh(x, LogFastest, SqrtFastest)
+}
+
+func asinh(x int64, log, sqrt func(int64) int64) int64 {
+    if x < 0 {
+        return -asinh(-Max(x, -MaxValue), log, sqrt)
+    }
+
+    // From 2^15 on, x^2 overflows, and ln(2x) is within 1 / (4x^2) of the result.
+    if x >= 1<<15*One {
+        return log(x) + RcpLog2E
+    }
+    return log(x + sqrt(Mul(x, x)+One))
+}
+
+// Acosh Inverse hyperbolic cosine of x >= 1, ln(x + sqrt(x^2 - 1)), 0 below one.
+func Acosh(x int64) int64 {
+    return acosh(x, Log, Sqrt)
+}
+
+func AcoshFast(x int64) int64 {
+    return acosh(x, LogFast, SqrtFast)
+}
+
+func AcoshFastest(x int64) int64 {
+    return acosh(x, LogFastest, SqrtFastest)
+}
+
+func acosh(x int64, log, sqrt func(int64) int64) int64 {
+    // Return 0 for invalid values
+    if x < One {
+        return 0
+    }
+
+    // See asinh.
+    if x >= 1<<15*One {
+        return log(x) + RcpLog2E
+    }
+
+    // x^2 - 1 as (x - 1) (x + 1), which keeps its precision near one.
+    return log(x + sqrt(Mul(x-One, x+One)))
+}
+
+// Atanh Inverse hyperbolic tangent of |x| < 1, ln((1 + x) / (1 - x)) / 2, 0 beyond.
+func Atanh(x int64) int64 {
+    return atanh(x, Log)
+}
+
+func AtanhFast(x int64) int64 {
+    return atanh(x, LogFast)
+}
+
+func AtanhFastest(x int64) int64 {
+    return atanh(x, LogFastest)
+}
+
+func atanh(x int64, log func(int64) int64) int64 {
+    // Return 0 for invalid values
+    if x <= -One || x >= One {
+        return 0
+    }
+
+    // ln((1 + x) / (1 - x)) / 2 as a difference, as the quotient overflows near one. Halving towards zero
+    // keeps the result odd.
+    return (log(One+x) - log(One-x)) / 2
+}
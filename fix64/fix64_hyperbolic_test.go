@@ -0,0 +1,101 @@
+package fix64_test
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+)
+
+type hyperbolic struct {
+    name  string
+    tiers []func(int64) int64
+}
+
+var hyperbolics = []hyperbolic{
+    {"Sinh", []func(int64) int64{fix64.Sinh, fix64.SinhFast, fix64.SinhFastest}},
+    {"Cosh", []func(int64) int64{fix64.Cosh, fix64.CoshFast, fix64.CoshFastest}},
+    {"Tanh", []func(int64) int64{fix64.Tanh, fix64.TanhFast, fix64.TanhFastest}},
+    {"Asinh", []func(int64) int64{fix64.Asinh, fix64.AsinhFast, fix64.AsinhFastest}},
+    {"Acosh", []func(int64) int64{fix64.Acosh, fix64.AcoshFast, fix64.AcoshFastest}},
+    {"Atanh", []func(int64) int64{fix64.Atanh, fix64.AtanhFast, fix64.AtanhFastest}},
+}
+
+func tiersOf(name string) []func(int64) int64 {
+    for _, h := range hyperbolics {
+        if h.name == name {
+            return h.tiers
+        }
+    }
+    return nil
+}
+
+func TestHyperbolic_Domain(t *testing.T) {
+    for _, f := range tiersOf("Sinh") {
+        assert.Equal(t, fix64.Zero, f(0))
+        assert.Equal(t, fix64.MaxValue, f(fix64.FromInt32(23)))
+        assert.Equal(t, -fix64.MaxValue, f(fix64.FromInt32(-23)))
+        assert.Equal(t, -fix64.MaxValue, f(fix64.MinValue))
+        assert.Equal(t, fix64.MaxValue, f(fix64.MaxValue))
+    }
+    for _, f := range tiersOf("Cosh") {
+        assert.Equal(t, fix64.MaxValue, f(fix64.FromInt32(23)))
+        assert.Equal(t, fix64.MaxValue, f(fix64.MinValue))
+    }
+    for _, f := range tiersOf("Tanh") {
+        assert.Equal(t, fix64.Zero, f(0))
+        assert.Equal(t, fix64.One, f(fix64.MaxValue))
+        assert.Equal(t, -fix64.One, f(fix64.MinValue))
+    }
+    for _, f := range tiersOf("Asinh") {
+        assert.Equal(t, fix64.Zero, f(0))
+        assert.Equal(t, -f(fix64.MaxValue), f(fix64.MinValue))
+        assert.InDelta(t, math.Asinh(fix64.ToFloat64(fix64.MaxValue)), fix64.ToFloat64(f(fix64.MaxValue)), 1e-3)
+    }
+    for _, f := range tiersOf("Acosh") {
+        assert.Equal(t, fix64.Zero, f(fix64.One))
+        assert.Equal(t, fix64.Zero, f(fix64.One-1))
+        assert.Equal(t, fix64.Zero, f(fix64.MinValue))
+        assert.InDelta(t, math.Acosh(fix64.ToFloat64(fix64.MaxValue)), fix64.ToFloat64(f(fix64.MaxValue)), 1e-3)
+    }
+    for _, f := range tiersOf("Atanh") {
+        assert.Equal(t, fix64.Zero, f(0))
+        assert.Equal(t, fix64.Zero, f(fix64.One))
+        assert.Equal(t, fix64.Zero, f(-fix64.One))
+        assert.Equal(t, fix64.Zero, f(fix64.MaxValue))
+        assert.InDelta(t, 33*math.Ln2/2, fix64.ToFloat64(f(fix64.One-1)), 1e-3)
+    }
+}
+
+func TestHyperbolic_Symmetry(t *testing.T) {
+    for _, x := range []int64{1, fix64.Half, fix64.One, fix64.Pi, fix64.FromInt32(20), fix64.FromInt32(1000)} {
+        for _, h := range hyperbolics {
+            for _, f := range h.tiers {
+                switch h.name {
+                case "Cosh":
+                    assert.Equal(t, f(x), f(-x), "%s(%d)", h.name, x)
+                case "Acosh":
+                default:
+                    assert.Equal(t, -f(x), f(-x), "%s(%d)", h.name, x)
+                }
+            }
+        }
+    }
+}
+
+func TestSinCos(t *testing.T) {
+    for i := -1000; i <= 1000; i++ {
+        x := fix64.FromFloat64(float64(i) / 100)
+        sin, cos := fix64.SinCos(x)
+        assert.Equal(t, fix64.Sin(x), sin)
+        assert.InDelta(t, fix64.Cos(x), cos, 8)
+        sin, cos = fix64.SinCosFast(x)
+        assert.Equal(t, fix64.SinFast(x), sin)
+        assert.InDelta(t, fix64.CosFast(x), cos, 8)
+        sin, cos = fix64.SinCosFastest(x)
+        assert.Equal(t, fix64.SinFastest(x), sin)
+        assert.InDelta(t, fix64.CosFastest(x), cos, 8)
+    }
+}
@@ -0,0 +1,85 @@
+package fix64_test
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/fixutil"
+)
+
+func TestParse(t *testing.T) {
+    tests := []struct {
+        in   string
+        want int64
+    }{
+        {"0", 0},
+        {"-0", 0},
+        {"1", fix64.One},
+        {"+1", fix64.One},
+        {"-1", fix64.Neg1},
+        {"0.5", fix64.Half},
+        {".5", fix64.Half},
+        {"2.", fix64.Two},
+        {"-2.5", -fix64.Two - fix64.Half},
+        {"25e-1", fix64.Two + fix64.Half},
+        {"0.025E2", fix64.Two + fix64.Half},
+        {"3.141592653589793238462643383279", fix64.Pi},
+        {"2.718281828459045235360287471352", fix64.E},
+        {"0.00000000023283064365386962890625", 1},  // exactly 2^-32
+        {"0.000000000116415321826934814453125", 0}, // exactly half an ulp, ties to even
+        {"0.000000000349245965480804443359375", 2}, // 1.5 ulp, ties to even
+        {"0.0000000001164153218269348144531251", 1},
+        {"2147483647.99999999976716935634613037109375", fix64.MaxValue},
+        {"-2147483648", fix64.MinValue},
+        {"1e-10000", 0},
+        {"0x100000000", fix64.One},
+        {"-0x80000000", -fix64.Half},
+        {"0X7fffffffffffffff", fix64.MaxValue},
+        {"-0x8000000000000000", fix64.MinValue},
+    }
+    for _, tt := range tests {
+        got, err := fix64.Parse(tt.in)
+        assert.NoError(t, err, tt.in)
+        assert.Equal(t, tt.want, got, tt.in)
+    }
+}
+
+func TestParse_Errors(t *testing.T) {
+    for _, in := range []string{"", "+", "-", ".", "1..2", "1.2.3", "e5", "1e", "1e+", "1x", "0x", "0xg", " 1", "NaN", "Inf", "1_000"} {
+        _, err := fix64.Parse(in)
+        assert.True(t, errors.Is(err, fixutil.ErrSyntax), in)
+        var perr *fixutil.ParseError
+        assert.True(t, errors.As(err, &perr), in)
+        assert.Equal(t, in, perr.Num)
+    }
+    for _, tt := range []struct {
+        in   string
+        want int64
+    }{
+        {"2147483648", fix64.MaxValue},
+        {"2147483647.9999999999", fix64.MaxValue},
+        {"-2147483648.0000000002", fix64.MinValue},
+        {"1e10000", fix64.MaxValue},
+        {"-1e10000", fix64.MinValue},
+        {"0x8000000000000000", fix64.MaxValue},
+        {"-0x8000000000000001", fix64.MinValue},
+    } {
+        got, err := fix64.Parse(tt.in)
+        assert.True(t, errors.Is(err, fixutil.ErrRange), tt.in)
+        assert.Equal(t, tt.want, got, tt.in)
+    }
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    for i := 0; i < 10000; i++ {
+        v := int64(r.Uint64())
+        got, err := fix64.Parse(fix64.ToString(v))
+        assert.NoError(t, err)
+        assert.Equal(t, v, got)
+    }
+}
@@ -0,0 +1,165 @@
+package fix64
+
+import (
+    "math/bits"
+)
+
+// Powers and roots that don't go through Exp and Log.
+//
+// PowInt multiplies, so integer powers are exact up to the rounding of each product and keep the sign of a
+// negative base. NthRoot refines its estimate with a Newton step. Cbrt and Hypot are exact, they work on the
+// raw values in 128 bits.
+
+// absSat Returns the absolute value of v, MaxValue for MinValue which has no positive counterpart.
+func absSat(v int64) int64 {
+    if v == MinValue {
+        return MaxValue
+    }
+    return Abs(v)
+}
+
+// PowInt Calculates x to the integer power n by repeated squaring, clamping to MinValue/MaxValue on
+// overflow. Negative powers are the reciprocal of the positive one, and 0 to a negative power is MaxValue.
+func PowInt(x int64, n int32) int64 {
+    if n >= 0 {
+        return powUint(x, uint32(n))
+    }
+    m := uint32(-int64(n))
+
+    // Below one, the power of the reciprocal keeps more digits than the reciprocal of the power.
+    if -One < x && x < One {
+        return powUint(DivSat(One, x), m)
+    }
+    p := powUint(x, m)
+    if p == MaxValue || p == MinValue {
+        // The power is out of range, its reciprocal is below the resolution.
+        return 0
+    }
+    return DivSat(One, p)
+}
+
+func powUint(x int64, n uint32) int64 {
+    r := One
+    for ; n > 1; n >>= 1 {
+        if n&1 != 0 {
+            r = MulSat(r, x)
+        }
+        x = MulSat(x, x)
+    }
+    if n == 1 {
+        r = MulSat(r, x)
+    }
+    return r
+}
+
+// Cbrt Calculates the cube root of the given number, negative for negative x. The result is exact, truncated
+// towards zero.
+func Cbrt(x int64) int64 {
+    if x < 0 {
+        // uint64 of MinValue is its magnitude.
+        return -cbrt(uint64(-x))
+    }
+    return cbrt(uint64(x))
+}
+
+// cbrt Returns the largest r with r^3 <= x * 2^64, the s32.32 cube root of x, one bit at a time.
+func cbrt(x uint64) int64 {
+    var r uint64
+    // The root of 2^127 is below 2^43.
+    for b := 42; b >= 0; b-- {
+        c := r | 1<<b
+        sqHi, sqLo := bits.Mul64(c, c)
+        hiHi, hi := bits.Mul64(sqHi, c)
+        carry, lo := bits.Mul64(sqLo, c)
+        hi, over := bits.Add64(hi, carry, 0)
+        if hiHi == 0 && over == 0 && (hi < x || hi == x && lo == 0) {
+            r = c
+        }
+    }
+    return int64(r)
+}
+
+// NthRoot Calculates the n-th root of x, x to the power of 1 / n. Odd roots of negative x are negative, even
+// roots of negative x and roots with n <= 0 return 0.
+func NthRoot(x int64, n int32) int64 {
+    switch {
+    case n <= 0:
+        return 0
+    case n == 1:
+        return x
+    case n == 2:
+        return SqrtPrecise(x)
+    case n == 3:
+        return Cbrt(x)
+    }
+    if x < 0 {
+        if n&1 == 0 {
+            return 0
+        }
+        return -nthRoot(absSat(x), n)
+    }
+    return nthRoot(x, n)
+}
+
+func nthRoot(x int64, n int32) int64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Scale x up by 2^(k*n) while it fits, so that y^(n-1) keeps its precision for small x, and the root
+    // back down by 2^k.
+    k := (nlz(uint64(x)) - 1) / n
+    x <<= k * n
+
+    // Estimate from the logarithm, then one Newton step y += (x / y^(n-1) - y) / n.
+    y := Exp2(Log2(x) / int64(n))
+    y += (DivPrecise(x, PowInt(y, n-1)) - y) / int64(n)
+    return y >> k
+}
+
+// Hypot Calculates sqrt(a^2 + b^2) from the 128-bit sum of the squares, so that it neither overflows nor
+// underflows. The result is exact, truncated, and clamps to MaxValue when out of range.
+func Hypot(a, b int64) int64 {
+    hi, lo := addSqr(0, 0, a)
+    return hypot(addSqr(hi, lo, b))
+}
+
+// Hypot3 Calculates sqrt(a^2 + b^2 + c^2), see Hypot.
+func Hypot3(a, b, c int64) int64 {
+    hi, lo := addSqr(0, 0, a)
+    hi, lo = addSqr(hi, lo, b)
+    return hypot(addSqr(hi, lo, c))
+}
+
+// Hypot4 Calculates sqrt(a^2 + b^2 + c^2 + d^2), see Hypot.
+func Hypot4(a, b, c, d int64) int64 {
+    hi, lo := addSqr(0, 0, a)
+    hi, lo = addSqr(hi, lo, b)
+    hi, lo = addSqr(hi, lo, c)
+    return hypot(addSqr(hi, lo, d))
+}
+
+// addSqr Adds the square of the raw value of v to hi:lo. Four squares of values up to MaxValue fit.
+func addSqr(hi, lo uint64, v int64) (uint64, uint64) {
+    m := uint64(absSat(v))
+    sqHi, sqLo := bits.Mul64(m, m)
+    lo, carry := bits.Add64(lo, sqLo, 0)
+    return hi + sqHi + carry, lo
+}
+
+// hypot Returns the largest r with r^2 <= hi:lo, one bit at a time, which is the s32.32 root of the sum of
+// squares of raw values.
+func hypot(hi, lo uint64) int64 {
+    var r uint64
+    for b := 63; b >= 0; b-- {
+        c := r | 1<<b
+        sqHi, sqLo := bits.Mul64(c, c)
+        if sqHi < hi || sqHi == hi && sqLo <= lo {
+            r = c
+        }
+    }
+    if r > uint64(MaxValue) {
+        return MaxValue
+    }
+    return int64(r)
+}
@@ -0,0 +1,126 @@
+package fix64_test
+
+import (
+    "math"
+    "math/big"
+    "math/rand"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+)
+
+func TestPowInt(t *testing.T) {
+    assert.Equal(t, fix64.FromInt32(-8), fix64.PowInt(fix64.FromInt32(-2), 3))
+    assert.Equal(t, fix64.FromInt32(16), fix64.PowInt(fix64.FromInt32(-2), 4))
+    assert.Equal(t, fix64.One>>3, fix64.PowInt(fix64.FromInt32(2), -3))
+    assert.Equal(t, -fix64.One>>3, fix64.PowInt(fix64.FromInt32(-2), -3))
+    assert.Equal(t, fix64.One, fix64.PowInt(0, 0))
+    assert.Equal(t, fix64.Zero, fix64.PowInt(0, 5))
+    assert.Equal(t, fix64.MaxValue, fix64.PowInt(0, -1))
+    assert.Equal(t, fix64.MaxValue, fix64.PowInt(fix64.FromInt32(3), 40))
+    assert.Equal(t, fix64.MinValue, fix64.PowInt(fix64.FromInt32(-3), 41))
+    assert.Equal(t, fix64.Zero, fix64.PowInt(fix64.FromInt32(3), -40))
+    assert.Equal(t, fix64.Zero, fix64.PowInt(fix64.FromInt32(2), math.MinInt32))
+    assert.Equal(t, fix64.One, fix64.PowInt(fix64.One, math.MaxInt32))
+
+    r := rand.New(rand.NewSource(1))
+    for i := 0; i < 10000; i++ {
+        x := r.Float64()*8 - 4
+        n := int32(r.Intn(21) - 10)
+        want := math.Pow(fix64.ToFloat64(fix64.FromFloat64(x)), float64(n))
+        if math.Abs(want) > 1<<30 {
+            continue
+        }
+        got := fix64.ToFloat64(fix64.PowInt(fix64.FromFloat64(x), n))
+        assert.InDelta(t, want, got, 1e-8*math.Max(1, math.Abs(want)), "PowInt(%v, %d)", x, n)
+    }
+}
+
+func TestPow_IntegerExponent(t *testing.T) {
+    for _, pow := range []func(x, exponent int64) int64{fix64.Pow, fix64.PowFast, fix64.PowFastest} {
+        assert.Equal(t, fix64.FromInt32(-32), pow(fix64.FromInt32(-2), fix64.FromInt32(5)))
+        assert.Equal(t, fix64.One>>2, pow(fix64.FromInt32(-2), fix64.FromInt32(-2)))
+        assert.Equal(t, fix64.One, pow(fix64.FromInt32(-7), 0))
+        assert.Equal(t, fix64.Zero, pow(fix64.FromInt32(-2), fix64.Half))
+    }
+}
+
+func TestCbrt(t *testing.T) {
+    assert.Equal(t, fix64.FromInt32(3), fix64.Cbrt(fix64.FromInt32(27)))
+    assert.Equal(t, fix64.FromInt32(-2), fix64.Cbrt(fix64.FromInt32(-8)))
+    assert.Equal(t, fix64.One>>2, fix64.Cbrt(fix64.One>>6))
+
+    // The result is the largest r with r^3 <= x * 2^64.
+    check := func(x int64) {
+        r := fix64.Cbrt(x)
+        assert.Equal(t, -r, fix64.Cbrt(-x), "Cbrt(%d)", -x)
+        n := new(big.Int).Lsh(big.NewInt(x), 64)
+        cube := func(v int64) *big.Int {
+            b := big.NewInt(v)
+            return new(big.Int).Mul(b, new(big.Int).Mul(b, b))
+        }
+        assert.True(t, cube(r).Cmp(n) <= 0 && cube(r+1).Cmp(n) > 0, "Cbrt(%d) = %d", x, r)
+    }
+    r := rand.New(rand.NewSource(2))
+    for i := 0; i < 2000; i++ {
+        check(r.Int63() >> r.Intn(63))
+    }
+    for _, x := range []int64{0, 1, 2, fix64.One - 1, fix64.One, fix64.MaxValue} {
+        check(x)
+    }
+    assert.Equal(t, -fix64.Cbrt(fix64.MaxValue), fix64.Cbrt(fix64.MinValue))
+}
+
+func TestNthRoot(t *testing.T) {
+    assert.Equal(t, fix64.Zero, fix64.NthRoot(fix64.FromInt32(16), 0))
+    assert.Equal(t, fix64.Zero, fix64.NthRoot(fix64.FromInt32(16), -2))
+    assert.Equal(t, fix64.Zero, fix64.NthRoot(fix64.FromInt32(-16), 4))
+    assert.Equal(t, fix64.FromInt32(-5), fix64.NthRoot(fix64.FromInt32(-5), 1))
+    assert.Equal(t, fix64.SqrtPrecise(fix64.FromInt32(2)), fix64.NthRoot(fix64.FromInt32(2), 2))
+    assert.Equal(t, fix64.Cbrt(fix64.FromInt32(-2)), fix64.NthRoot(fix64.FromInt32(-2), 3))
+
+    r := rand.New(rand.NewSource(3))
+    for i := 0; i < 10000; i++ {
+        x := math.Exp(r.Float64()*40 - 20)
+        n := int32(r.Intn(30) + 4)
+        if n&1 != 0 && r.Intn(2) == 0 {
+            x = -x
+        }
+        fx := fix64.FromFloat64(x)
+        want := math.Copysign(math.Pow(math.Abs(fix64.ToFloat64(fx)), 1/float64(n)), x)
+        got := fix64.ToFloat64(fix64.NthRoot(fx, n))
+        assert.InDelta(t, want, got, 1e-8*math.Max(1, math.Abs(want)), "NthRoot(%v, %d)", x, n)
+    }
+}
+
+func TestHypot(t *testing.T) {
+    assert.Equal(t, fix64.FromInt32(5), fix64.Hypot(fix64.FromInt32(3), fix64.FromInt32(-4)))
+    assert.Equal(t, int64(5), fix64.Hypot(-3, 4))
+    assert.Equal(t, fix64.Zero, fix64.Hypot(0, 0))
+    assert.Equal(t, fix64.FromInt32(7), fix64.Hypot(0, fix64.FromInt32(-7)))
+    assert.Equal(t, fix64.FromInt32(13), fix64.Hypot3(fix64.FromInt32(3), fix64.FromInt32(4), fix64.FromInt32(-12)))
+    assert.Equal(t, fix64.FromInt32(2), fix64.Hypot4(fix64.One, -fix64.One, fix64.One, -fix64.One))
+    assert.Equal(t, fix64.MaxValue, fix64.Hypot(fix64.MaxValue, fix64.MaxValue))
+    assert.Equal(t, fix64.MaxValue, fix64.Hypot(fix64.MinValue, 0))
+    assert.Equal(t, fix64.MaxValue, fix64.Hypot4(fix64.MinValue, fix64.MinValue, fix64.MinValue, fix64.MinValue))
+
+    // The result is the largest r with r^2 <= a^2 + b^2 + c^2 + d^2 in raw values.
+    sqr := func(v int64) *big.Int {
+        return new(big.Int).Mul(big.NewInt(int64(v)), big.NewInt(int64(v)))
+    }
+    r := rand.New(rand.NewSource(4))
+    for i := 0; i < 2000; i++ {
+        a, b, c, d := r.Int63() >> r.Intn(63), -(r.Int63() >> r.Intn(63)), r.Int63() >> r.Intn(63), -(r.Int63() >> r.Intn(63))
+        check := func(h int64, n *big.Int) {
+            assert.True(t, sqr(h).Cmp(n) <= 0 && (h == fix64.MaxValue || sqr(h+1).Cmp(n) > 0), "Hypot(%d, %d, %d, %d) = %d", a, b, c, d, h)
+        }
+        n := new(big.Int).Add(sqr(a), sqr(b))
+        check(fix64.Hypot(a, b), n)
+        n.Add(n, sqr(c))
+        check(fix64.Hypot3(a, b, c), n)
+        n.Add(n, sqr(d))
+        check(fix64.Hypot4(a, b, c, d), n)
+    }
+}
@@ -0,0 +1,69 @@
+package fix64
+
+import (
+    "math/bits"
+
+    "github.com/camry/fp/fixutil"
+)
+
+// Multiply and divide with an explicit rounding mode. Mul rounds toward negative infinity and DivPrecise
+// toward zero, which makes long accumulations drift; fixutil.RoundNearestEven has no such bias.
+
+// MulRound Multiplies two FP values together, rounding the exact product according to mode. Overflow
+// wraps around like Mul.
+func MulRound(a, b int64, mode fixutil.RoundingMode) int64 {
+    hi, lo := mul128(a, b)
+    floor := int64(uint64(hi)<<Shift | lo>>Shift)
+    frac := lo & uint64(FractionMask)
+    return fixutil.RoundFromFloor(floor, frac != 0, cmpU64(frac, uint64(1)<<(Shift-1)), hi < 0, mode)
+}
+
+// DivRound Divides two FP values, rounding the exact quotient according to mode. Division by zero
+// returns 0 like DivPrecise, and overflow clamps to MinValue/MaxValue.
+func DivRound(a, b int64, mode fixutil.RoundingMode) int64 {
+    if b == 0 {
+        return 0
+    }
+    neg := (a < 0) != (b < 0) && a != 0
+    ua, ub := uint64(a), uint64(b)
+    if a < 0 {
+        ua = -ua
+    }
+    if b < 0 {
+        ub = -ub
+    }
+    if ua>>Shift >= ub {
+        return saturate(neg)
+    }
+    q, r := bits.Div64(ua>>Shift, ua<<Shift, ub)
+
+    // Floor of the signed quotient, and how the remainder compares to half of the divisor.
+    half := cmpU64(r, ub-r)
+    if !neg {
+        if q > uint64(MaxValue) {
+            return MaxValue
+        }
+        if v := fixutil.RoundFromFloor(int64(q), r != 0, half, false, mode); v >= 0 {
+            return v
+        }
+        return MaxValue
+    }
+    if q > 1<<63 || (q == 1<<63 && r != 0) {
+        return MinValue
+    }
+    if r == 0 {
+        return -int64(q)
+    }
+    return fixutil.RoundFromFloor(-int64(q)-1, true, -half, true, mode)
+}
+
+// cmpU64 Returns the sign of a - b.
+func cmpU64(a, b uint64) int {
+    if a < b {
+        return -1
+    }
+    if a > b {
+        return 1
+    }
+    return 0
+}
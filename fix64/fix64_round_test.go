@@ -0,0 +1,148 @@
+package fix64_test
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/fixutil"
+)
+
+var roundingModes = []fixutil.RoundingMode{
+    fixutil.RoundNearestEven, fixutil.RoundNearestAway, fixutil.RoundTowardZero, fixutil.RoundFloor, fixutil.RoundCeil,
+}
+
+// roundRat Rounds num / den to an integer according to mode, as the reference for MulRound and DivRound.
+func roundRat(num, den *big.Int, mode fixutil.RoundingMode) *big.Int {
+    if den.Sign() < 0 {
+        num, den = new(big.Int).Neg(num), new(big.Int).Neg(den)
+    }
+    floor, rem := new(big.Int).DivMod(num, den, new(big.Int)) // Euclidean, rem >= 0
+    if rem.Sign() == 0 {
+        return floor
+    }
+    ceil := new(big.Int).Add(floor, big.NewInt(1))
+    half := new(big.Int).Lsh(rem, 1).Cmp(den)
+    switch mode {
+    case fixutil.RoundFloor:
+        return floor
+    case fixutil.RoundCeil:
+        return ceil
+    case fixutil.RoundTowardZero:
+        if num.Sign() < 0 {
+            return ceil
+        }
+        return floor
+    case fixutil.RoundNearestAway:
+        if half > 0 || (half == 0 && num.Sign() > 0) {
+            return ceil
+        }
+        return floor
+    }
+    if half > 0 || (half == 0 && floor.Bit(0) == 1) {
+        return ceil
+    }
+    return floor
+}
+
+func TestMulRound(t *testing.T) {
+    values := append([]int64{fix64.Half + 1, -fix64.Half - 1, 3, -3, 0x180000000, -0x180000000}, boundaryValues...)
+    one := big.NewInt(fix64.One)
+    for _, mode := range roundingModes {
+        for _, a := range values {
+            for _, b := range values {
+                want := roundRat(new(big.Int).Mul(big.NewInt(a), big.NewInt(b)), one, mode)
+                if !fitsInt64(want) {
+                    continue
+                }
+                assert.Equal(t, want.Int64(), fix64.MulRound(a, b, mode), "MulRound(%d, %d, %v)", a, b, mode)
+            }
+        }
+    }
+    assert.Equal(t, fix64.Mul(-3, fix64.Half), fix64.MulRound(-3, fix64.Half, fixutil.RoundFloor))
+}
+
+func TestDivRound(t *testing.T) {
+    values := append([]int64{3, -3, 7, -7, fix64.FromInt32(3), fix64.FromInt32(-10)}, boundaryValues...)
+    for _, mode := range roundingModes {
+        for _, a := range values {
+            for _, b := range values {
+                if b == 0 {
+                    assert.Equal(t, int64(0), fix64.DivRound(a, b, mode))
+                    continue
+                }
+                want := roundRat(new(big.Int).Lsh(big.NewInt(a), 32), big.NewInt(b), mode)
+                got := fix64.DivRound(a, b, mode)
+                switch {
+                case want.Cmp(bigMax) > 0:
+                    assert.Equal(t, fix64.MaxValue, got)
+                case want.Cmp(bigMin) < 0:
+                    assert.Equal(t, fix64.MinValue, got)
+                default:
+                    assert.Equal(t, want.Int64(), got, "DivRound(%d, %d, %v)", a, b, mode)
+                }
+            }
+        }
+    }
+    // Ties: 1/2 ulp.
+    assert.Equal(t, int64(0), fix64.DivRound(1, fix64.Two, fixutil.RoundNearestEven))
+    assert.Equal(t, int64(1), fix64.DivRound(1, fix64.Two, fixutil.RoundNearestAway))
+    assert.Equal(t, int64(-1), fix64.DivRound(-1, fix64.Two, fixutil.RoundNearestAway))
+    assert.Equal(t, int64(2), fix64.DivRound(3, fix64.Two, fixutil.RoundNearestEven))
+}
+
+// TestRound_Unbiased Accumulates many products and quotients and compares the drift from the exact sum.
+// Truncating operators lose half an ulp per step on average; round-half-even stays within a few
+// multiples of the random walk bound sqrt(n).
+func TestRound_Unbiased(t *testing.T) {
+    const n = 100000
+    seed := uint64(0x9e3779b97f4a7c15)
+    next := func() int64 {
+        seed = seed*6364136223846793005 + 1442695040888963407
+        return int64(seed>>20) - 1<<43 // about +-2048
+    }
+
+    exactMul := new(big.Int)
+    var sumMul, sumMulRound int64
+    var errDiv, errDivRound float64 // in ulps
+    divErr := func(q, a, b int64) float64 {
+        e, _ := new(big.Rat).SetFrac(new(big.Int).Sub(new(big.Int).Mul(big.NewInt(q), big.NewInt(b)),
+            new(big.Int).Lsh(big.NewInt(a), 32)), big.NewInt(b)).Float64()
+        return e
+    }
+    for i := 0; i < n; i++ {
+        a, b := next(), next()
+        exactMul.Add(exactMul, new(big.Int).Mul(big.NewInt(a), big.NewInt(b)))
+        sumMul += fix64.Mul(a, b)
+        sumMulRound += fix64.MulRound(a, b, fixutil.RoundNearestEven)
+
+        // Positive operands, where truncation always errs downward.
+        a, b = a&0x7fffffffff, b&0x7fffffffff|fix64.One
+        errDiv += divErr(fix64.DivPrecise(a, b), a, b)
+        errDivRound += divErr(fix64.DivRound(a, b, fixutil.RoundNearestEven), a, b)
+    }
+
+    // Errors in ulps.
+    errMul, _ := new(big.Rat).SetFrac(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(sumMul), 32), exactMul), big.NewInt(fix64.One)).Float64()
+    errMulRound, _ := new(big.Rat).SetFrac(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(sumMulRound), 32), exactMul), big.NewInt(fix64.One)).Float64()
+    assert.Less(t, errMul, -0.4*n) // floor drifts down by n/2
+    assert.InDelta(t, 0, errMulRound, 5*316)
+    assert.Less(t, errDiv, -0.4*n)
+    assert.InDelta(t, 0, errDivRound, 5*316)
+
+    // Repeated damping, as in a simulation step: x *= 0.999 with floor rounding decays faster.
+    damping := fix64.FromFloat64(0.999)
+    x, xRound := fix64.FromInt32(1000), fix64.FromInt32(1000)
+    want := new(big.Float).SetPrec(1024).SetInt64(fix64.FromInt32(1000))
+    factor := new(big.Float).SetPrec(1024).SetMantExp(new(big.Float).SetInt64(damping), -32)
+    for i := 0; i < 1000; i++ {
+        x = fix64.Mul(x, damping)
+        xRound = fix64.MulRound(xRound, damping, fixutil.RoundNearestEven)
+        want.Mul(want, factor)
+    }
+    wantRaw, _ := want.Float64()
+    assert.Greater(t, wantRaw-float64(x), 250.0) // about sum(0.5 * 0.999^k) = 316 ulps
+    assert.InDelta(t, wantRaw, float64(xRound), 20)
+}
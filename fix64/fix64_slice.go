@@ -0,0 +1,82 @@
+package fix64
+
+// Batch variants of the basic operators over slices.
+//
+// Each function writes one result per element of dst and gives the same value as the scalar function
+// element by element. The operands must be at least as long as dst, and may be dst itself. Cutting the
+// operands to the length of dst up front lets the compiler drop the bounds checks from the loops.
+
+// cut Returns s[:n], panicking unless s holds n elements, which slicing alone would take from the capacity.
+func cut(s []int64, n int) []int64 {
+    if len(s) < n {
+        panic("fix64: operand shorter than the result")
+    }
+    return s[:n]
+}
+
+// AddSlice dst[i] = a[i] + b[i]
+func AddSlice(dst, a, b []int64) {
+    a, b = cut(a, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = a[i] + b[i]
+    }
+}
+
+// SubSlice dst[i] = a[i] - b[i]
+func SubSlice(dst, a, b []int64) {
+    a, b = cut(a, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = a[i] - b[i]
+    }
+}
+
+// MulSlice dst[i] = Mul(a[i], b[i])
+func MulSlice(dst, a, b []int64) {
+    a, b = cut(a, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = Mul(a[i], b[i])
+    }
+}
+
+// MulAddSlice dst[i] = c[i] + Mul(a[i], b[i])
+func MulAddSlice(dst, c, a, b []int64) {
+    c, a, b = cut(c, len(dst)), cut(a, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = c[i] + Mul(a[i], b[i])
+    }
+}
+
+// ScaleSlice dst[i] = Mul(a[i], s)
+func ScaleSlice(dst, a []int64, s int64) {
+    a = cut(a, len(dst))
+    for i := range dst {
+        dst[i] = Mul(a[i], s)
+    }
+}
+
+// AddScaledSlice dst[i] = a[i] + Mul(b[i], s)
+func AddScaledSlice(dst, a, b []int64, s int64) {
+    a, b = cut(a, len(dst)), cut(b, len(dst))
+    for i := range dst {
+        dst[i] = a[i] + Mul(b[i], s)
+    }
+}
+
+// LerpSlice dst[i] = Lerp(a[i], b[i], t)
+func LerpSlice(dst, a, b []int64, t int64) {
+    a, b = cut(a, len(dst)), cut(b, len(dst))
+    t1 := One - t
+    for i := range dst {
+        dst[i] = Mul(a[i], t) + Mul(b[i], t1)
+    }
+}
+
+// DotSlice Returns the sum of Mul(a[i], b[i]), b must be at least as long as a.
+func DotSlice(a, b []int64) int64 {
+    b = cut(b, len(a))
+    var sum int64
+    for i := range a {
+        sum += Mul(a[i], b[i])
+    }
+    return sum
+}
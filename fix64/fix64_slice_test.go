@@ -0,0 +1,73 @@
+package fix64_test
+
+import (
+    "math/rand"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+)
+
+func randomSlice(r *rand.Rand, n int) []int64 {
+    s := make([]int64, n)
+    for i := range s {
+        s[i] = r.Int63n(1<<40) - 1<<39
+    }
+    copy(s, boundaryValues)
+    return s
+}
+
+func TestSlice(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    n := 257
+    a, b, c := randomSlice(r, n), randomSlice(r, n), randomSlice(r, n)
+    s, tt := r.Int63n(1<<34)-1<<33, r.Int63n(fix64.One)
+    dst := make([]int64, n)
+
+    fix64.AddSlice(dst, a, b)
+    for i := range dst {
+        assert.Equal(t, fix64.Add(a[i], b[i]), dst[i])
+    }
+    fix64.SubSlice(dst, a, b)
+    for i := range dst {
+        assert.Equal(t, fix64.Sub(a[i], b[i]), dst[i])
+    }
+    fix64.MulSlice(dst, a, b)
+    for i := range dst {
+        assert.Equal(t, fix64.Mul(a[i], b[i]), dst[i])
+    }
+    fix64.MulAddSlice(dst, c, a, b)
+    for i := range dst {
+        assert.Equal(t, c[i]+fix64.Mul(a[i], b[i]), dst[i])
+    }
+    fix64.ScaleSlice(dst, a, s)
+    for i := range dst {
+        assert.Equal(t, fix64.Mul(a[i], s), dst[i])
+    }
+    fix64.AddScaledSlice(dst, a, b, s)
+    for i := range dst {
+        assert.Equal(t, a[i]+fix64.Mul(b[i], s), dst[i])
+    }
+    fix64.LerpSlice(dst, a, b, tt)
+    for i := range dst {
+        assert.Equal(t, fix64.Lerp(a[i], b[i], tt), dst[i])
+    }
+    var dot int64
+    for i := range a {
+        dot += fix64.Mul(a[i], b[i])
+    }
+    assert.Equal(t, dot, fix64.DotSlice(a, b))
+
+    // In place, and over a shorter dst.
+    want := append([]int64{}, a...)
+    fix64.AddScaledSlice(want, want, b, s)
+    fix64.AddScaledSlice(a, a, b, s)
+    assert.Equal(t, want, a)
+    dst[3] = 7
+    fix64.AddSlice(dst[:3], a, b)
+    assert.Equal(t, a[2]+b[2], dst[2])
+    assert.Equal(t, int64(7), dst[3])
+    assert.Panics(t, func() { fix64.AddSlice(dst, a[:n-1], b) })
+    assert.Panics(t, func() { fix64.DotSlice(a, b[:n-1]) })
+}
@@ -57,3 +57,14 @@ func TestFix64(t *testing.T) {
     assert.Equal(t, fix64.ToFloat32(f6), float32(257.14285))
     assert.Equal(t, fix64.ToFloat64(f6), 257.1428517694585)
 }
+
+func TestSqrt(t *testing.T) {
+    assert.Equal(t, fix64.Sqrt(fix64.FromInt32(4)), fix64.FromInt32(2))
+    assert.InDelta(t, fix64.ToFloat64(fix64.Sqrt(fix64.FromFloat64(0.3))), 0.5477225575, 0.000001)
+    assert.InDelta(t, fix64.ToFloat64(fix64.RSqrt(fix64.FromInt32(100))), 0.1, 0.000001)
+}
+
+func TestLog(t *testing.T) {
+    assert.InDelta(t, fix64.ToFloat64(fix64.Log(fix64.FromFloat64(0.99))), -0.0100503359, 0.000001)
+    assert.InDelta(t, fix64.ToFloat64(fix64.Log2(fix64.FromFloat64(0.3))), -1.7369655942, 0.000001)
+}
@@ -0,0 +1,440 @@
+package fixq
+
+import (
+    "math"
+
+    "github.com/camry/fp/fixutil"
+)
+
+// Fixed point values with a configurable Q format.
+//
+// A Q format is a type implementing Format, usually an empty struct. Values of 32-bit formats behave like
+// fix32 values: integer arithmetic wraps around at 32 bits, while results of the math functions and
+// conversions saturate. Predefined formats cover the common cases, others are declared the same way:
+//
+//	type Q12_20 struct{}
+//
+//	func (Q12_20) Shift() uint { return 20 }
+//	func (Q12_20) Bits() uint  { return 32 }
+//
+//	type Coord = fixq.Fixed[Q12_20]
+
+// Format Describes a Q format. Shift must be in [1, Bits-2] so that one is representable, and Bits must be
+// 32 or 64.
+type Format interface {
+    // Shift Returns the number of fraction bits.
+    Shift() uint
+    // Bits Returns the storage width, including the sign bit.
+    Bits() uint
+}
+
+type (
+    Q2_30  struct{} // Signed 2.30, for unit vectors and normals.
+    Q8_24  struct{} // Signed 8.24, for colors and blend factors.
+    Q16_16 struct{} // Signed 16.16, the fix32 layout.
+    Q24_8  struct{} // Signed 24.8, for coarse coordinates.
+    Q2_62  struct{} // Signed 2.62.
+    Q16_48 struct{} // Signed 16.48, for high precision fractions.
+    Q32_32 struct{} // Signed 32.32, the fix64 layout.
+    Q48_16 struct{} // Signed 48.16, for world coordinates.
+)
+
+func (Q2_30) Shift() uint  { return 30 }
+func (Q2_30) Bits() uint   { return 32 }
+func (Q8_24) Shift() uint  { return 24 }
+func (Q8_24) Bits() uint   { return 32 }
+func (Q16_16) Shift() uint { return 16 }
+func (Q16_16) Bits() uint  { return 32 }
+func (Q24_8) Shift() uint  { return 8 }
+func (Q24_8) Bits() uint   { return 32 }
+func (Q2_62) Shift() uint  { return 62 }
+func (Q2_62) Bits() uint   { return 64 }
+func (Q16_48) Shift() uint { return 48 }
+func (Q16_48) Bits() uint  { return 64 }
+func (Q32_32) Shift() uint { return 32 }
+func (Q32_32) Bits() uint  { return 64 }
+func (Q48_16) Shift() uint { return 16 }
+func (Q48_16) Bits() uint  { return 64 }
+
+// Fixed Signed fixed point value in the Q format Q. The raw value is kept in an int64 for every format,
+// sign-extended from the storage width.
+type Fixed[Q Format] struct {
+    Raw int64 // Raw fixed point value
+}
+
+func shiftOf[Q Format]() uint {
+    var q Q
+    return q.Shift()
+}
+
+func bitsOf[Q Format]() uint {
+    var q Q
+    return q.Bits()
+}
+
+func minRawOf[Q Format]() int64 {
+    return -1 << (bitsOf[Q]() - 1)
+}
+
+func maxRawOf[Q Format]() int64 {
+    return 1<<(bitsOf[Q]()-1) - 1
+}
+
+// wrap Narrows raw to the storage width of Q, wrapping around like integer arithmetic.
+func wrap[Q Format](raw int64) Fixed[Q] {
+    if n := 64 - bitsOf[Q](); n > 0 {
+        raw = raw << n >> n
+    }
+    return Fixed[Q]{Raw: raw}
+}
+
+// sat Narrows raw to the storage width of Q, saturating at the limits.
+func sat[Q Format](raw int64) Fixed[Q] {
+    if raw > maxRawOf[Q]() {
+        raw = maxRawOf[Q]()
+    } else if raw < minRawOf[Q]() {
+        raw = minRawOf[Q]()
+    }
+    return Fixed[Q]{Raw: raw}
+}
+
+/************************************/
+/*********** Construction ***********/
+/************************************/
+
+// FromRaw Creates a value from its raw representation, which is narrowed to the storage width of Q.
+func FromRaw[Q Format](raw int64) Fixed[Q] {
+    return wrap[Q](raw)
+}
+
+// FromInt Converts an integer, wrapping around when it is out of range like FromInt64 of fix64.
+func FromInt[Q Format](v int64) Fixed[Q] {
+    return wrap[Q](v << shiftOf[Q]())
+}
+
+// FromFloat64 Converts a float64, truncating toward zero and saturating when out of range.
+func FromFloat64[Q Format](v float64) Fixed[Q] {
+    r := math.Ldexp(v, int(shiftOf[Q]()))
+    switch {
+    case r != r:
+        return Fixed[Q]{}
+    case r >= math.MaxInt64:
+        return sat[Q](math.MaxInt64)
+    case r <= math.MinInt64:
+        return sat[Q](math.MinInt64)
+    }
+    return sat[Q](int64(r))
+}
+
+// Ratio Creates the value that's a divided by b, rounded toward zero.
+func Ratio[Q Format](a, b int64) Fixed[Q] {
+    raw, ok := divShift(a, b, shiftOf[Q]())
+    if !ok {
+        return Fixed[Q]{}
+    }
+    return sat[Q](raw)
+}
+
+// FromFix64 Converts a signed 32.32 raw value, such as F64.Raw, see Convert for the rounding.
+func FromFix64[Q Format](raw int64) Fixed[Q] {
+    v, _ := convertRaw[Q](raw, 32)
+    return v
+}
+
+// FromFix32 Converts a signed 16.16 raw value, such as F32.Raw, see Convert for the rounding.
+func FromFix32[Q Format](raw int32) Fixed[Q] {
+    v, _ := convertRaw[Q](int64(raw), 16)
+    return v
+}
+
+// Parse Converts a decimal string, or a raw hexadecimal literal, see fix64.Parse.
+func Parse[Q Format](s string) (Fixed[Q], error) {
+    raw, err := fixutil.ParseFixed("fixq.Parse", s, shiftOf[Q](), int(bitsOf[Q]()))
+    return Fixed[Q]{Raw: raw}, err
+}
+
+// Zero Returns 0.
+func Zero[Q Format]() Fixed[Q] {
+    return Fixed[Q]{}
+}
+
+// One Returns 1.
+func One[Q Format]() Fixed[Q] {
+    return Fixed[Q]{Raw: 1 << shiftOf[Q]()}
+}
+
+// Half Returns 0.5.
+func Half[Q Format]() Fixed[Q] {
+    return Fixed[Q]{Raw: 1 << (shiftOf[Q]() - 1)}
+}
+
+// Pi Returns pi, saturated in formats that cannot hold it.
+func Pi[Q Format]() Fixed[Q] {
+    return sat[Q](constQ(piQ62, shiftOf[Q]()))
+}
+
+// PiHalf Returns pi / 2.
+func PiHalf[Q Format]() Fixed[Q] {
+    return sat[Q](constQ(piQ62>>1, shiftOf[Q]()))
+}
+
+// E Returns e, saturated in formats that cannot hold it.
+func E[Q Format]() Fixed[Q] {
+    return sat[Q](constQ(eQ62, shiftOf[Q]()))
+}
+
+// MinValue Returns the smallest representable value.
+func MinValue[Q Format]() Fixed[Q] {
+    return Fixed[Q]{Raw: minRawOf[Q]()}
+}
+
+// MaxValue Returns the largest representable value.
+func MaxValue[Q Format]() Fixed[Q] {
+    return Fixed[Q]{Raw: maxRawOf[Q]()}
+}
+
+/************************************/
+/************ Conversion ************/
+/************************************/
+
+// convertRaw Rounds raw, with from fraction bits, to the nearest value of Q, ties to even. ok is false when
+// the result saturated.
+func convertRaw[Q Format](raw int64, from uint) (Fixed[Q], bool) {
+    to := shiftOf[Q]()
+    if to >= from {
+        d := to - from
+        switch {
+        case raw > maxRawOf[Q]()>>d:
+            return MaxValue[Q](), false
+        case raw < minRawOf[Q]()>>d:
+            return MinValue[Q](), false
+        }
+        return Fixed[Q]{Raw: raw << d}, true
+    }
+    d := from - to
+    q := raw >> d
+    rem := raw & (1<<d - 1)
+    half := int64(1) << (d - 1)
+    if rem > half || (rem == half && q&1 == 1) {
+        q++
+    }
+    return sat[Q](q), q >= minRawOf[Q]() && q <= maxRawOf[Q]()
+}
+
+// Convert Converts v to the format To, rounding to nearest with ties to even when To has fewer fraction
+// bits, and saturating when the value is out of range.
+func Convert[To, From Format](v Fixed[From]) Fixed[To] {
+    r, _ := convertRaw[To](v.Raw, shiftOf[From]())
+    return r
+}
+
+// ConvertChecked Converts like Convert, ok is false when the value is out of range for To.
+func ConvertChecked[To, From Format](v Fixed[From]) (r Fixed[To], ok bool) {
+    return convertRaw[To](v.Raw, shiftOf[From]())
+}
+
+// Fix64 Returns the value as a signed 32.32 raw value, such as F64.Raw, see Convert.
+func (f Fixed[Q]) Fix64() int64 {
+    r, _ := convertRaw[Q32_32](f.Raw, shiftOf[Q]())
+    return r.Raw
+}
+
+// Fix32 Returns the value as a signed 16.16 raw value, such as F32.Raw, see Convert.
+func (f Fixed[Q]) Fix32() int32 {
+    r, _ := convertRaw[Q16_16](f.Raw, shiftOf[Q]())
+    return int32(r.Raw)
+}
+
+// Float64 Converts the value into a float64.
+func (f Fixed[Q]) Float64() float64 {
+    return math.Ldexp(float64(f.Raw), -int(shiftOf[Q]()))
+}
+
+// Float32 Converts the value into a float32.
+func (f Fixed[Q]) Float32() float32 {
+    return float32(f.Float64())
+}
+
+// FloorToInt Converts the value into an integer by rounding it down.
+func (f Fixed[Q]) FloorToInt() int64 {
+    return f.Raw >> shiftOf[Q]()
+}
+
+// CeilToInt Converts the value into an integer by rounding it up.
+func (f Fixed[Q]) CeilToInt() int64 {
+    s := shiftOf[Q]()
+    return (f.Raw + (1<<s - 1)) >> s
+}
+
+// RoundToInt Converts the value into an integer by rounding it to nearest, ties up.
+func (f Fixed[Q]) RoundToInt() int64 {
+    s := shiftOf[Q]()
+    return (f.Raw + 1<<(s-1)) >> s
+}
+
+// ToString Converts the value to the shortest decimal string that parses back to the same value.
+func (f Fixed[Q]) ToString() string {
+    return fixutil.FormatFixed(f.Raw, shiftOf[Q](), 'f', -1)
+}
+
+// Text Converts the value to a string, see fix64.Format.
+func (f Fixed[Q]) Text(format byte, prec int) string {
+    return fixutil.FormatFixed(f.Raw, shiftOf[Q](), format, prec)
+}
+
+/************************************/
+/************ Arithmetic ************/
+/************************************/
+
+// Negate -f, wraps around for MinValue.
+func (f Fixed[Q]) Negate() Fixed[Q] {
+    return wrap[Q](-f.Raw)
+}
+
+// Add f + v2, wraps around on overflow.
+func (f Fixed[Q]) Add(v2 Fixed[Q]) Fixed[Q] {
+    return wrap[Q](f.Raw + v2.Raw)
+}
+
+// Sub f - v2, wraps around on overflow.
+func (f Fixed[Q]) Sub(v2 Fixed[Q]) Fixed[Q] {
+    return wrap[Q](f.Raw - v2.Raw)
+}
+
+// Mul f * v2, rounded down like fix64.Mul and wrapping around on overflow.
+func (f Fixed[Q]) Mul(v2 Fixed[Q]) Fixed[Q] {
+    return wrap[Q](mulShift(f.Raw, v2.Raw, shiftOf[Q]()))
+}
+
+// MulInt f * v2, wraps around on overflow.
+func (f Fixed[Q]) MulInt(v2 int64) Fixed[Q] {
+    return wrap[Q](f.Raw * v2)
+}
+
+// DivPrecise f / v2, rounded toward zero. Returns 0 for division by zero and saturates on overflow.
+func (f Fixed[Q]) DivPrecise(v2 Fixed[Q]) Fixed[Q] {
+    if v2.Raw == 0 {
+        return Fixed[Q]{}
+    }
+    raw, ok := divShift(f.Raw, v2.Raw, shiftOf[Q]())
+    if !ok {
+        if (f.Raw < 0) != (v2.Raw < 0) {
+            return MinValue[Q]()
+        }
+        return MaxValue[Q]()
+    }
+    return sat[Q](raw)
+}
+
+// Mod f % v2, returns 0 for v2 == 0.
+func (f Fixed[Q]) Mod(v2 Fixed[Q]) Fixed[Q] {
+    if v2.Raw == 0 {
+        return Fixed[Q]{}
+    }
+    return Fixed[Q]{Raw: f.Raw % v2.Raw}
+}
+
+// Lerp Linearly interpolates from f to b by t.
+func (f Fixed[Q]) Lerp(b, t Fixed[Q]) Fixed[Q] {
+    one := One[Q]()
+    return f.Mul(one.Sub(t)).Add(b.Mul(t))
+}
+
+// Abs Returns the absolute value, wraps around for MinValue.
+func (f Fixed[Q]) Abs() Fixed[Q] {
+    if f.Raw < 0 {
+        return f.Negate()
+    }
+    return f
+}
+
+// Sign Returns -1, 0 or 1.
+func (f Fixed[Q]) Sign() int32 {
+    switch {
+    case f.Raw < 0:
+        return -1
+    case f.Raw > 0:
+        return 1
+    }
+    return 0
+}
+
+// Floor Rounds down to an integer.
+func (f Fixed[Q]) Floor() Fixed[Q] {
+    return Fixed[Q]{Raw: f.Raw &^ (1<<shiftOf[Q]() - 1)}
+}
+
+// Ceil Rounds up to an integer, wraps around at the top of the range.
+func (f Fixed[Q]) Ceil() Fixed[Q] {
+    mask := int64(1)<<shiftOf[Q]() - 1
+    return wrap[Q]((f.Raw + mask) &^ mask)
+}
+
+// Round Rounds to the nearest integer, ties up, wraps around at the top of the range.
+func (f Fixed[Q]) Round() Fixed[Q] {
+    s := shiftOf[Q]()
+    return wrap[Q]((f.Raw + 1<<(s-1)) &^ (1<<s - 1))
+}
+
+// Fract Returns the fractional part, f - f.Floor().
+func (f Fixed[Q]) Fract() Fixed[Q] {
+    return Fixed[Q]{Raw: f.Raw & (1<<shiftOf[Q]() - 1)}
+}
+
+func (f Fixed[Q]) Min(v2 Fixed[Q]) Fixed[Q] {
+    if f.Raw < v2.Raw {
+        return f
+    }
+    return v2
+}
+
+func (f Fixed[Q]) Max(v2 Fixed[Q]) Fixed[Q] {
+    if f.Raw > v2.Raw {
+        return f
+    }
+    return v2
+}
+
+func (f Fixed[Q]) Clamp(min, max Fixed[Q]) Fixed[Q] {
+    if f.Raw > max.Raw {
+        return max
+    }
+    if f.Raw < min.Raw {
+        return min
+    }
+    return f
+}
+
+func (f Fixed[Q]) EQ(v2 Fixed[Q]) bool {
+    return f.Raw == v2.Raw
+}
+
+func (f Fixed[Q]) NE(v2 Fixed[Q]) bool {
+    return f.Raw != v2.Raw
+}
+
+func (f Fixed[Q]) LT(v2 Fixed[Q]) bool {
+    return f.Raw < v2.Raw
+}
+
+func (f Fixed[Q]) LE(v2 Fixed[Q]) bool {
+    return f.Raw <= v2.Raw
+}
+
+func (f Fixed[Q]) GT(v2 Fixed[Q]) bool {
+    return f.Raw > v2.Raw
+}
+
+func (f Fixed[Q]) GE(v2 Fixed[Q]) bool {
+    return f.Raw >= v2.Raw
+}
+
+func (f Fixed[Q]) CompareTo(other Fixed[Q]) int32 {
+    if f.Raw < other.Raw {
+        return -1
+    }
+    if f.Raw > other.Raw {
+        return +1
+    }
+    return 0
+}
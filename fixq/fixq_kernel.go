@@ -0,0 +1,360 @@
+package fixq
+
+import (
+    "math"
+    "math/bits"
+
+    "github.com/camry/fp/fixutil"
+)
+
+// Raw helpers shared by every Q format. They take the number of fraction bits s explicitly and return
+// 64-bit results; the Fixed methods narrow them to the storage width of the format.
+
+// Constants as unsigned Q2.62, rounded to nearest.
+const (
+    piQ62     uint64 = 0xc90fdaa22168c235 // pi
+    eQ62      uint64 = 0xadf85458a2bb4a9b // e
+    ln2Q62    uint64 = 0x2c5c85fdf473de6b // log(2)
+    rcpLn2Q62 uint64 = 0x5c551d94ae0bf85e // 1.0 / log(2)
+    twoOverPi uint64 = 0x28be60db9391054a // 2.0 / pi, maps [0, 2pi] to [0, 4]
+)
+
+// s2.30 constants used by the fixutil kernels.
+const (
+    one30        int32 = 1 << 30
+    sqrt2Q30     int32 = 1518500249 // sqrt(2.0)
+    halfSqrt2Q30 int32 = 759250125  // 0.5 * sqrt(2.0)
+)
+
+// wideShift Returns the fraction bits of the logarithms and exponents used inside Exp and Pow. Capping it
+// leaves integer bits for intermediates that do not fit formats like Q2_62, and 48 bits is still well
+// beyond the precision of the kernels.
+func wideShift(s uint) uint {
+    if s > 48 {
+        return 48
+    }
+    return s
+}
+
+// constQ Rounds an unsigned Q2.62 constant to s fraction bits, saturating when it does not fit.
+func constQ(c uint64, s uint) int64 {
+    if s < 62 {
+        d := 62 - s
+        c = (c >> d) + (c >> (d - 1) & 1)
+    }
+    if c > math.MaxInt64 {
+        return math.MaxInt64
+    }
+    return int64(c)
+}
+
+// mul128 Returns the full signed 128-bit product of a and b.
+func mul128(a, b int64) (hi int64, lo uint64) {
+    h, l := bits.Mul64(uint64(a), uint64(b))
+    hi = int64(h)
+    if a < 0 {
+        hi -= b
+    }
+    if b < 0 {
+        hi -= a
+    }
+    return hi, l
+}
+
+// mulShift Returns floor(a * b / 2^sh), truncated to 64 bits.
+func mulShift(a, b int64, sh uint) int64 {
+    hi, lo := mul128(a, b)
+    switch {
+    case sh == 0:
+        return int64(lo)
+    case sh < 64:
+        return int64(lo>>sh | uint64(hi)<<(64-sh))
+    case sh < 128:
+        return hi >> (sh - 64)
+    }
+    return hi >> 63
+}
+
+// mulShiftSat Returns floor(a * b * 2^sh), saturated to 64 bits. sh may be negative.
+func mulShiftSat(a, b int64, sh int) int64 {
+    hi, lo := mul128(a, b)
+    if sh < 0 {
+        r := uint(-sh)
+        switch {
+        case r < 64:
+            lo = lo>>r | uint64(hi)<<(64-r)
+            hi >>= r
+        case r < 128:
+            lo = uint64(hi >> (r - 64))
+            hi >>= 63
+        default:
+            lo = uint64(hi >> 63)
+            hi >>= 63
+        }
+    }
+    if hi != int64(lo)>>63 {
+        if hi < 0 {
+            return math.MinInt64
+        }
+        return math.MaxInt64
+    }
+    if sh > 0 {
+        return shiftSat(int64(lo), sh)
+    }
+    return int64(lo)
+}
+
+// divShift Returns a * 2^sh / b truncated toward zero, ok is false when b is 0 or the quotient overflows.
+func divShift(a, b int64, sh uint) (int64, bool) {
+    if b == 0 || sh > 63 {
+        return 0, false
+    }
+    neg := (a < 0) != (b < 0)
+    ua, ub := uint64(a), uint64(b)
+    if a < 0 {
+        ua = -ua
+    }
+    if b < 0 {
+        ub = -ub
+    }
+    var hi uint64
+    if sh > 0 {
+        hi = ua >> (64 - sh)
+    }
+    if hi >= ub {
+        return 0, false
+    }
+    q, _ := bits.Div64(hi, ua<<sh, ub)
+    if neg {
+        if q > 1<<63 {
+            return 0, false
+        }
+        return -int64(q), true
+    }
+    if q > 1<<63-1 {
+        return 0, false
+    }
+    return int64(q), true
+}
+
+// shiftSat Returns floor(v * 2^sh), saturated to 64 bits. sh may be negative.
+func shiftSat(v int64, sh int) int64 {
+    switch {
+    case sh <= -64:
+        return v >> 63
+    case sh < 0:
+        return v >> -sh
+    case v == 0:
+        return 0
+    case sh >= 63 || v > math.MaxInt64>>sh:
+        if v < 0 {
+            return math.MinInt64
+        }
+        return math.MaxInt64
+    case v < math.MinInt64>>sh:
+        return math.MinInt64
+    }
+    return v << sh
+}
+
+// fromQ30 Converts an s2.30 kernel result to s fraction bits, rounding to nearest.
+func fromQ30(y int32, s uint) int64 {
+    if s >= 30 {
+        return int64(y) << (s - 30)
+    }
+    d := 30 - s
+    return (int64(y) + 1<<(d-1)) >> d
+}
+
+// normalize Splits x > 0 into an s2.30 mantissa in [1.0, 2.0( and the exponent offset, so that
+// x == n * 2^offset in a format with s fraction bits.
+func normalize(x int64, s uint) (n int32, offset int) {
+    top := 63 - bits.LeadingZeros64(uint64(x))
+    return int32(shiftSat(x, 30-top)), top - int(s)
+}
+
+func sqrtRaw(x int64, s uint, poly func(int32) int32) int64 {
+    if x <= 0 {
+        return 0
+    }
+    n, offset := normalize(x, s)
+    y := poly(n - one30)
+
+    // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
+    adjust := one30
+    if offset&1 != 0 {
+        adjust = sqrt2Q30
+    }
+    return shiftSat(int64(fixutil.Qmul30(adjust, y)), int(s)-30+offset>>1)
+}
+
+func rsqrtRaw(x int64, s uint, poly func(int32) int32) int64 {
+    if x <= 0 {
+        return 0
+    }
+    n, offset := normalize(x, s)
+    y := poly(n - one30)
+
+    adjust := one30
+    if offset&1 != 0 {
+        adjust = halfSqrt2Q30
+    }
+    return shiftSat(int64(fixutil.Qmul30(adjust, y)), int(s)-30-offset>>1)
+}
+
+func rcpRaw(x int64, s uint, poly func(int32) int32) int64 {
+    if x == 0 || x == -1<<63 {
+        return 0
+    }
+    neg := x < 0
+    if neg {
+        x = -x
+    }
+    n, offset := normalize(x, s)
+    y := shiftSat(int64(poly(n-one30)), int(s)-30-offset)
+    if neg {
+        return -y
+    }
+    return y
+}
+
+func divRaw(a, b int64, s uint, poly func(int32) int32) int64 {
+    if b == 0 || b == -1<<63 {
+        return 0
+    }
+    neg := b < 0
+    if neg {
+        b = -b
+    }
+    n, offset := normalize(b, s)
+    res := int64(poly(n - one30))
+
+    // a / b == a * rcp(n) * 2^-offset, with rcp(n) as s2.30.
+    y := mulShiftSat(a, res, -30-offset)
+    if neg {
+        return -y
+    }
+    return y
+}
+
+// exp2Raw Computes 2^x for x with xs fraction bits, returning s fraction bits.
+func exp2Raw(x int64, xs, s uint, maxRaw int64, poly func(int32) int32) int64 {
+    intPart := x >> xs
+    // 2^intPart * [1.0, 2.0( overflows once intPart reaches the integer bits of the format.
+    if intPart >= int64(bits.Len64(uint64(maxRaw)))-int64(s) {
+        return maxRaw
+    }
+    if intPart < -int64(s)-1 {
+        return 0
+    }
+
+    // Compute exp2 for fractional part (as s2.30).
+    frac := x & (1<<xs - 1)
+    k := int32(shiftSat(frac, 30-int(xs)))
+    y := int64(poly(k))
+
+    // Combine integer and fractional result.
+    return shiftSat(y, int(s)-30+int(intPart))
+}
+
+// logRaw Computes the natural logarithm of x with s fraction bits, returning ys fraction bits.
+func logRaw(x int64, s, ys uint, poly func(int32) int32) int64 {
+    if x <= 0 {
+        return 0
+    }
+    n, offset := normalize(x, s)
+    y := fromQ30(poly(n-one30), ys)
+    return int64(offset)*constQ(ln2Q62, ys) + y
+}
+
+// log2Raw Computes the binary logarithm of x with s fraction bits, returning ys fraction bits.
+func log2Raw(x int64, s, ys uint, poly func(int32) int32) int64 {
+    if x <= 0 {
+        return 0
+    }
+    n, offset := normalize(x, s)
+    y := fromQ30(poly(n-one30), ys)
+    return int64(offset)<<ys + y
+}
+
+// unitAngle Maps x radians to s2.30 quarter turns, wrapped into one period.
+func unitAngle(x int64, s uint) int32 {
+    return int32(mulShift(x, int64(twoOverPi), s+32))
+}
+
+// unitSin Computes the sine of z quarter turns as s2.30.
+func unitSin(z int32, poly func(int32) int32) int32 {
+    // See: http://www.coranac.com/2009/07/sines/
+
+    // Handle quadrants 1 and 2 by mirroring the [1, 3] range to [-1, 1] (by calculating 2 - z).
+    if (z ^ (z << 1)) < 0 {
+        z = int32((1 << 31) - int64(z))
+    }
+
+    zz := fixutil.Qmul30(z, z)
+    return fixutil.Qmul30(poly(zz), z)
+}
+
+// tanRaw Computes sin / cos, saturating near the poles.
+func tanRaw(x int64, s uint, poly func(int32) int32) int64 {
+    z := unitAngle(x, s)
+    sin, cos := unitSin(z, poly), unitSin(z+one30, poly)
+    if r, ok := divShift(int64(sin), int64(cos), s); ok {
+        return r
+    }
+    if (sin < 0) != (cos < 0) {
+        return math.MinInt64
+    }
+    return math.MaxInt64
+}
+
+func atan2Raw(y, x int64, s uint, poly func(int32) int32) int64 {
+    // See: https://www.dsprelated.com/showarticle/1052.php
+    piHalf := constQ(piQ62>>1, s)
+    pi := constQ(piQ62, s)
+    if x == 0 {
+        if y > 0 {
+            return piHalf
+        }
+        if y < 0 {
+            return -piHalf
+        }
+        return 0
+    }
+
+    // Work with magnitudes; |MinValue| is off by one, which is below the kernel precision.
+    nx, ny := x, y
+    if nx < 0 {
+        nx = ^nx
+    }
+    if ny < 0 {
+        ny = ^ny
+    }
+    neg := (x < 0) != (y < 0)
+
+    var angle int64
+    if nx >= ny {
+        k, _ := divShift(ny, nx, 30)
+        angle = fromQ30(poly(int32(k)), s)
+    } else {
+        k, _ := divShift(nx, ny, 30)
+        angle = fromQ30(poly(int32(k)), s)
+    }
+    if neg {
+        angle = -angle
+    }
+
+    if nx >= ny {
+        if x > 0 {
+            return angle
+        }
+        if y >= 0 {
+            return angle + pi
+        }
+        return angle - pi
+    }
+    if y > 0 {
+        return piHalf - angle
+    }
+    return -piHalf - angle
+}
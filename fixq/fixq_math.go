@@ -0,0 +1,322 @@
+package fixq
+
+import (
+    "github.com/camry/fp/fixutil"
+)
+
+// Math functions built on the fixutil polynomial kernels, using the same kernels and tiers as fix64. The
+// kernels work on s2.30 values, so results carry at most about 30 bits of precision whatever the format;
+// inputs are normalized first, so formats with more fraction bits keep that relative precision for small
+// values. Results that do not fit the format saturate.
+
+// Div Divides using a polynomial approximation of the reciprocal, see DivPrecise for the exact quotient.
+func (f Fixed[Q]) Div(v2 Fixed[Q]) Fixed[Q] {
+    return sat[Q](divRaw(f.Raw, v2.Raw, shiftOf[Q](), fixutil.RcpPoly4Lut8))
+}
+
+// DivFast Divides using a polynomial approximation of the reciprocal, see DivPrecise for the exact quotient.
+func (f Fixed[Q]) DivFast(v2 Fixed[Q]) Fixed[Q] {
+    return sat[Q](divRaw(f.Raw, v2.Raw, shiftOf[Q](), fixutil.RcpPoly6))
+}
+
+// DivFastest Divides using a polynomial approximation of the reciprocal, see DivPrecise for the exact quotient.
+func (f Fixed[Q]) DivFastest(v2 Fixed[Q]) Fixed[Q] {
+    return sat[Q](divRaw(f.Raw, v2.Raw, shiftOf[Q](), fixutil.RcpPoly4))
+}
+
+// Sqrt Calculates the square root, 0 for non-positive values.
+func (f Fixed[Q]) Sqrt() Fixed[Q] {
+    return sat[Q](sqrtRaw(f.Raw, shiftOf[Q](), fixutil.SqrtPoly3Lut8))
+}
+
+// SqrtFast Calculates the square root, 0 for non-positive values.
+func (f Fixed[Q]) SqrtFast() Fixed[Q] {
+    return sat[Q](sqrtRaw(f.Raw, shiftOf[Q](), fixutil.SqrtPoly4))
+}
+
+// SqrtFastest Calculates the square root, 0 for non-positive values.
+func (f Fixed[Q]) SqrtFastest() Fixed[Q] {
+    return sat[Q](sqrtRaw(f.Raw, shiftOf[Q](), fixutil.SqrtPoly3))
+}
+
+// RSqrt Calculates the reciprocal square root, 0 for non-positive values.
+func (f Fixed[Q]) RSqrt() Fixed[Q] {
+    return sat[Q](rsqrtRaw(f.Raw, shiftOf[Q](), fixutil.RSqrtPoly3Lut16))
+}
+
+// RSqrtFast Calculates the reciprocal square root, 0 for non-positive values.
+func (f Fixed[Q]) RSqrtFast() Fixed[Q] {
+    return sat[Q](rsqrtRaw(f.Raw, shiftOf[Q](), fixutil.RSqrtPoly5))
+}
+
+// RSqrtFastest Calculates the reciprocal square root, 0 for non-positive values.
+func (f Fixed[Q]) RSqrtFastest() Fixed[Q] {
+    return sat[Q](rsqrtRaw(f.Raw, shiftOf[Q](), fixutil.RSqrtPoly3))
+}
+
+// Rcp Calculates the reciprocal, 0 for 0.
+func (f Fixed[Q]) Rcp() Fixed[Q] {
+    return sat[Q](rcpRaw(f.Raw, shiftOf[Q](), fixutil.RcpPoly4Lut8))
+}
+
+// RcpFast Calculates the reciprocal, 0 for 0.
+func (f Fixed[Q]) RcpFast() Fixed[Q] {
+    return sat[Q](rcpRaw(f.Raw, shiftOf[Q](), fixutil.RcpPoly6))
+}
+
+// RcpFastest Calculates the reciprocal, 0 for 0.
+func (f Fixed[Q]) RcpFastest() Fixed[Q] {
+    return sat[Q](rcpRaw(f.Raw, shiftOf[Q](), fixutil.RcpPoly4))
+}
+
+// Exp2 Calculates the base 2 exponent.
+func (f Fixed[Q]) Exp2() Fixed[Q] {
+    return sat[Q](exp2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), maxRawOf[Q](), fixutil.Exp2Poly5))
+}
+
+// Exp2Fast Calculates the base 2 exponent.
+func (f Fixed[Q]) Exp2Fast() Fixed[Q] {
+    return sat[Q](exp2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), maxRawOf[Q](), fixutil.Exp2Poly4))
+}
+
+// Exp2Fastest Calculates the base 2 exponent.
+func (f Fixed[Q]) Exp2Fastest() Fixed[Q] {
+    return sat[Q](exp2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), maxRawOf[Q](), fixutil.Exp2Poly3))
+}
+
+// Exp Calculates the natural exponent.
+func (f Fixed[Q]) Exp() Fixed[Q] {
+    // e^x == 2^(x / ln(2)), evaluated with the full precision of 1 / ln(2) before narrowing.
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    return sat[Q](exp2Raw(mulShiftSat(f.Raw, int64(rcpLn2Q62), int(ws)-int(s)-62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly5))
+}
+
+// ExpFast Calculates the natural exponent.
+func (f Fixed[Q]) ExpFast() Fixed[Q] {
+    // e^x == 2^(x / ln(2)), evaluated with the full precision of 1 / ln(2) before narrowing.
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    return sat[Q](exp2Raw(mulShiftSat(f.Raw, int64(rcpLn2Q62), int(ws)-int(s)-62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly4))
+}
+
+// ExpFastest Calculates the natural exponent.
+func (f Fixed[Q]) ExpFastest() Fixed[Q] {
+    // e^x == 2^(x / ln(2)), evaluated with the full precision of 1 / ln(2) before narrowing.
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    return sat[Q](exp2Raw(mulShiftSat(f.Raw, int64(rcpLn2Q62), int(ws)-int(s)-62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly3))
+}
+
+// Log Natural logarithm (base e), 0 for non-positive values.
+func (f Fixed[Q]) Log() Fixed[Q] {
+    return sat[Q](logRaw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.LogPoly5Lut8))
+}
+
+// LogFast Natural logarithm (base e), 0 for non-positive values.
+func (f Fixed[Q]) LogFast() Fixed[Q] {
+    return sat[Q](logRaw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.LogPoly3Lut8))
+}
+
+// LogFastest Natural logarithm (base e), 0 for non-positive values.
+func (f Fixed[Q]) LogFastest() Fixed[Q] {
+    return sat[Q](logRaw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.LogPoly5))
+}
+
+// Log2 Base 2 logarithm, 0 for non-positive values.
+func (f Fixed[Q]) Log2() Fixed[Q] {
+    return sat[Q](log2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.Log2Poly4Lut16))
+}
+
+// Log2Fast Base 2 logarithm, 0 for non-positive values.
+func (f Fixed[Q]) Log2Fast() Fixed[Q] {
+    return sat[Q](log2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.Log2Poly3Lut16))
+}
+
+// Log2Fastest Base 2 logarithm, 0 for non-positive values.
+func (f Fixed[Q]) Log2Fastest() Fixed[Q] {
+    return sat[Q](log2Raw(f.Raw, shiftOf[Q](), shiftOf[Q](), fixutil.Log2Poly5))
+}
+
+// Pow Calculates f to the power of the exponent, 0 for non-positive f.
+func (f Fixed[Q]) Pow(exponent Fixed[Q]) Fixed[Q] {
+    // n^0 == 1
+    if exponent.Raw == 0 {
+        return One[Q]()
+    }
+    if f.Raw <= 0 {
+        return Fixed[Q]{}
+    }
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    l := logRaw(f.Raw, s, ws, fixutil.LogPoly5Lut8)
+    return sat[Q](exp2Raw(mulShiftSat(mulShiftSat(exponent.Raw, l, -int(s)), int64(rcpLn2Q62), -62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly5))
+}
+
+// PowFast Calculates f to the power of the exponent, 0 for non-positive f.
+func (f Fixed[Q]) PowFast(exponent Fixed[Q]) Fixed[Q] {
+    // n^0 == 1
+    if exponent.Raw == 0 {
+        return One[Q]()
+    }
+    if f.Raw <= 0 {
+        return Fixed[Q]{}
+    }
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    l := logRaw(f.Raw, s, ws, fixutil.LogPoly3Lut8)
+    return sat[Q](exp2Raw(mulShiftSat(mulShiftSat(exponent.Raw, l, -int(s)), int64(rcpLn2Q62), -62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly4))
+}
+
+// PowFastest Calculates f to the power of the exponent, 0 for non-positive f.
+func (f Fixed[Q]) PowFastest(exponent Fixed[Q]) Fixed[Q] {
+    // n^0 == 1
+    if exponent.Raw == 0 {
+        return One[Q]()
+    }
+    if f.Raw <= 0 {
+        return Fixed[Q]{}
+    }
+    s := shiftOf[Q]()
+    ws := wideShift(s)
+    l := logRaw(f.Raw, s, ws, fixutil.LogPoly5)
+    return sat[Q](exp2Raw(mulShiftSat(mulShiftSat(exponent.Raw, l, -int(s)), int64(rcpLn2Q62), -62), ws, s, maxRawOf[Q](), fixutil.Exp2Poly3))
+}
+
+// Sin Calculates the sine of f radians.
+func (f Fixed[Q]) Sin() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s), fixutil.SinPoly4), s))
+}
+
+// SinFast Calculates the sine of f radians.
+func (f Fixed[Q]) SinFast() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s), fixutil.SinPoly3), s))
+}
+
+// SinFastest Calculates the sine of f radians.
+func (f Fixed[Q]) SinFastest() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s), fixutil.SinPoly2), s))
+}
+
+// Cos Calculates the cosine of f radians.
+func (f Fixed[Q]) Cos() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s)+one30, fixutil.SinPoly4), s))
+}
+
+// CosFast Calculates the cosine of f radians.
+func (f Fixed[Q]) CosFast() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s)+one30, fixutil.SinPoly3), s))
+}
+
+// CosFastest Calculates the cosine of f radians.
+func (f Fixed[Q]) CosFastest() Fixed[Q] {
+    s := shiftOf[Q]()
+    return sat[Q](fromQ30(unitSin(unitAngle(f.Raw, s)+one30, fixutil.SinPoly2), s))
+}
+
+// Tan Calculates the tangent of f radians, saturating near the poles.
+func (f Fixed[Q]) Tan() Fixed[Q] {
+    return sat[Q](tanRaw(f.Raw, shiftOf[Q](), fixutil.SinPoly4))
+}
+
+// TanFast Calculates the tangent of f radians, saturating near the poles.
+func (f Fixed[Q]) TanFast() Fixed[Q] {
+    return sat[Q](tanRaw(f.Raw, shiftOf[Q](), fixutil.SinPoly3))
+}
+
+// TanFastest Calculates the tangent of f radians, saturating near the poles.
+func (f Fixed[Q]) TanFastest() Fixed[Q] {
+    return sat[Q](tanRaw(f.Raw, shiftOf[Q](), fixutil.SinPoly2))
+}
+
+// Atan2 Calculates the angle of the vector (x, y) in [-pi, pi], receiver is y.
+func (f Fixed[Q]) Atan2(x Fixed[Q]) Fixed[Q] {
+    return sat[Q](atan2Raw(f.Raw, x.Raw, shiftOf[Q](), fixutil.AtanPoly5Lut8))
+}
+
+// Atan2Fast Calculates the angle of the vector (x, y) in [-pi, pi], receiver is y.
+func (f Fixed[Q]) Atan2Fast(x Fixed[Q]) Fixed[Q] {
+    return sat[Q](atan2Raw(f.Raw, x.Raw, shiftOf[Q](), fixutil.AtanPoly3Lut8))
+}
+
+// Atan2Fastest Calculates the angle of the vector (x, y) in [-pi, pi], receiver is y.
+func (f Fixed[Q]) Atan2Fastest(x Fixed[Q]) Fixed[Q] {
+    return sat[Q](atan2Raw(f.Raw, x.Raw, shiftOf[Q](), fixutil.AtanPoly4))
+}
+
+// Atan Calculates the arc tangent in [-pi/2, pi/2].
+func (f Fixed[Q]) Atan() Fixed[Q] {
+    return f.Atan2(One[Q]())
+}
+
+// AtanFast Calculates the arc tangent in [-pi/2, pi/2].
+func (f Fixed[Q]) AtanFast() Fixed[Q] {
+    return f.Atan2Fast(One[Q]())
+}
+
+// AtanFastest Calculates the arc tangent in [-pi/2, pi/2].
+func (f Fixed[Q]) AtanFastest() Fixed[Q] {
+    return f.Atan2Fastest(One[Q]())
+}
+
+// Asin Calculates the arc sine in [-pi/2, pi/2], 0 outside of [-1, 1].
+func (f Fixed[Q]) Asin() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    // 1 - x^2 rather than (1 + x) * (1 - x), which overflows in Q2.30.
+    return f.Atan2(one.Sub(f.Mul(f)).Sqrt())
+}
+
+// AsinFast Calculates the arc sine in [-pi/2, pi/2], 0 outside of [-1, 1].
+func (f Fixed[Q]) AsinFast() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    // 1 - x^2 rather than (1 + x) * (1 - x), which overflows in Q2.30.
+    return f.Atan2Fast(one.Sub(f.Mul(f)).SqrtFast())
+}
+
+// AsinFastest Calculates the arc sine in [-pi/2, pi/2], 0 outside of [-1, 1].
+func (f Fixed[Q]) AsinFastest() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    // 1 - x^2 rather than (1 + x) * (1 - x), which overflows in Q2.30.
+    return f.Atan2Fastest(one.Sub(f.Mul(f)).SqrtFastest())
+}
+
+// Acos Calculates the arc cosine in [0, pi], 0 outside of [-1, 1].
+func (f Fixed[Q]) Acos() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    return one.Sub(f.Mul(f)).Sqrt().Atan2(f)
+}
+
+// AcosFast Calculates the arc cosine in [0, pi], 0 outside of [-1, 1].
+func (f Fixed[Q]) AcosFast() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    return one.Sub(f.Mul(f)).SqrtFast().Atan2Fast(f)
+}
+
+// AcosFastest Calculates the arc cosine in [0, pi], 0 outside of [-1, 1].
+func (f Fixed[Q]) AcosFastest() Fixed[Q] {
+    one := One[Q]()
+    if f.Raw < -one.Raw || f.Raw > one.Raw {
+        return Fixed[Q]{}
+    }
+    return one.Sub(f.Mul(f)).SqrtFastest().Atan2Fastest(f)
+}
@@ -0,0 +1,223 @@
+package fixq_test
+
+import (
+    "math"
+    "math/big"
+    "math/rand"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/fixq"
+)
+
+// checkMath Compares the math functions of Q against package math over a spread of inputs. tol is the
+// absolute error allowed on results of magnitude up to 1, scaled up for larger results.
+func checkMath[Q fixq.Format](t *testing.T, name string, tol float64) {
+    ulp := fixq.FromRaw[Q](1).Float64()
+    maxV := fixq.MaxValue[Q]().Float64()
+    check := func(fn string, x, got, want float64) {
+        if math.Abs(want) >= maxV {
+            return
+        }
+        tol := tol
+        if strings.HasSuffix(fn, "Fast") {
+            tol = 1e-4
+        }
+        allowed := (tol + 2*ulp) * math.Max(1, math.Abs(want))
+        if math.Abs(got-want) > allowed {
+            t.Errorf("%s %s(%v) = %v, want %v", name, fn, x, got, want)
+        }
+    }
+
+    for _, xf := range []float64{0.001, 0.1, 0.25, 0.5, 0.7, 1, 1.5, 1.9, 3, 10, 100, 1000, 12345.678} {
+        x := fixq.FromFloat64[Q](xf)
+        if x.Raw == 0 || math.Abs(x.Float64()-xf) > ulp {
+            continue // out of range for the format
+        }
+        xf = x.Float64()
+        check("Sqrt", xf, x.Sqrt().Float64(), math.Sqrt(xf))
+        check("SqrtFast", xf, x.SqrtFast().Float64(), math.Sqrt(xf))
+        check("RSqrt", xf, x.RSqrt().Float64(), 1/math.Sqrt(xf))
+        check("Rcp", xf, x.Rcp().Float64(), 1/xf)
+        check("Log", xf, x.Log().Float64(), math.Log(xf))
+        check("Log2", xf, x.Log2().Float64(), math.Log2(xf))
+        check("LogFast", xf, x.LogFast().Float64(), math.Log(xf))
+        check("Exp", -xf, x.Negate().Exp().Float64(), math.Exp(-xf))
+        check("Div", xf, fixq.One[Q]().Div(x).Float64(), 1/xf)
+        check("DivPrecise", xf, fixq.One[Q]().DivPrecise(x).Float64(), 1/xf)
+        if xf < 8 {
+            check("Exp", xf, x.Exp().Float64(), math.Exp(xf))
+            check("Exp2", xf, x.Exp2().Float64(), math.Exp2(xf))
+            check("Pow", xf, x.Pow(fixq.Half[Q]()).Float64(), math.Pow(xf, 0.5))
+        }
+        check("Sin", xf, x.Sin().Float64(), math.Sin(xf))
+        check("Cos", xf, x.Cos().Float64(), math.Cos(xf))
+        check("SinFast", xf, x.SinFast().Float64(), math.Sin(xf))
+        check("Sin", -xf, x.Negate().Sin().Float64(), math.Sin(-xf))
+        if math.Abs(math.Cos(xf)) > 0.1 {
+            check("Tan", xf, x.Tan().Float64(), math.Tan(xf))
+        }
+        check("Atan", xf, x.Atan().Float64(), math.Atan(xf))
+        check("AtanFast", xf, x.AtanFast().Float64(), math.Atan(xf))
+        check("Atan2", -xf, x.Negate().Atan2(fixq.One[Q]().Negate()).Float64(), math.Atan2(-xf, -1))
+        if xf <= 1 {
+            check("Asin", xf, x.Asin().Float64(), math.Asin(xf))
+            check("Acos", -xf, x.Negate().Acos().Float64(), math.Acos(-xf))
+        }
+    }
+}
+
+func TestMath(t *testing.T) {
+    checkMath[fixq.Q2_30](t, "Q2_30", 1e-6)
+    checkMath[fixq.Q8_24](t, "Q8_24", 1e-6)
+    checkMath[fixq.Q16_16](t, "Q16_16", 1e-6)
+    checkMath[fixq.Q24_8](t, "Q24_8", 1e-6)
+    checkMath[fixq.Q2_62](t, "Q2_62", 1e-6)
+    checkMath[fixq.Q16_48](t, "Q16_48", 1e-6)
+    checkMath[fixq.Q32_32](t, "Q32_32", 1e-6)
+    checkMath[fixq.Q48_16](t, "Q48_16", 1e-6)
+}
+
+func TestMath_SmallValues(t *testing.T) {
+    // Formats with more fraction bits keep relative precision for tiny inputs.
+    x := fixq.FromRaw[fixq.Q16_48](1 << 8) // 2^-40
+    assert.InDelta(t, math.Ldexp(1, -20), x.Sqrt().Float64(), math.Ldexp(1, -45))
+    assert.InDelta(t, -40*math.Ln2, x.Log().Float64(), 1e-6)
+    assert.Equal(t, int64(0), fixq.FromFix64[fixq.Q16_48](fix64.Sqrt(x.Fix64())).Raw)
+}
+
+func TestMath_MatchesFix64(t *testing.T) {
+    for _, v := range []float64{0.3, 1, 2.5, 100, 40000} {
+        x := fixq.FromFloat64[fixq.Q32_32](v)
+        assert.Equal(t, fix64.Sqrt(x.Raw), x.Sqrt().Raw, v)
+        assert.Equal(t, fix64.Log2(x.Raw), x.Log2().Raw, v)
+        assert.Equal(t, fix64.Rcp(x.Raw), x.Rcp().Raw, v)
+    }
+}
+
+func TestMath_Saturation(t *testing.T) {
+    assert.Equal(t, fixq.MaxValue[fixq.Q8_24](), fixq.FromInt[fixq.Q8_24](10).Exp())
+    assert.Equal(t, fixq.MaxValue[fixq.Q2_30](), fixq.Pi[fixq.Q2_30]())
+    assert.Equal(t, fixq.MaxValue[fixq.Q2_30](), fixq.FromFloat64[fixq.Q2_30](0.25).Rcp())
+    assert.Equal(t, fixq.MaxValue[fixq.Q32_32](), fixq.FromRaw[fixq.Q32_32](1).Rcp())
+    assert.Equal(t, fixq.MaxValue[fixq.Q32_32](), fixq.One[fixq.Q32_32]().DivPrecise(fixq.FromRaw[fixq.Q32_32](1)))
+    assert.Equal(t, fixq.MaxValue[fixq.Q16_16](), fixq.PiHalf[fixq.Q16_16]().Sub(fixq.FromRaw[fixq.Q16_16](1)).Tan())
+    assert.Equal(t, fixq.MinValue[fixq.Q16_16](), fixq.PiHalf[fixq.Q16_16]().Tan())
+}
+
+func TestArithmetic(t *testing.T) {
+    a := fixq.Ratio[fixq.Q8_24](3, 2)
+    b := fixq.FromInt[fixq.Q8_24](-2)
+    assert.Equal(t, "-0.5", a.Add(b).ToString())
+    assert.Equal(t, "3.5", a.Sub(b).ToString())
+    assert.Equal(t, "-3", a.Mul(b).ToString())
+    assert.Equal(t, "-0.75", a.DivPrecise(b).ToString())
+    assert.Equal(t, "-2", a.Negate().Floor().ToString())
+    assert.Equal(t, "2", a.Round().ToString())
+    assert.Equal(t, "0.5", a.Fract().ToString())
+    assert.Equal(t, int64(-1), a.Negate().RoundToInt())
+    assert.True(t, b.LT(a))
+    assert.Equal(t, int32(-1), b.CompareTo(a))
+
+    // 32-bit formats wrap around like fix32.
+    max := fixq.MaxValue[fixq.Q8_24]()
+    assert.Equal(t, fixq.MinValue[fixq.Q8_24](), max.Add(fixq.FromRaw[fixq.Q8_24](1)))
+    assert.Equal(t, int64(math.MinInt32), fixq.FromInt[fixq.Q8_24](128).Raw)
+}
+
+func TestConvert(t *testing.T) {
+    // Ties go to even.
+    assert.Equal(t, int64(2), fixq.Convert[fixq.Q24_8](fixq.FromRaw[fixq.Q16_16](0x280)).Raw)
+    assert.Equal(t, int64(4), fixq.Convert[fixq.Q24_8](fixq.FromRaw[fixq.Q16_16](0x380)).Raw)
+    assert.Equal(t, int64(-2), fixq.Convert[fixq.Q24_8](fixq.FromRaw[fixq.Q16_16](-0x280)).Raw)
+    assert.Equal(t, int64(3), fixq.Convert[fixq.Q24_8](fixq.FromRaw[fixq.Q16_16](0x281)).Raw)
+
+    // Widening is exact.
+    v := fixq.Ratio[fixq.Q8_24](-7, 3)
+    w, ok := fixq.ConvertChecked[fixq.Q16_48](v)
+    assert.True(t, ok)
+    assert.Equal(t, v.Raw<<24, w.Raw)
+    assert.Equal(t, v, fixq.Convert[fixq.Q8_24](w))
+
+    // Out of range saturates.
+    r, ok := fixq.ConvertChecked[fixq.Q2_30](fixq.FromInt[fixq.Q8_24](3))
+    assert.False(t, ok)
+    assert.Equal(t, fixq.MaxValue[fixq.Q2_30](), r)
+    r, ok = fixq.ConvertChecked[fixq.Q2_30](fixq.FromInt[fixq.Q48_16](-1 << 40))
+    assert.False(t, ok)
+    assert.Equal(t, fixq.MinValue[fixq.Q2_30](), r)
+
+    // fix64 and fix32 interop.
+    assert.Equal(t, fix64.Pi, fixq.Pi[fixq.Q32_32]().Raw)
+    assert.Equal(t, (fix64.Pi+0x8000)>>16<<16, fixq.FromFix64[fixq.Q48_16](fix64.Pi).Fix64())
+    assert.Equal(t, int32(205887), fixq.Pi[fixq.Q16_16]().Fix32())
+    assert.Equal(t, fixq.Pi[fixq.Q16_16](), fixq.FromFix32[fixq.Q16_16](205887))
+}
+
+func TestParse(t *testing.T) {
+    v, err := fixq.Parse[fixq.Q2_30]("-1.5")
+    assert.NoError(t, err)
+    assert.Equal(t, int64(-3<<29), v.Raw)
+    _, err = fixq.Parse[fixq.Q2_30]("2")
+    assert.Error(t, err)
+    assert.Equal(t, "0x1800000", fixq.Ratio[fixq.Q8_24](3, 2).Text('x', -1))
+    assert.Equal(t, "1.50", fixq.Ratio[fixq.Q8_24](3, 2).Text('f', 2))
+}
+
+// checkRoundTrip Formats values of Q across the range, including those one ulp away from zero, one half
+// and one, and checks that they parse back to the same raw value.
+func checkRoundTrip[Q fixq.Format](t *testing.T, name string) {
+    var q Q
+    shift := int(q.Shift())
+    one, half := fixq.One[Q]().Raw, fixq.Half[Q]().Raw
+    raws := []int64{0, 1, 2, 3, half - 1, half, half + 1, one - 1, one, one + 1, 3*half - 1, 3 * half,
+        fixq.MaxValue[Q]().Raw, fixq.MinValue[Q]().Raw, fixq.MaxValue[Q]().Raw - 1, fixq.MinValue[Q]().Raw + 1}
+    r := rand.New(rand.NewSource(int64(len(name))))
+    for i := 0; i < 1000; i++ {
+        raws = append(raws, fixq.FromRaw[Q](r.Int63()>>r.Intn(63)).Raw)
+    }
+    for _, raw := range raws {
+        for _, v := range []fixq.Fixed[Q]{fixq.FromRaw[Q](raw), fixq.FromRaw[Q](raw).Negate()} {
+            if v.Raw != fixq.FromRaw[Q](v.Raw).Raw {
+                continue // MinValue negated
+            }
+            s := v.ToString()
+            back, err := fixq.Parse[Q](s)
+            if assert.NoError(t, err, "%s %s", name, s) {
+                assert.Equal(t, v.Raw, back.Raw, "%s %d formats as %s", name, v.Raw, s)
+            }
+
+            // Within half an ulp of the exact value.
+            got, _ := new(big.Float).SetPrec(256).SetString(s)
+            exact := new(big.Float).SetPrec(256).SetInt64(v.Raw)
+            exact.SetMantExp(exact, -shift)
+            diff, _ := got.Sub(got, exact).Float64()
+            assert.LessOrEqual(t, math.Abs(diff), math.Ldexp(1, -shift-1), "%s %d formats as %s", name, v.Raw, s)
+        }
+    }
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+    checkRoundTrip[fixq.Q2_30](t, "Q2_30")
+    checkRoundTrip[fixq.Q8_24](t, "Q8_24")
+    checkRoundTrip[fixq.Q16_16](t, "Q16_16")
+    checkRoundTrip[fixq.Q24_8](t, "Q24_8")
+    checkRoundTrip[fixq.Q2_62](t, "Q2_62")
+    checkRoundTrip[fixq.Q16_48](t, "Q16_48")
+    checkRoundTrip[fixq.Q32_32](t, "Q32_32")
+    checkRoundTrip[fixq.Q48_16](t, "Q48_16")
+
+    // The digits of Q2_62, where ten times the fraction no longer fits into 64 bits.
+    assert.Equal(t, "0.5", fixq.Half[fixq.Q2_62]().ToString())
+    for _, s := range []string{"1.5", "0.7", "-1.25", "0.1"} {
+        v, err := fixq.Parse[fixq.Q2_62](s)
+        assert.NoError(t, err)
+        assert.Equal(t, s, v.ToString())
+    }
+    v, _ := fixq.Parse[fixq.Q2_62]("1.3")
+    assert.Equal(t, "1.300000000000000000", v.Text('f', 18))
+    assert.Equal(t, "0.0000000000000000002", fixq.FromRaw[fixq.Q2_62](1).Text('f', 19))
+}
@@ -469,6 +469,37 @@ func SinPoly4(a int32) int32 {
     return y
 }
 
+/************************************/
+/************** Sinh() **************/
+/************************************/
+
+// SinhPoly2 Precision: 17.38 bits
+func SinhPoly2(a int32) int32 {
+    y := Qmul30(a, 9268414)    // 0.008631882919944767
+    y = Qmul30(a, y+178838713) // 0.16655653098244813
+    y = y + 1073748132         // 1.0000058751997036
+    return y
+}
+
+// SinhPoly3 Precision: 25.55 bits
+func SinhPoly3(a int32) int32 {
+    y := Qmul30(a, 218979)     // 0.0002039398974492885
+    y = Qmul30(a, y+8944175)   // 0.008329911920146243
+    y = Qmul30(a, y+178957692) // 0.16666733826974445
+    y = y + 1073741802         // 0.999999979700701
+    return y
+}
+
+// SinhPoly4 Precision: 30.00 bits
+func SinhPoly4(a int32) int32 {
+    y := Qmul30(a, 3026)       // 2.8185244927228195e-06
+    y = Qmul30(a, y+212985)    // 0.00019835812577597353
+    y = Qmul30(a, y+8947869)   // 0.008333352590007887
+    y = Qmul30(a, y+178956968) // 0.1666666643036669
+    y = y + 1073741824         // 1.0000000000459075
+    return y
+}
+
 /************************************/
 /************** Atan() **************/
 /************************************/
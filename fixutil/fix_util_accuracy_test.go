@@ -0,0 +1,154 @@
+package fixutil_test
+
+import (
+    "math/big"
+    "sort"
+    "testing"
+
+    "github.com/camry/fp/fixutil"
+    "github.com/camry/fp/internal/accuracy"
+    "github.com/stretchr/testify/assert"
+)
+
+// polyFamily The polynomials approximating one function of a in [0, 1), all s2.30.
+type polyFamily struct {
+    name  string
+    exact func(a *big.Float) *big.Float
+    mode  accuracy.Mode
+    dir   int // the direction of the function in a
+    end   bool
+    polys map[string]func(int32) int32
+}
+
+var polyFamilies = []polyFamily{
+    {"2^a", func(a *big.Float) *big.Float { return accuracy.Exp2(a) }, accuracy.Relative, 1, false, map[string]func(int32) int32{
+        "Exp2Poly3": fixutil.Exp2Poly3, "Exp2Poly4": fixutil.Exp2Poly4, "Exp2Poly5": fixutil.Exp2Poly5,
+    }},
+    {"1/(1+a)", func(a *big.Float) *big.Float { return new(big.Float).Quo(accuracy.Float(1), onePlus(a)) }, accuracy.Relative, -1, false, map[string]func(int32) int32{
+        "RcpPoly4": fixutil.RcpPoly4, "RcpPoly6": fixutil.RcpPoly6, "RcpPoly3Lut4": fixutil.RcpPoly3Lut4, "RcpPoly4Lut8": fixutil.RcpPoly4Lut8,
+    }},
+    {"sqrt(1+a)", func(a *big.Float) *big.Float { return accuracy.Sqrt(onePlus(a)) }, accuracy.Relative, 1, false, map[string]func(int32) int32{
+        "SqrtPoly3": fixutil.SqrtPoly3, "SqrtPoly4": fixutil.SqrtPoly4, "SqrtPoly3Lut8": fixutil.SqrtPoly3Lut8,
+    }},
+    {"1/sqrt(1+a)", func(a *big.Float) *big.Float { return new(big.Float).Quo(accuracy.Float(1), accuracy.Sqrt(onePlus(a))) }, accuracy.Relative, -1, false, map[string]func(int32) int32{
+        "RSqrtPoly3": fixutil.RSqrtPoly3, "RSqrtPoly5": fixutil.RSqrtPoly5, "RSqrtPoly3Lut16": fixutil.RSqrtPoly3Lut16,
+    }},
+    {"ln(1+a)", func(a *big.Float) *big.Float { return accuracy.Log(onePlus(a)) }, accuracy.Absolute, 1, false, map[string]func(int32) int32{
+        "LogPoly5": fixutil.LogPoly5, "LogPoly3Lut4": fixutil.LogPoly3Lut4, "LogPoly3Lut8": fixutil.LogPoly3Lut8, "LogPoly5Lut8": fixutil.LogPoly5Lut8,
+    }},
+    {"log2(1+a)", func(a *big.Float) *big.Float { return accuracy.Log2(onePlus(a)) }, accuracy.Absolute, 1, false, map[string]func(int32) int32{
+        "Log2Poly5": fixutil.Log2Poly5, "Log2Poly4Lut4": fixutil.Log2Poly4Lut4, "Log2Poly5Lut4": fixutil.Log2Poly5Lut4,
+        "Log2Poly3Lut8": fixutil.Log2Poly3Lut8, "Log2Poly3Lut16": fixutil.Log2Poly3Lut16, "Log2Poly4Lut16": fixutil.Log2Poly4Lut16,
+    }},
+    // SinPoly(z^2) z = sin(z pi/2).
+    {"sin(sqrt(a) pi/2)/sqrt(a)", sinOverZ, accuracy.Relative, -1, true, map[string]func(int32) int32{
+        "SinPoly2": fixutil.SinPoly2, "SinPoly3": fixutil.SinPoly3, "SinPoly4": fixutil.SinPoly4,
+    }},
+    // SinhPoly(x^2) x = sinh(x).
+    {"sinh(sqrt(a))/sqrt(a)", sinhOverX, accuracy.Relative, 1, true, map[string]func(int32) int32{
+        "SinhPoly2": fixutil.SinhPoly2, "SinhPoly3": fixutil.SinhPoly3, "SinhPoly4": fixutil.SinhPoly4,
+    }},
+    {"atan(a)", accuracy.Atan, accuracy.Absolute, 1, true, map[string]func(int32) int32{
+        "AtanPoly4": fixutil.AtanPoly4, "AtanPoly5Lut8": fixutil.AtanPoly5Lut8, "AtanPoly3Lut8": fixutil.AtanPoly3Lut8,
+    }},
+}
+
+func onePlus(a *big.Float) *big.Float {
+    return new(big.Float).Add(accuracy.Float(1), a)
+}
+
+func sinOverZ(a *big.Float) *big.Float {
+    if a.Sign() == 0 {
+        halfPi := accuracy.Pi()
+        return halfPi.Quo(halfPi, accuracy.Float(2))
+    }
+    z := accuracy.Sqrt(a)
+    x := new(big.Float).Mul(z, accuracy.Pi())
+    x.Quo(x, accuracy.Float(2))
+    return x.Quo(accuracy.Sin(x), z)
+}
+
+func sinhOverX(a *big.Float) *big.Float {
+    if a.Sign() == 0 {
+        return accuracy.Float(1)
+    }
+    x := accuracy.Sqrt(a)
+    return x.Quo(accuracy.Sinh(x), x)
+}
+
+// nonMonotone The steps against the direction of the function the polynomials are known to take, all at
+// a = 1 where the polynomials are exact while they round down just below it.
+var nonMonotone = map[string]int{
+    "SinPoly2":      1,
+    "SinPoly3":      1,
+    "SinPoly4":      1,
+    "AtanPoly5Lut8": 1, // the last row of the table is atan(1) itself
+}
+
+// polyInputs Returns 2^bits s2.30 inputs sweeping [0, 1), and 1 as well with end.
+func polyInputs(bits int, end bool) []int32 {
+    var in []int32
+    for i := int32(0); i < 1<<bits; i++ {
+        // Evenly spaced, each moved by a hash within its step to reach odd inputs.
+        in = append(in, i<<(30-bits)+int32(uint32(i)*2654435761>>(32-30+bits)))
+    }
+    in = append(in, 1<<30-1)
+    if end {
+        in = append(in, 1<<30)
+    }
+    return in
+}
+
+func TestPolyAccuracy(t *testing.T) {
+    bits := 14
+    if testing.Short() {
+        bits = 10
+    }
+    docs, err := accuracy.Documented("fix_util.go")
+    assert.NoError(t, err)
+    swept := 0
+    for _, f := range polyFamilies {
+        in := polyInputs(bits, f.end)
+        want := make([]*big.Float, len(in))
+        for i, a := range in {
+            want[i] = f.exact(accuracy.Fixed(int64(a), 30))
+        }
+        for name, poly := range f.polys {
+            got := make([]int64, len(in))
+            for i, a := range in {
+                got[i] = int64(poly(a))
+            }
+            // Every step of Horner's scheme truncates by up to one ulp.
+            r := accuracy.Sweep{Shift: 30, Mode: f.mode, Bits: accuracy.Combined([]float64{docs[name]}, []float64{1}), Slack: 4, Monotone: f.dir}.Run(got, want)
+            t.Logf("%-16s %-26s documented %5.2f  %v", name, f.name, docs[name], r)
+            assert.Contains(t, docs, name)
+            assert.Zero(t, r.Failures, "%s misses its documented precision at a = %d", name, in[r.Worst])
+            assert.LessOrEqual(t, r.Violations, nonMonotone[name], "%s is not monotone", name)
+            swept++
+        }
+    }
+    assert.Equal(t, len(docs), swept)
+}
+
+func BenchmarkPoly(b *testing.B) {
+    in := polyInputs(10, false)
+    for _, f := range polyFamilies {
+        names := make([]string, 0, len(f.polys))
+        for name := range f.polys {
+            names = append(names, name)
+        }
+        sort.Strings(names)
+        for _, name := range names {
+            poly := f.polys[name]
+            b.Run(name, func(b *testing.B) {
+                var sink int32
+                for i := 0; i < b.N; i++ {
+                    sink += poly(in[i&(len(in)-1)])
+                }
+                benchSink = sink
+            })
+        }
+    }
+}
+
+var benchSink int32
@@ -0,0 +1,138 @@
+package fixutil
+
+import (
+    "math"
+    "math/bits"
+    "strconv"
+)
+
+// FormatFixed Converts a raw fixed point value with the given number of fraction bits into a string,
+// using integer arithmetic only.
+//
+// The format fmt is one of
+//
+//	'f' -ddd.dddd, with prec fraction digits rounded half to even; prec < 0 uses the fewest digits
+//	    that parse back to the same raw value
+//	'x' -0xhhhh, the raw value as a hexadecimal literal; prec is ignored
+//
+// Any other format yields '%' followed by the format character, like strconv.FormatFloat.
+func FormatFixed(raw int64, shift uint, fmt byte, prec int) string {
+    return string(AppendFixed(make([]byte, 0, 24), raw, shift, fmt, prec))
+}
+
+// AppendFixed Appends the string form of raw, as generated by FormatFixed, to dst and returns the extended buffer.
+func AppendFixed(dst []byte, raw int64, shift uint, fmt byte, prec int) []byte {
+    mag := uint64(raw)
+    if raw < 0 {
+        dst = append(dst, '-')
+        mag = -mag
+    }
+    return AppendUfixed(dst, mag, shift, fmt, prec)
+}
+
+// FormatUfixed Converts a raw unsigned fixed point value into a string, see FormatFixed.
+func FormatUfixed(raw uint64, shift uint, fmt byte, prec int) string {
+    return string(AppendUfixed(make([]byte, 0, 24), raw, shift, fmt, prec))
+}
+
+// AppendUfixed Appends the string form of raw, as generated by FormatUfixed, to dst and returns the extended buffer.
+func AppendUfixed(dst []byte, raw uint64, shift uint, fmt byte, prec int) []byte {
+    mag := raw
+    switch fmt {
+    case 'x':
+        dst = append(dst, '0', 'x')
+        return strconv.AppendUint(dst, mag, 16)
+    case 'f':
+    default:
+        return append(dst, '%', fmt)
+    }
+
+    mask := uint64(1)<<shift - 1
+    intPart := mag >> shift
+    frac := mag & mask
+
+    var digits [64]byte
+    n := 0
+    if prec < 0 {
+        // Shortest: emit exact digits until the truncated or rounded-up decimal lies strictly inside the
+        // half-ulp interval around raw, or on its edge when raw is even (the parser rounds ties to even).
+        // The error of a d digit candidate is rem*10^-d units of 2^-shift, with half an ulp being 1/2.
+        even := mag&1 == 0
+        pow := uint64(1) // 10^d, saturated once it can no longer matter
+        for frac != 0 {
+            twiceDown := frac << 1
+            twiceUp := (mask + 1 - frac) << 1
+            okDown := twiceDown < pow || (twiceDown == pow && even)
+            okUp := twiceUp < pow || (twiceUp == pow && even)
+            if okDown || okUp {
+                if okUp && (!okDown || twiceUp < twiceDown) {
+                    intPart, n = roundUpDigits(&digits, n, intPart)
+                }
+                break
+            }
+            digits[n], frac = nextDigit(frac, shift, mask)
+            n++
+            if hi, lo := bits.Mul64(pow, 10); hi == 0 {
+                pow = lo
+            } else {
+                pow = math.MaxUint64
+            }
+        }
+    } else {
+        for i := 0; i < prec && i < int(shift); i++ {
+            digits[n], frac = nextDigit(frac, shift, mask)
+            n++
+        }
+        if prec < int(shift) {
+            half := (mask + 1) >> 1
+            if frac > half || (frac == half && lastDigitOdd(&digits, n, intPart)) {
+                intPart, n = roundUpDigits(&digits, n, intPart)
+            }
+        }
+    }
+
+    dst = strconv.AppendUint(dst, intPart, 10)
+    if prec < 0 {
+        if n > 0 {
+            dst = append(dst, '.')
+            dst = append(dst, digits[:n]...)
+        }
+        return dst
+    }
+    if prec > 0 {
+        dst = append(dst, '.')
+        dst = append(dst, digits[:n]...)
+        for i := n; i < prec; i++ {
+            dst = append(dst, '0')
+        }
+    }
+    return dst
+}
+
+// nextDigit Returns the next decimal digit of the fraction frac / 2^shift and the fraction that remains. The
+// product with 10 is kept in 128 bits, as it no longer fits into 64 once shift exceeds 60.
+func nextDigit(frac uint64, shift uint, mask uint64) (byte, uint64) {
+    hi, lo := bits.Mul64(frac, 10)
+    return byte('0' + (hi<<(64-shift) | lo>>shift)), lo & mask
+}
+
+// lastDigitOdd Reports whether the last emitted decimal digit, or the integer part when there is none, is odd.
+func lastDigitOdd(digits *[64]byte, n int, intPart uint64) bool {
+    if n == 0 {
+        return intPart&1 == 1
+    }
+    return (digits[n-1]-'0')&1 == 1
+}
+
+// roundUpDigits Adds one unit in the last emitted place, carrying into the integer part. Trailing zeros
+// produced by the carry are kept, as the caller may need a fixed number of digits.
+func roundUpDigits(digits *[64]byte, n int, intPart uint64) (uint64, int) {
+    for i := n - 1; i >= 0; i-- {
+        if digits[i] != '9' {
+            digits[i]++
+            return intPart, n
+        }
+        digits[i] = '0'
+    }
+    return intPart + 1, n
+}
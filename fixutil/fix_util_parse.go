@@ -0,0 +1,218 @@
+package fixutil
+
+import (
+    "errors"
+    "math/big"
+    "strconv"
+)
+
+// ErrSyntax indicates that a value does not have the right syntax for a fixed point number.
+var ErrSyntax = errors.New("invalid syntax")
+
+// ErrRange indicates that a value is out of range for the target fixed point type.
+var ErrRange = errors.New("value out of range")
+
+// ParseError records a failed conversion from a string.
+type ParseError struct {
+    Func string // the failing function (Parse, F64FromString, ...)
+    Num  string // the input
+    Err  error  // the reason the conversion failed (ErrSyntax, ErrRange, ...)
+}
+
+func (e *ParseError) Error() string {
+    return e.Func + ": parsing " + strconv.Quote(e.Num) + ": " + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+    return e.Err
+}
+
+// maxDecimalExp bounds the decimal exponent of accepted inputs so the exact arithmetic stays small.
+// Anything beyond it is either far out of range or far below half an ulp.
+const maxDecimalExp = 1000
+
+// ParseFixed Converts a decimal or hexadecimal string into a raw fixed point value with the given number
+// of fraction bits, stored in a signed integer of bitSize bits.
+//
+// Decimal input has the form [+-]digits[.digits][(e|E)[+-]digits] and is rounded to the nearest
+// representable value, ties to even, using only integer arithmetic. Hexadecimal input has the form
+// [+-]0x<hex digits> and is taken as the raw value itself. On overflow the saturated value is returned
+// together with ErrRange.
+func ParseFixed(fn, s string, shift uint, bitSize int) (int64, error) {
+    maxRaw := int64(1)<<(bitSize-1) - 1
+    minRaw := -maxRaw - 1
+
+    neg, mag, err := parseMagnitude(s, shift)
+    if err == nil {
+        if neg {
+            mag.Neg(mag)
+        }
+        if mag.IsInt64() && mag.Int64() <= maxRaw && mag.Int64() >= minRaw {
+            return mag.Int64(), nil
+        }
+        err = ErrRange
+    }
+    if err == ErrSyntax {
+        return 0, &ParseError{Func: fn, Num: s, Err: err}
+    }
+    if neg {
+        return minRaw, &ParseError{Func: fn, Num: s, Err: err}
+    }
+    return maxRaw, &ParseError{Func: fn, Num: s, Err: err}
+}
+
+// ParseUfixed Converts a string into a raw unsigned fixed point value of bitSize bits, see ParseFixed.
+// Negative values are out of range, except for those that round to zero.
+func ParseUfixed(fn, s string, shift uint, bitSize int) (uint64, error) {
+    maxRaw := uint64(1)<<(bitSize-1)<<1 - 1
+
+    neg, mag, err := parseMagnitude(s, shift)
+    if err == nil {
+        if mag.Sign() == 0 {
+            return 0, nil
+        }
+        if !neg && mag.IsUint64() && mag.Uint64() <= maxRaw {
+            return mag.Uint64(), nil
+        }
+        err = ErrRange
+    }
+    if err == ErrSyntax {
+        return 0, &ParseError{Func: fn, Num: s, Err: err}
+    }
+    if neg {
+        return 0, &ParseError{Func: fn, Num: s, Err: err}
+    }
+    return maxRaw, &ParseError{Func: fn, Num: s, Err: err}
+}
+
+// ParseFixedBig Converts a string into a raw fixed point value like ParseFixed, without a range check, for
+// types wider than 64 bits. Hexadecimal input is the raw value. Only absurdly large input fails with ErrRange.
+func ParseFixedBig(fn, s string, shift uint) (*big.Int, error) {
+    neg, mag, err := parseMagnitude(s, shift)
+    if err != nil {
+        return nil, &ParseError{Func: fn, Num: s, Err: err}
+    }
+    if neg {
+        mag.Neg(mag)
+    }
+    return mag, nil
+}
+
+// parseMagnitude Parses the sign and the rounded raw magnitude of s, see ParseFixed for the syntax. err is
+// ErrSyntax, or ErrRange when the magnitude is too large to be worth computing.
+func parseMagnitude(s string, shift uint) (neg bool, mag *big.Int, err error) {
+    i := 0
+    if i < len(s) && (s[i] == '+' || s[i] == '-') {
+        neg = s[i] == '-'
+        i++
+    }
+
+    // Raw hexadecimal literal.
+    if len(s)-i > 2 && s[i] == '0' && (s[i+1] == 'x' || s[i+1] == 'X') {
+        hex := s[i+2:]
+        for j := 0; j < len(hex); j++ {
+            c := hex[j]
+            if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+                return neg, nil, ErrSyntax
+            }
+        }
+        mag, _ = new(big.Int).SetString(hex, 16)
+        return neg, mag, nil
+    }
+
+    // Mantissa digits, remembering where the decimal point was.
+    mantissa := new(big.Int)
+    ten := big.NewInt(10)
+    digits := 0
+    sawDigits := false
+    sawDot := false
+    exp := 0
+    for ; i < len(s); i++ {
+        c := s[i]
+        if c == '.' {
+            if sawDot {
+                return neg, nil, ErrSyntax
+            }
+            sawDot = true
+            continue
+        }
+        if c < '0' || c > '9' {
+            break
+        }
+        sawDigits = true
+        if c == '0' && digits == 0 {
+            // Leading zeros carry no information besides the position of the point.
+            if sawDot {
+                exp--
+            }
+            continue
+        }
+        mantissa.Mul(mantissa, ten)
+        mantissa.Add(mantissa, big.NewInt(int64(c-'0')))
+        digits++
+        if sawDot {
+            exp--
+        }
+    }
+    if !sawDigits {
+        return neg, nil, ErrSyntax
+    }
+
+    // Optional exponent.
+    if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+        i++
+        expNeg := false
+        if i < len(s) && (s[i] == '+' || s[i] == '-') {
+            expNeg = s[i] == '-'
+            i++
+        }
+        if i == len(s) {
+            return neg, nil, ErrSyntax
+        }
+        e := 0
+        for ; i < len(s); i++ {
+            c := s[i]
+            if c < '0' || c > '9' {
+                return neg, nil, ErrSyntax
+            }
+            if e < 10*maxDecimalExp {
+                e = e*10 + int(c-'0')
+            }
+        }
+        if expNeg {
+            e = -e
+        }
+        exp += e
+    }
+    if i != len(s) {
+        return neg, nil, ErrSyntax
+    }
+
+    if digits == 0 {
+        return neg, new(big.Int), nil
+    }
+    if digits+exp > maxDecimalExp/10 {
+        return neg, nil, ErrRange
+    }
+    if digits+exp < -maxDecimalExp/10 {
+        // Smaller than half an ulp of any supported format.
+        return neg, new(big.Int), nil
+    }
+
+    // raw = round(mantissa * 10^exp * 2^shift)
+    num := new(big.Int).Lsh(mantissa, shift)
+    den := big.NewInt(1)
+    if exp >= 0 {
+        num.Mul(num, new(big.Int).Exp(ten, big.NewInt(int64(exp)), nil))
+    } else {
+        den.Exp(ten, big.NewInt(int64(-exp)), nil)
+    }
+    q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+
+    // Round half to even.
+    r.Lsh(r, 1)
+    if c := r.Cmp(den); c > 0 || (c == 0 && q.Bit(0) == 1) {
+        q.Add(q, big.NewInt(1))
+    }
+    return neg, q, nil
+}
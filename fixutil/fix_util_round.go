@@ -0,0 +1,61 @@
+package fixutil
+
+import (
+    "strconv"
+)
+
+// RoundingMode Selects how an inexact result is rounded to the nearest representable value.
+type RoundingMode int
+
+const (
+    RoundNearestEven RoundingMode = iota // to nearest, ties to the even value
+    RoundNearestAway                     // to nearest, ties away from zero
+    RoundTowardZero                      // toward zero (truncation)
+    RoundFloor                           // toward negative infinity
+    RoundCeil                            // toward positive infinity
+)
+
+func (m RoundingMode) String() string {
+    switch m {
+    case RoundNearestEven:
+        return "RoundNearestEven"
+    case RoundNearestAway:
+        return "RoundNearestAway"
+    case RoundTowardZero:
+        return "RoundTowardZero"
+    case RoundFloor:
+        return "RoundFloor"
+    case RoundCeil:
+        return "RoundCeil"
+    }
+    return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// RoundFromFloor Rounds an exact result given as its floor plus a discarded fraction. inexact reports whether
+// the fraction is non-zero, half is the sign of the fraction minus one half (-1, 0 or 1), and neg whether the
+// exact result is negative. Unknown modes round to nearest even.
+func RoundFromFloor(floor int64, inexact bool, half int, neg bool, mode RoundingMode) int64 {
+    if !inexact {
+        return floor
+    }
+    switch mode {
+    case RoundFloor:
+        return floor
+    case RoundCeil:
+        return floor + 1
+    case RoundTowardZero:
+        if neg {
+            return floor + 1
+        }
+        return floor
+    case RoundNearestAway:
+        if half > 0 || (half == 0 && !neg) {
+            return floor + 1
+        }
+        return floor
+    }
+    if half > 0 || (half == 0 && floor&1 != 0) {
+        return floor + 1
+    }
+    return floor
+}
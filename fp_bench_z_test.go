@@ -0,0 +1,80 @@
+package fp_test
+
+import (
+    "testing"
+)
+
+// benchOp An operation under benchmark, called on each of the inputs in turn.
+type benchOp[T, R any] struct {
+    name string
+    fn   func(T) R
+}
+
+// pair The operands of a binary operation under benchmark.
+type pair[T, U any] struct {
+    a T
+    b U
+}
+
+// binary Adapts a binary operation, such as a method expression, to take a pair.
+func binary[T, U, R any](fn func(T, U) R) func(pair[T, U]) R {
+    return func(p pair[T, U]) R { return fn(p.a, p.b) }
+}
+
+// pairs Pairs the inputs of a with those of b, walking b at another stride so every a meets many b.
+func pairs[T, U any](a []T, b []U) []pair[T, U] {
+    in := make([]pair[T, U], len(a))
+    for i := range in {
+        in[i] = pair[T, U]{a[i], b[(i*7+3)%len(b)]}
+    }
+    return in
+}
+
+// benchOps Runs a sub-benchmark for each operation over the inputs, whose count must be a power of two,
+// keeping the last result in sink.
+func benchOps[T, R any](b *testing.B, in []T, sink *R, ops []benchOp[T, R]) {
+    for _, op := range ops {
+        fn := op.fn
+        b.Run(op.name, func(b *testing.B) {
+            b.ReportAllocs()
+            var r R
+            for i := 0; i < b.N; i++ {
+                r = fn(in[i&(len(in)-1)])
+            }
+            *sink = r
+        })
+    }
+}
+
+// benchInputs The number of inputs of each benchmark.
+const benchInputs = 1024
+
+// spread Returns benchInputs values over [lo, hi) in a scrambled order.
+func spread(lo, hi float64) []float64 {
+    in := make([]float64, benchInputs)
+    for i := range in {
+        in[i] = lo + (hi-lo)*float64(i*617%benchInputs)/benchInputs
+    }
+    return in
+}
+
+// mapped Applies fn to every input.
+func mapped[T, R any](in []T, fn func(T) R) []R {
+    out := make([]R, len(in))
+    for i, v := range in {
+        out[i] = fn(v)
+    }
+    return out
+}
+
+// rotated Returns the inputs starting at offset k, so that rotations of one input set make the components
+// of vectors differ.
+func rotated[T any](in []T, k int) []T {
+    return append(append([]T{}, in[k:]...), in[:k]...)
+}
+
+var (
+    boolSink  bool
+    int32Sink int32
+    int64Sink int64
+)
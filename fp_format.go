@@ -0,0 +1,97 @@
+package fp
+
+import (
+    "fmt"
+    "strings"
+
+    "github.com/camry/fp/fixutil"
+)
+
+// formatFixed Writes raw to s according to verb, implementing fmt.Formatter for F32 and F64.
+//
+// %v and %s print the shortest round-tripping decimal, or use the precision when one is given.
+// %f and %F print the given number of fraction digits, 6 by default. %x and %X print the raw value in
+// hexadecimal, with a 0x prefix for the '#' flag. The '+', ' ', '-' and '0' flags and the width behave
+// as they do for floats.
+func formatFixed(s fmt.State, verb rune, raw int64, shift uint, typ string) {
+    writeFixed(s, verb, typ, func(format byte, prec int) string {
+        return fixutil.FormatFixed(raw, shift, format, prec)
+    })
+}
+
+// formatUfixed Writes the unsigned raw to s according to verb, see formatFixed.
+func formatUfixed(s fmt.State, verb rune, raw uint64, shift uint, typ string) {
+    writeFixed(s, verb, typ, func(format byte, prec int) string {
+        return fixutil.FormatUfixed(raw, shift, format, prec)
+    })
+}
+
+// writeFixed Implements formatFixed and formatUfixed, text converts the value like fixutil.FormatFixed.
+func writeFixed(s fmt.State, verb rune, typ string, text func(format byte, prec int) string) {
+    prec, hasPrec := s.Precision()
+    var body string
+    switch verb {
+    case 'v', 's':
+        if !hasPrec {
+            prec = -1
+        }
+        body = text('f', prec)
+    case 'f', 'F':
+        if !hasPrec {
+            prec = 6
+        }
+        body = text('f', prec)
+    case 'x', 'X':
+        body = text('x', -1)
+    default:
+        fmt.Fprintf(s, "%%!%c(%s=%s)", verb, typ, text('f', -1))
+        return
+    }
+
+    sign := ""
+    if strings.HasPrefix(body, "-") {
+        sign, body = "-", body[1:]
+    } else if s.Flag('+') {
+        sign = "+"
+    } else if s.Flag(' ') {
+        sign = " "
+    }
+    if verb == 'x' || verb == 'X' {
+        body = body[2:]
+        if verb == 'X' {
+            body = strings.ToUpper(body)
+        }
+        if s.Flag('#') {
+            body = string([]rune{'0', verb}) + body
+        }
+    }
+
+    width, hasWidth := s.Width()
+    pad := 0
+    if hasWidth {
+        pad = width - len(sign) - len(body)
+    }
+    switch {
+    case pad <= 0:
+        fmt.Fprint(s, sign, body)
+    case s.Flag('-'):
+        fmt.Fprint(s, sign, body, strings.Repeat(" ", pad))
+    case s.Flag('0'):
+        fmt.Fprint(s, sign, strings.Repeat("0", pad), body)
+    default:
+        fmt.Fprint(s, strings.Repeat(" ", pad), sign, body)
+    }
+}
+
+// formatTuple Writes the components to s as "(a, b, ...)", formatting each with the same verb and flags.
+func formatTuple(s fmt.State, verb rune, components ...fmt.Formatter) {
+    format := fmt.FormatString(s, verb)
+    fmt.Fprint(s, "(")
+    for i, c := range components {
+        if i > 0 {
+            fmt.Fprint(s, ", ")
+        }
+        fmt.Fprintf(s, format, c)
+    }
+    fmt.Fprint(s, ")")
+}
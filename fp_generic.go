@@ -0,0 +1,177 @@
+package fp
+
+import (
+    "math/bits"
+)
+
+// Scalar The operations F32 and F64 share, for algorithms written once over both.
+//
+// T is the type itself, so that a function over both is declared as
+//
+//     func Smoothstep[T Scalar[T]](edge0, edge1, x T) T
+type Scalar[T any] interface {
+    Add(T) T
+    Sub(T) T
+    Mul(T) T
+    Div(T) T
+    DivPrecise(T) T
+    Negate() T
+    Abs() T
+    Sqrt() T
+    Add2() T // plus one
+    Sub2() T // minus one
+    CompareTo(T) int32
+    EQ(T) bool
+    LT(T) bool
+    Lerp(b, t T) T
+    Float64() float64
+}
+
+// Vector The operations the vectors of F32 and F64 share, with S the scalar type of their components.
+//
+// The scalar type can't be inferred from the vector type, so the helpers taking both are called with
+// explicit type arguments, such as AvgVec[F64Vec3, F64](points).
+type Vector[V any, S Scalar[S]] interface {
+    Add(V) V
+    Sub(V) V
+    Negate() V
+    Scale(S) V
+    Dot(V) S
+    Length() S
+    LengthSqr() S
+    Normalize() V
+    Distance(V) S
+    Lerp(b V, t S) V
+    EQ(V) bool
+}
+
+var (
+    _ Scalar[F32]          = F32{}
+    _ Scalar[F64]          = F64{}
+    _ Vector[F32Vec2, F32] = F32Vec2{}
+    _ Vector[F32Vec3, F32] = F32Vec3{}
+    _ Vector[F32Vec4, F32] = F32Vec4{}
+    _ Vector[F64Vec2, F64] = F64Vec2{}
+    _ Vector[F64Vec3, F64] = F64Vec3{}
+    _ Vector[F64Vec4, F64] = F64Vec4{}
+)
+
+// fromInt Returns n >= 0 as a T. Scalar has no constructor, so n is built from its bits by doubling and Add2.
+func fromInt[T Scalar[T]](n int) T {
+    var v T
+    for bit := bits.Len(uint(n)) - 1; bit >= 0; bit-- {
+        v = v.Add(v)
+        if n>>bit&1 != 0 {
+            v = v.Add2()
+        }
+    }
+    return v
+}
+
+// Min Returns the smallest of the arguments.
+func Min[T Scalar[T]](first T, rest ...T) T {
+    ans := first
+    for _, item := range rest {
+        if item.LT(ans) {
+            ans = item
+        }
+    }
+    return ans
+}
+
+// Max Returns the largest of the arguments.
+func Max[T Scalar[T]](first T, rest ...T) T {
+    ans := first
+    for _, item := range rest {
+        if ans.LT(item) {
+            ans = item
+        }
+    }
+    return ans
+}
+
+// Sum Returns the sum of the values, zero for none.
+func Sum[T Scalar[T]](values []T) T {
+    var sum T
+    for _, v := range values {
+        sum = sum.Add(v)
+    }
+    return sum
+}
+
+// Avg Returns the mean of the values, zero for none.
+func Avg[T Scalar[T]](values []T) T {
+    if len(values) == 0 {
+        var zero T
+        return zero
+    }
+    return Sum(values).DivPrecise(fromInt[T](len(values)))
+}
+
+// Lerp a * (1 - t) + b * t, see F64.Lerp.
+func Lerp[T Scalar[T]](a, b, t T) T {
+    return a.Lerp(b, t)
+}
+
+// Clamp Returns v limited to [lo, hi].
+func Clamp[T Scalar[T]](v, lo, hi T) T {
+    if v.LT(lo) {
+        return lo
+    }
+    if hi.LT(v) {
+        return hi
+    }
+    return v
+}
+
+// Smoothstep Returns 0 for x at or below edge0, 1 at or above edge1, and the Hermite curve 3t^2 - 2t^3 of
+// t = (x - edge0) / (edge1 - edge0) between them, for edge0 < edge1.
+func Smoothstep[T Scalar[T]](edge0, edge1, x T) T {
+    var zero T
+    one := zero.Add2()
+    t := Clamp(x.Sub(edge0).DivPrecise(edge1.Sub(edge0)), zero, one)
+    three := one.Add2().Add2()
+    return t.Mul(t).Mul(three.Sub(t.Add(t)))
+}
+
+// SumVec Returns the sum of the vectors, zero for none.
+func SumVec[V Vector[V, S], S Scalar[S]](vs []V) V {
+    var sum V
+    for _, v := range vs {
+        sum = sum.Add(v)
+    }
+    return sum
+}
+
+// AvgVec Returns the mean of the vectors, their centroid, as their sum scaled by the reciprocal of the count,
+// zero for none.
+func AvgVec[V Vector[V, S], S Scalar[S]](vs []V) V {
+    if len(vs) == 0 {
+        var zero V
+        return zero
+    }
+    var one S
+    one = one.Add2()
+    return SumVec[V, S](vs).Scale(one.DivPrecise(fromInt[S](len(vs))))
+}
+
+// PathLength Returns the sum of the distances between consecutive vectors.
+func PathLength[V Vector[V, S], S Scalar[S]](vs []V) S {
+    var length S
+    for i := 1; i < len(vs); i++ {
+        length = length.Add(vs[i].Distance(vs[i-1]))
+    }
+    return length
+}
+
+// Nearest Returns the index of the vector nearest to p, the first of equals, -1 for none.
+func Nearest[V Vector[V, S], S Scalar[S]](vs []V, p V) int {
+    best := -1
+    var bestDist S
+    for i, v := range vs {
+        if d := v.Sub(p).LengthSqr(); best < 0 || d.LT(bestDist) {
+            best, bestDist = i, d
+        }
+    }
+    return best
+}
@@ -0,0 +1,51 @@
+package fp_test
+
+import (
+    "math"
+    "testing"
+
+    "github.com/camry/fp"
+
+    "github.com/stretchr/testify/assert"
+)
+
+// scalarCases Checks the scalar helpers over any Scalar, given the conversion from float64.
+func scalarCases[T fp.Scalar[T]](t *testing.T, from func(float64) T) {
+    values := []T{from(2.5), from(-1), from(4), from(0.5)}
+    assert.Equal(t, from(-1), fp.Min(values[0], values[1:]...))
+    assert.Equal(t, from(4), fp.Max(values[0], values[1:]...))
+    assert.Equal(t, from(6), fp.Sum(values))
+    assert.Equal(t, from(1.5), fp.Avg(values))
+    assert.Equal(t, from(0), fp.Avg([]T{}))
+    assert.Equal(t, from(3), fp.Avg([]T{from(1), from(2), from(3), from(4), from(5)}))
+    assert.Equal(t, from(3), fp.Lerp(from(2), from(6), from(0.25)))
+    assert.Equal(t, from(4), fp.Clamp(from(7), from(-1), from(4)))
+    assert.Equal(t, from(-1), fp.Clamp(from(-7), from(-1), from(4)))
+    assert.Equal(t, from(2), fp.Clamp(from(2), from(-1), from(4)))
+
+    assert.Equal(t, from(0), fp.Smoothstep(from(1), from(3), from(0.5)))
+    assert.Equal(t, from(1), fp.Smoothstep(from(1), from(3), from(5)))
+    assert.Equal(t, from(0.5), fp.Smoothstep(from(1), from(3), from(2)))
+    assert.Equal(t, from(0.15625), fp.Smoothstep(from(1), from(3), from(1.5)))
+}
+
+func TestScalar(t *testing.T) {
+    scalarCases(t, fp.F32FromFloat64)
+    scalarCases(t, fp.F64FromFloat64)
+}
+
+func TestVector(t *testing.T) {
+    pts := []fp.F64Vec2{fp.F64Vec2FromInt32(0, 0), fp.F64Vec2FromInt32(3, 4), fp.F64Vec2FromInt32(3, 0), fp.F64Vec2FromInt32(-2, 2)}
+    assert.Equal(t, fp.F64Vec2FromInt32(4, 6), fp.SumVec[fp.F64Vec2, fp.F64](pts))
+    assert.Equal(t, fp.F64Vec2FromFloat64(1, 1.5), fp.AvgVec[fp.F64Vec2, fp.F64](pts))
+    assert.Equal(t, fp.F64Vec2{}, fp.AvgVec[fp.F64Vec2, fp.F64](nil))
+    assert.InDelta(t, 5+4+math.Sqrt(29), fp.PathLength[fp.F64Vec2, fp.F64](pts).Float64(), 1e-6)
+    assert.Equal(t, 2, fp.Nearest[fp.F64Vec2, fp.F64](pts, fp.F64Vec2FromInt32(4, 1)))
+    assert.Equal(t, -1, fp.Nearest[fp.F64Vec2, fp.F64](nil, fp.F64Vec2{}))
+
+    cube := []fp.F32Vec3{fp.F32Vec3FromInt32(0, 0, 0), fp.F32Vec3FromInt32(2, 0, 0), fp.F32Vec3FromInt32(2, 2, 0), fp.F32Vec3FromInt32(0, 2, 2)}
+    assert.Equal(t, fp.F32Vec3FromInt32(4, 4, 2), fp.SumVec[fp.F32Vec3, fp.F32](cube))
+    assert.Equal(t, fp.F32Vec3FromFloat64(1, 1, 0.5), fp.AvgVec[fp.F32Vec3, fp.F32](cube))
+    assert.Equal(t, 1, fp.Nearest[fp.F32Vec3, fp.F32](cube, fp.F32Vec3FromInt32(3, 0, 0)))
+    assert.InDelta(t, 4+math.Sqrt(8), fp.PathLength[fp.F32Vec3, fp.F32](cube).Float64(), 1e-4)
+}
@@ -0,0 +1,308 @@
+package fp
+
+import (
+    "bytes"
+    "encoding/binary"
+    "encoding/json"
+    "fmt"
+    "strconv"
+    "strings"
+
+    "github.com/camry/fp/fix32"
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/fixutil"
+)
+
+// RawJSONMarshaler A value that can write its JSON form with raw integers, such as 6442450944 for F64 1.5,
+// instead of decimal strings such as "1.5".
+type RawJSONMarshaler interface {
+    MarshalJSONRaw() ([]byte, error)
+}
+
+// RawJSON Wraps a value so that it marshals to JSON with raw integers, choosing the form per field rather
+// than for the whole process. It unmarshals from either form, as UnmarshalJSON of every type accepts both,
+// as well as the {"Raw": ...} objects written before the types implemented json.Marshaler.
+type RawJSON[T RawJSONMarshaler] struct {
+    Value T
+}
+
+// MarshalJSON Implements json.Marshaler, writing the raw integer form of the value.
+func (r RawJSON[T]) MarshalJSON() ([]byte, error) {
+    return r.Value.MarshalJSONRaw()
+}
+
+// UnmarshalJSON Implements json.Unmarshaler, accepting any form the wrapped type accepts.
+func (r *RawJSON[T]) UnmarshalJSON(data []byte) error {
+    return json.Unmarshal(data, &r.Value)
+}
+
+// fixedFormat Describes the raw layout of a fixed point scalar.
+type fixedFormat struct {
+    shift   uint // fraction bits
+    size    int  // bytes per raw value
+    rawJSON bool // JSON with raw integers instead of decimal strings
+}
+
+var (
+    f64Format    = fixedFormat{shift: uint(fix64.Shift), size: 8}
+    f32Format    = fixedFormat{shift: uint(fix32.Shift), size: 4}
+    f64RawFormat = fixedFormat{shift: uint(fix64.Shift), size: 8, rawJSON: true}
+    f32RawFormat = fixedFormat{shift: uint(fix32.Shift), size: 4, rawJSON: true}
+)
+
+func (ff fixedFormat) bits() int {
+    return ff.size * 8
+}
+
+/************************************/
+/*************** JSON ***************/
+/************************************/
+
+func (ff fixedFormat) appendJSON(dst []byte, raw int64) []byte {
+    if ff.rawJSON {
+        return strconv.AppendInt(dst, raw, 10)
+    }
+    dst = append(dst, '"')
+    dst = fixutil.AppendFixed(dst, raw, ff.shift, 'f', -1)
+    return append(dst, '"')
+}
+
+func (ff fixedFormat) appendJSONTuple(dst []byte, raws ...int64) []byte {
+    dst = append(dst, '[')
+    for i, raw := range raws {
+        if i > 0 {
+            dst = append(dst, ',')
+        }
+        dst = ff.appendJSON(dst, raw)
+    }
+    return append(dst, ']')
+}
+
+// appendJSONRows Appends the raw values, in row-major order, as an array of rows of cols values.
+func (ff fixedFormat) appendJSONRows(dst []byte, cols int, raws ...int64) []byte {
+    dst = append(dst, '[')
+    for i := 0; i < len(raws); i += cols {
+        if i > 0 {
+            dst = append(dst, ',')
+        }
+        dst = ff.appendJSONTuple(dst, raws[i:i+cols]...)
+    }
+    return append(dst, ']')
+}
+
+// parseJSON Parses a decimal or hexadecimal string, or a raw integer, into a raw value.
+func (ff fixedFormat) parseJSON(typ string, data []byte) (int64, error) {
+    data = bytes.TrimSpace(data)
+    if len(data) > 0 && data[0] == '"' {
+        var s string
+        if err := json.Unmarshal(data, &s); err != nil {
+            return 0, fmt.Errorf("fp: %s.UnmarshalJSON: %w", typ, err)
+        }
+        return fixutil.ParseFixed(typ+".UnmarshalJSON", s, ff.shift, ff.bits())
+    }
+    raw, err := strconv.ParseInt(string(data), 10, ff.bits())
+    if err != nil {
+        return 0, fmt.Errorf("fp: %s.UnmarshalJSON: invalid raw value %q", typ, data)
+    }
+    return raw, nil
+}
+
+// unmarshalJSON Parses n raw values from a JSON scalar (n == 1) or array. legacy names the fields of the
+// equivalent {"Raw": ...} object form, if any. The result is nil, without error, for null.
+func (ff fixedFormat) unmarshalJSON(typ string, data []byte, legacy []string, n int) ([]int64, error) {
+    data = bytes.TrimSpace(data)
+    if string(data) == "null" {
+        return nil, nil
+    }
+    raws := make([]int64, n)
+    switch {
+    case len(data) > 0 && data[0] == '{' && legacy != nil:
+        var fields map[string]json.RawMessage
+        if err := json.Unmarshal(data, &fields); err != nil {
+            return nil, fmt.Errorf("fp: %s.UnmarshalJSON: %w", typ, err)
+        }
+        if len(fields) != len(legacy) {
+            return nil, fmt.Errorf("fp: %s.UnmarshalJSON: expected fields %s", typ, strings.Join(legacy, ", "))
+        }
+        for i, name := range legacy {
+            field, ok := fields[name]
+            if !ok {
+                return nil, fmt.Errorf("fp: %s.UnmarshalJSON: missing field %s", typ, name)
+            }
+            raw, err := strconv.ParseInt(string(bytes.TrimSpace(field)), 10, ff.bits())
+            if err != nil {
+                return nil, fmt.Errorf("fp: %s.UnmarshalJSON: invalid raw value %q for %s", typ, field, name)
+            }
+            raws[i] = raw
+        }
+    case n == 1:
+        raw, err := ff.parseJSON(typ, data)
+        if err != nil {
+            return nil, err
+        }
+        raws[0] = raw
+    default:
+        var elems []json.RawMessage
+        if err := json.Unmarshal(data, &elems); err != nil {
+            return nil, fmt.Errorf("fp: %s.UnmarshalJSON: %w", typ, err)
+        }
+        if len(elems) != n {
+            return nil, fmt.Errorf("fp: %s.UnmarshalJSON: expected %d components, got %d", typ, n, len(elems))
+        }
+        for i, elem := range elems {
+            raw, err := ff.parseJSON(typ, elem)
+            if err != nil {
+                return nil, err
+            }
+            raws[i] = raw
+        }
+    }
+    return raws, nil
+}
+
+// unmarshalJSONRows Parses a rows x cols matrix, in row-major order, from a JSON array of row arrays.
+// The result is nil, without error, for null.
+func (ff fixedFormat) unmarshalJSONRows(typ string, data []byte, rows, cols int) ([]int64, error) {
+    data = bytes.TrimSpace(data)
+    if string(data) == "null" {
+        return nil, nil
+    }
+    var elems []json.RawMessage
+    if err := json.Unmarshal(data, &elems); err != nil {
+        return nil, fmt.Errorf("fp: %s.UnmarshalJSON: %w", typ, err)
+    }
+    if len(elems) != rows {
+        return nil, fmt.Errorf("fp: %s.UnmarshalJSON: expected %d rows, got %d", typ, rows, len(elems))
+    }
+    raws := make([]int64, 0, rows*cols)
+    for i, elem := range elems {
+        row, err := ff.unmarshalJSON(typ, elem, nil, cols)
+        if err != nil {
+            return nil, err
+        }
+        if row == nil {
+            return nil, fmt.Errorf("fp: %s.UnmarshalJSON: row %d is null", typ, i)
+        }
+        raws = append(raws, row...)
+    }
+    return raws, nil
+}
+
+/************************************/
+/*************** Text ***************/
+/************************************/
+
+// appendText Appends the raw values in the ToString form, "1.5" for one value and "(1.5, 2)" for several.
+func (ff fixedFormat) appendText(dst []byte, raws ...int64) []byte {
+    if len(raws) == 1 {
+        return fixutil.AppendFixed(dst, raws[0], ff.shift, 'f', -1)
+    }
+    dst = append(dst, '(')
+    for i, raw := range raws {
+        if i > 0 {
+            dst = append(dst, ", "...)
+        }
+        dst = fixutil.AppendFixed(dst, raw, ff.shift, 'f', -1)
+    }
+    return append(dst, ')')
+}
+
+// splitTuple Splits "(a, (b, c), d)" into its top level elements "a", "(b, c)" and "d".
+func splitTuple(s string) ([]string, bool) {
+    s = strings.TrimSpace(s)
+    if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+        return nil, false
+    }
+    s = s[1 : len(s)-1]
+    var elems []string
+    depth, start := 0, 0
+    for i := 0; i < len(s); i++ {
+        switch s[i] {
+        case '(':
+            depth++
+        case ')':
+            depth--
+            if depth < 0 {
+                return nil, false
+            }
+        case ',':
+            if depth == 0 {
+                elems = append(elems, strings.TrimSpace(s[start:i]))
+                start = i + 1
+            }
+        }
+    }
+    if depth != 0 {
+        return nil, false
+    }
+    return append(elems, strings.TrimSpace(s[start:])), true
+}
+
+// unmarshalText Parses n raw values from the form written by appendText.
+func (ff fixedFormat) unmarshalText(typ string, text []byte, n int) ([]int64, error) {
+    elems := []string{string(text)}
+    if n > 1 {
+        var ok bool
+        if elems, ok = splitTuple(string(text)); !ok || len(elems) != n {
+            return nil, fmt.Errorf("fp: %s.UnmarshalText: expected %d components in %q", typ, n, text)
+        }
+    }
+    raws := make([]int64, n)
+    for i, elem := range elems {
+        raw, err := fixutil.ParseFixed(typ+".UnmarshalText", elem, ff.shift, ff.bits())
+        if err != nil {
+            return nil, err
+        }
+        raws[i] = raw
+    }
+    return raws, nil
+}
+
+// unmarshalTextRows Parses a rows x cols matrix, in row-major order, from "((a, b), (c, d))".
+func (ff fixedFormat) unmarshalTextRows(typ string, text []byte, rows, cols int) ([]int64, error) {
+    elems, ok := splitTuple(string(text))
+    if !ok || len(elems) != rows {
+        return nil, fmt.Errorf("fp: %s.UnmarshalText: expected %d rows in %q", typ, rows, text)
+    }
+    raws := make([]int64, 0, rows*cols)
+    for _, elem := range elems {
+        row, err := ff.unmarshalText(typ, []byte(elem), cols)
+        if err != nil {
+            return nil, err
+        }
+        raws = append(raws, row...)
+    }
+    return raws, nil
+}
+
+/************************************/
+/************** Binary **************/
+/************************************/
+
+// appendBinary Appends the raw values as fixed size little-endian integers.
+func (ff fixedFormat) appendBinary(dst []byte, raws ...int64) []byte {
+    for _, raw := range raws {
+        if ff.size == 8 {
+            dst = binary.LittleEndian.AppendUint64(dst, uint64(raw))
+        } else {
+            dst = binary.LittleEndian.AppendUint32(dst, uint32(raw))
+        }
+    }
+    return dst
+}
+
+// unmarshalBinary Parses n raw values from the form written by appendBinary, which must match its size exactly.
+func (ff fixedFormat) unmarshalBinary(typ string, data []byte, n int) ([]int64, error) {
+    if len(data) != ff.size*n {
+        return nil, fmt.Errorf("fp: %s.UnmarshalBinary: expected %d bytes, got %d", typ, ff.size*n, len(data))
+    }
+    raws := make([]int64, n)
+    for i := range raws {
+        if ff.size == 8 {
+            raws[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+        } else {
+            raws[i] = int64(int32(binary.LittleEndian.Uint32(data[i*4:])))
+        }
+    }
+    return raws, nil
+}
@@ -0,0 +1,172 @@
+package fp_test
+
+import (
+    "encoding"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "flag"
+    "fmt"
+    "os"
+    "path/filepath"
+    "reflect"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fixutil"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+type marshaler interface {
+    json.Marshaler
+    fp.RawJSONMarshaler
+    encoding.TextMarshaler
+    encoding.BinaryMarshaler
+}
+
+func marshalFixtures() []struct {
+    name  string
+    value marshaler
+} {
+    v64 := fp.F64Vec3FromF64(fp.F64Ratio(3, 2), fp.F64Neg1.Mul(fp.F64Two), fp.F64Pi)
+    q64 := fp.FromAxisAngle(fp.F64Vec3AxisY, fp.F64PiHalf)
+    v32 := fp.F32Vec3FromF32(fp.F32Ratio(3, 2), fp.F32Neg1.Mul(fp.F32Two), fp.F32Pi)
+    q32 := fp.F32QuatFromAxisAngle(fp.F32Vec3AxisY, fp.F32PiHalf)
+    return []struct {
+        name  string
+        value marshaler
+    }{
+        {"F64", fp.F64Ratio(-5, 4)},
+        {"F64Vec2", fp.F64Vec2FromF64(fp.F64E, fp.F64MinValue)},
+        {"F64Vec3", v64},
+        {"F64Vec4", fp.F64Vec4FromF64(fp.F64One, fp.F64Half, fp.F64Zero, fp.F64MaxValue)},
+        {"F64Quat", q64},
+        {"F64Mat3", fp.F64Mat3FromQuat(q64)},
+        {"F64Mat4", fp.F64Mat4FromTRS(v64, q64, fp.F64Vec3One.MulF64(fp.F64Two))},
+        {"F32", fp.F32Ratio(-5, 4)},
+        {"F32Vec2", fp.F32Vec2FromF32(fp.F32E, fp.F32MinValue)},
+        {"F32Vec3", v32},
+        {"F32Vec4", fp.F32Vec4FromF32(fp.F32One, fp.F32Half, fp.F32Zero, fp.F32MaxValue)},
+        {"F32Quat", q32},
+        {"F32Mat3", fp.F32Mat3FromQuat(q32)},
+        {"F32Mat4", fp.F32Mat4FromTRS(v32, q32, fp.F32Vec3One.MulF32(fp.F32Two))},
+    }
+}
+
+func TestMarshal_Golden(t *testing.T) {
+    var sb strings.Builder
+    for _, fx := range marshalFixtures() {
+        js, err := fx.value.MarshalJSON()
+        assert.NoError(t, err)
+        raw, err := fx.value.MarshalJSONRaw()
+        assert.NoError(t, err)
+        text, err := fx.value.MarshalText()
+        assert.NoError(t, err)
+        bin, err := fx.value.MarshalBinary()
+        assert.NoError(t, err)
+        fmt.Fprintf(&sb, "%s json: %s\n", fx.name, js)
+        fmt.Fprintf(&sb, "%s json raw: %s\n", fx.name, raw)
+        fmt.Fprintf(&sb, "%s text: %s\n", fx.name, text)
+        fmt.Fprintf(&sb, "%s binary: %s\n", fx.name, hex.EncodeToString(bin))
+
+        // Every encoding decodes back to the same value.
+        typ := reflect.TypeOf(fx.value)
+        for _, data := range [][]byte{js, raw} {
+            p := reflect.New(typ)
+            assert.NoError(t, json.Unmarshal(data, p.Interface()), fx.name)
+            assert.Equal(t, fx.value, p.Elem().Interface(), fx.name)
+        }
+        p := reflect.New(typ)
+        assert.NoError(t, p.Interface().(encoding.TextUnmarshaler).UnmarshalText(text), fx.name)
+        assert.Equal(t, fx.value, p.Elem().Interface(), fx.name)
+        p = reflect.New(typ)
+        assert.NoError(t, p.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(bin), fx.name)
+        assert.Equal(t, fx.value, p.Elem().Interface(), fx.name)
+
+        // Truncated binary input is rejected.
+        assert.Error(t, p.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(bin[1:]), fx.name)
+    }
+
+    golden := filepath.Join("testdata", "marshal.golden")
+    if *update {
+        assert.NoError(t, os.MkdirAll("testdata", 0o755))
+        assert.NoError(t, os.WriteFile(golden, []byte(sb.String()), 0o644))
+    }
+    want, err := os.ReadFile(golden)
+    assert.NoError(t, err)
+    assert.Equal(t, string(want), sb.String())
+}
+
+func TestMarshal_JSONStruct(t *testing.T) {
+    type save struct {
+        Pos    fp.F64Vec3
+        Speed  fp.F32
+        Facing fp.F64Quat
+    }
+    s := save{Pos: fp.F64Vec3FromInt32(1, 2, 3), Speed: fp.F32Half, Facing: fp.Identity}
+    data, err := json.Marshal(s)
+    assert.NoError(t, err)
+    assert.Equal(t, `{"Pos":["1","2","3"],"Speed":"0.5","Facing":["0","0","0","1"]}`, string(data))
+
+    // Files written before MarshalJSON existed still load.
+    var old save
+    assert.NoError(t, json.Unmarshal([]byte(`{"Pos":{"RawX":4294967296,"RawY":8589934592,"RawZ":12884901888},"Speed":{"Raw":32768},"Facing":{"RawX":0,"RawY":0,"RawZ":0,"RawW":4294967296}}`), &old))
+    assert.Equal(t, s, old)
+
+    // RawJSON picks the raw integer form per field, and either form loads into it.
+    type rawSave struct {
+        Pos   fp.RawJSON[fp.F64Vec3]
+        Speed fp.RawJSON[fp.F32]
+        Name  fp.F64
+    }
+    r := rawSave{Pos: fp.RawJSON[fp.F64Vec3]{Value: s.Pos}, Speed: fp.RawJSON[fp.F32]{Value: s.Speed}, Name: fp.F64Half}
+    data, err = json.Marshal(r)
+    assert.NoError(t, err)
+    assert.Equal(t, `{"Pos":[4294967296,8589934592,12884901888],"Speed":32768,"Name":"0.5"}`, string(data))
+    var back rawSave
+    assert.NoError(t, json.Unmarshal(data, &back))
+    assert.Equal(t, r, back)
+    assert.NoError(t, json.Unmarshal([]byte(`{"Pos":["1","2","3"],"Speed":"0.5","Name":"0.5"}`), &back))
+    assert.Equal(t, r, back)
+
+    // Map keys use the text form.
+    keys, err := json.Marshal(map[fp.F64]int{fp.F64Half: 1})
+    assert.NoError(t, err)
+    assert.Equal(t, `{"0.5":1}`, string(keys))
+}
+
+func TestMarshal_Invalid(t *testing.T) {
+    f := fp.F64One
+    assert.NoError(t, json.Unmarshal([]byte(`null`), &f))
+    assert.Equal(t, fp.F64One, f)
+    assert.Error(t, json.Unmarshal([]byte(`1.5`), &f))
+    assert.Error(t, json.Unmarshal([]byte(`true`), &f))
+    assert.Error(t, json.Unmarshal([]byte(`{"Raw":1,"X":2}`), &f))
+    err := json.Unmarshal([]byte(`"1.5x"`), &f)
+    assert.True(t, errors.Is(err, fixutil.ErrSyntax))
+    err = json.Unmarshal([]byte(`"3e9"`), &f)
+    assert.True(t, errors.Is(err, fixutil.ErrRange))
+    assert.Equal(t, fp.F64One, f)
+
+    var f32 fp.F32
+    assert.Error(t, json.Unmarshal([]byte(`4294967296`), &f32))
+    assert.Error(t, f32.UnmarshalText([]byte("40000")))
+
+    v := fp.F64Vec3One
+    assert.Error(t, json.Unmarshal([]byte(`["1","2"]`), &v))
+    assert.Error(t, json.Unmarshal([]byte(`["1","2","x"]`), &v))
+    assert.Error(t, v.UnmarshalText([]byte("(1, 2)")))
+    assert.Error(t, v.UnmarshalText([]byte("1, 2, 3")))
+    assert.Error(t, v.UnmarshalBinary(make([]byte, 23)))
+    assert.Equal(t, fp.F64Vec3One, v)
+
+    m := fp.F64Mat3Identity
+    assert.Error(t, json.Unmarshal([]byte(`[["1","0","0"],["0","1","0"]]`), &m))
+    assert.Error(t, json.Unmarshal([]byte(`[["1","0","0"],null,["0","0","1"]]`), &m))
+    assert.Error(t, m.UnmarshalText([]byte("((1, 0, 0), (0, 1, 0), (0, 0))")))
+    assert.Equal(t, fp.F64Mat3Identity, m)
+}
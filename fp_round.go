@@ -0,0 +1,16 @@
+package fp
+
+import (
+    "github.com/camry/fp/fixutil"
+)
+
+// RoundingMode Selects how MulRound and DivRound round an inexact result.
+type RoundingMode = fixutil.RoundingMode
+
+const (
+    RoundNearestEven = fixutil.RoundNearestEven // to nearest, ties to the even value
+    RoundNearestAway = fixutil.RoundNearestAway // to nearest, ties away from zero
+    RoundTowardZero  = fixutil.RoundTowardZero  // toward zero (truncation)
+    RoundFloor       = fixutil.RoundFloor       // toward negative infinity, like Mul
+    RoundCeil        = fixutil.RoundCeil        // toward positive infinity
+)
@@ -0,0 +1,242 @@
+package fp
+
+import (
+    "database/sql/driver"
+    "encoding"
+    "fmt"
+    "strconv"
+)
+
+// SQLFormat Selects how a value is stored in a database column.
+type SQLFormat int
+
+const (
+    // SQLDecimal Stores the decimal text form, "1.5" for scalars and "(1.5, 2)" for vectors. Suits TEXT and
+    // NUMERIC columns. This is the default of Value.
+    SQLDecimal SQLFormat = iota
+    // SQLRaw Stores the raw fixed point integer of a scalar. Suits INTEGER and BIGINT columns.
+    SQLRaw
+    // SQLBytes Stores the little-endian MarshalBinary form. Suits BLOB and BYTEA columns.
+    SQLBytes
+)
+
+func (f SQLFormat) String() string {
+    switch f {
+    case SQLDecimal:
+        return "SQLDecimal"
+    case SQLRaw:
+        return "SQLRaw"
+    case SQLBytes:
+        return "SQLBytes"
+    }
+    return "SQLFormat(" + strconv.Itoa(int(f)) + ")"
+}
+
+// SQLColumn Adapts an fp value to a column that uses a non-default SQLFormat.
+type SQLColumn struct {
+    v      interface{}
+    format SQLFormat
+}
+
+// SQLAs Wraps v, an fp scalar or vector or a pointer to one, so that it is stored and scanned using format:
+//
+//	db.Exec("UPDATE items SET price = ? WHERE id = ?", fp.SQLAs(price, fp.SQLRaw), id)
+//	db.QueryRow("SELECT price FROM items WHERE id = ?", id).Scan(fp.SQLAs(&price, fp.SQLRaw))
+func SQLAs(v interface{}, format SQLFormat) SQLColumn {
+    return SQLColumn{v: v, format: format}
+}
+
+// Value Implements driver.Valuer.
+func (c SQLColumn) Value() (driver.Value, error) {
+    return sqlValue(c.v, c.format)
+}
+
+// Scan Implements sql.Scanner, the wrapped value must be a pointer.
+func (c SQLColumn) Scan(src interface{}) error {
+    return sqlScan(c.v, src, c.format)
+}
+
+/************************************/
+/*********** Scan/Value *************/
+/************************************/
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (f F64) Value() (driver.Value, error) {
+    return sqlValue(f, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (f *F64) Scan(src interface{}) error {
+    return sqlScan(f, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F64Vec2) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F64Vec2) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F64Vec3) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F64Vec3) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F64Vec4) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F64Vec4) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (f F32) Value() (driver.Value, error) {
+    return sqlValue(f, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (f *F32) Scan(src interface{}) error {
+    return sqlScan(f, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F32Vec2) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F32Vec2) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F32Vec3) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F32Vec3) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+// Value Implements driver.Valuer, storing the decimal text form, see SQLAs for other formats.
+func (v F32Vec4) Value() (driver.Value, error) {
+    return sqlValue(v, SQLDecimal)
+}
+
+// Scan Implements sql.Scanner, see sqlScan for the accepted column types.
+func (v *F32Vec4) Scan(src interface{}) error {
+    return sqlScan(v, src, SQLDecimal)
+}
+
+/************************************/
+/************* Helpers **************/
+/************************************/
+
+// sqlRaw Returns the raw integer of a scalar, or false for other types.
+func sqlRaw(v interface{}) (int64, bool) {
+    switch v := v.(type) {
+    case F64:
+        return v.Raw, true
+    case *F64:
+        return v.Raw, true
+    case F32:
+        return int64(v.Raw), true
+    case *F32:
+        return int64(v.Raw), true
+    }
+    return 0, false
+}
+
+func sqlValue(v interface{}, format SQLFormat) (driver.Value, error) {
+    switch format {
+    case SQLDecimal:
+        if m, ok := v.(encoding.TextMarshaler); ok {
+            text, err := m.MarshalText()
+            return string(text), err
+        }
+    case SQLRaw:
+        if raw, ok := sqlRaw(v); ok {
+            return raw, nil
+        }
+    case SQLBytes:
+        if m, ok := v.(encoding.BinaryMarshaler); ok {
+            return m.MarshalBinary()
+        }
+    }
+    return nil, fmt.Errorf("fp: cannot store %T as %v", v, format)
+}
+
+// sqlScan Stores src into dst, a pointer to an fp value. Integers are raw values and are only accepted for
+// scalars. Strings and bytes are decimal text, or raw integers in text form for SQLRaw, except that bytes
+// are the MarshalBinary form for SQLBytes. float64 values go through their shortest decimal form and are
+// rounded to the nearest fixed point value, so REAL columns still scan deterministically.
+func sqlScan(dst interface{}, src interface{}, format SQLFormat) error {
+    if src == nil {
+        return fmt.Errorf("fp: cannot scan NULL into %T", dst)
+    }
+    if format == SQLRaw {
+        var text string
+        switch s := src.(type) {
+        case string:
+            text = s
+        case []byte:
+            text = string(s)
+        }
+        if text != "" {
+            raw, err := strconv.ParseInt(text, 10, 64)
+            if err != nil {
+                return fmt.Errorf("fp: invalid raw value %q", text)
+            }
+            src = raw
+        }
+    }
+    switch src := src.(type) {
+    case int64:
+        switch dst := dst.(type) {
+        case *F64:
+            *dst = F64FromRaw(src)
+            return nil
+        case *F32:
+            if src != int64(int32(src)) {
+                return fmt.Errorf("fp: raw value %d out of range for F32", src)
+            }
+            *dst = F32FromRaw(int32(src))
+            return nil
+        }
+    case float64:
+        switch dst.(type) {
+        case *F64, *F32:
+            return sqlScanText(dst, strconv.FormatFloat(src, 'f', -1, 64))
+        }
+    case string:
+        return sqlScanText(dst, src)
+    case []byte:
+        if format != SQLBytes {
+            return sqlScanText(dst, string(src))
+        }
+        if u, ok := dst.(encoding.BinaryUnmarshaler); ok {
+            return u.UnmarshalBinary(src)
+        }
+    }
+    return fmt.Errorf("fp: cannot scan %T into %T", src, dst)
+}
+
+func sqlScanText(dst interface{}, text string) error {
+    u, ok := dst.(encoding.TextUnmarshaler)
+    if !ok {
+        return fmt.Errorf("fp: cannot scan text into %T", dst)
+    }
+    return u.UnmarshalText([]byte(text))
+}
@@ -0,0 +1,177 @@
+package fp_test
+
+import (
+    "database/sql"
+    "database/sql/driver"
+    "errors"
+    "io"
+    "strings"
+    "sync"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+)
+
+// fakeDriver Is an in-memory key/value store understanding two statements, "SET key" with one argument and
+// "GET key". "GETB key" returns stored strings as []byte, the way many drivers return TEXT columns.
+type fakeDriver struct {
+    mu    sync.Mutex
+    cells map[string]driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+    d       *fakeDriver
+    op, key string
+}
+
+type fakeRows struct {
+    value driver.Value
+    done  bool
+}
+
+var fakeDB = &fakeDriver{cells: map[string]driver.Value{}}
+
+func init() {
+    sql.Register("fpfake", fakeDB)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d}, nil }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+    op, key, ok := strings.Cut(query, " ")
+    if !ok {
+        return nil, errors.New("fake: bad query")
+    }
+    return &fakeStmt{d: c.d, op: op, key: key}, nil
+}
+
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("fake: no transactions") }
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int {
+    if s.op == "SET" {
+        return 1
+    }
+    return 0
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+    s.d.mu.Lock()
+    defer s.d.mu.Unlock()
+    s.d.cells[s.key] = args[0]
+    return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+    s.d.mu.Lock()
+    defer s.d.mu.Unlock()
+    v := s.d.cells[s.key]
+    if str, ok := v.(string); ok && s.op == "GETB" {
+        v = []byte(str)
+    }
+    return &fakeRows{value: v}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"v"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+    if r.done {
+        return io.EOF
+    }
+    r.done = true
+    dest[0] = r.value
+    return nil
+}
+
+func TestSQL_Default(t *testing.T) {
+    db, err := sql.Open("fpfake", "")
+    assert.NoError(t, err)
+    defer db.Close()
+
+    price := fp.F64Ratio(-5, 4)
+    _, err = db.Exec("SET price", price)
+    assert.NoError(t, err)
+    assert.Equal(t, "-1.25", fakeDB.cells["price"])
+
+    for _, q := range []string{"GET price", "GETB price"} {
+        var got fp.F64
+        assert.NoError(t, db.QueryRow(q).Scan(&got))
+        assert.Equal(t, price, got)
+    }
+
+    pos := fp.F32Vec3FromInt32(1, -2, 3).DivF32(fp.F32Two)
+    _, err = db.Exec("SET pos", pos)
+    assert.NoError(t, err)
+    assert.Equal(t, "(0.5, -1, 1.5)", fakeDB.cells["pos"])
+    var gotPos fp.F32Vec3
+    assert.NoError(t, db.QueryRow("GETB pos").Scan(&gotPos))
+    assert.Equal(t, pos, gotPos)
+}
+
+func TestSQL_Formats(t *testing.T) {
+    db, err := sql.Open("fpfake", "")
+    assert.NoError(t, err)
+    defer db.Close()
+
+    rate := fp.F32Ratio(3, 4)
+    _, err = db.Exec("SET rate", fp.SQLAs(rate, fp.SQLRaw))
+    assert.NoError(t, err)
+    assert.Equal(t, int64(49152), fakeDB.cells["rate"])
+    var gotRate fp.F32
+    assert.NoError(t, db.QueryRow("GET rate").Scan(&gotRate))
+    assert.Equal(t, rate, gotRate)
+    gotRate = fp.F32Zero
+    assert.NoError(t, db.QueryRow("GET rate").Scan(fp.SQLAs(&gotRate, fp.SQLRaw)))
+    assert.Equal(t, rate, gotRate)
+
+    // Raw integers that come back as text.
+    fakeDB.cells["rawtext"] = "49152"
+    gotRate = fp.F32Zero
+    assert.NoError(t, db.QueryRow("GETB rawtext").Scan(fp.SQLAs(&gotRate, fp.SQLRaw)))
+    assert.Equal(t, rate, gotRate)
+
+    v := fp.F64Vec4FromInt32(1, 2, 3, 4)
+    _, err = db.Exec("SET v", fp.SQLAs(&v, fp.SQLBytes))
+    assert.NoError(t, err)
+    assert.Len(t, fakeDB.cells["v"], 32)
+    var gotV fp.F64Vec4
+    assert.NoError(t, db.QueryRow("GET v").Scan(fp.SQLAs(&gotV, fp.SQLBytes)))
+    assert.Equal(t, v, gotV)
+
+    // Vectors have no raw integer form.
+    _, err = db.Exec("SET v", fp.SQLAs(v, fp.SQLRaw))
+    assert.Error(t, err)
+}
+
+func TestSQL_Invalid(t *testing.T) {
+    db, err := sql.Open("fpfake", "")
+    assert.NoError(t, err)
+    defer db.Close()
+
+    fakeDB.cells["real"] = 0.1
+    var f fp.F64
+    assert.NoError(t, db.QueryRow("GET real").Scan(&f))
+    assert.Equal(t, fp.F64FromRaw(429496730), f) // nearest to 0.1, FromFloat64 would truncate
+
+    fakeDB.cells["null"] = nil
+    assert.Error(t, db.QueryRow("GET null").Scan(&f))
+
+    fakeDB.cells["big"] = int64(1) << 40
+    var f32 fp.F32
+    assert.Error(t, db.QueryRow("GET big").Scan(&f32))
+
+    fakeDB.cells["bad"] = "1.5.2"
+    assert.Error(t, db.QueryRow("GET bad").Scan(&f))
+
+    var v fp.F64Vec2
+    fakeDB.cells["short"] = []byte{1, 2, 3}
+    assert.Error(t, db.QueryRow("GET short").Scan(fp.SQLAs(&v, fp.SQLBytes)))
+    assert.Error(t, db.QueryRow("GET big").Scan(&v))
+}
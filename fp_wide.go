@@ -0,0 +1,310 @@
+package fp
+
+import (
+    "math/bits"
+
+    "github.com/camry/fp/fix64"
+)
+
+// Exact squared distances for the geometry types. Coordinates may span the whole F64 range, where
+// differences need 65 bits and their squares overflow any F64, so squares are summed as raw magnitudes
+// in 192 bits. A value v represents v * 2^-64.
+
+// wideSqr An unsigned 192-bit sum of squared raw values, least significant word first.
+type wideSqr [3]uint64
+
+// absDiff Returns |a - b| without overflow.
+func absDiff(a, b int64) uint64 {
+    if a < b {
+        return uint64(b) - uint64(a)
+    }
+    return uint64(a) - uint64(b)
+}
+
+// absRaw Returns |a| without overflow.
+func absRaw(a int64) uint64 {
+    if a < 0 {
+        return -uint64(a)
+    }
+    return uint64(a)
+}
+
+// addSqr Adds mag^2.
+func (w *wideSqr) addSqr(mag uint64) {
+    hi, lo := bits.Mul64(mag, mag)
+    var c uint64
+    w[0], c = bits.Add64(w[0], lo, 0)
+    w[1], c = bits.Add64(w[1], hi, c)
+    w[2] += c
+}
+
+// wideSqrOf Returns the sum of the squares of the magnitudes.
+func wideSqrOf(mags ...uint64) wideSqr {
+    var w wideSqr
+    for _, m := range mags {
+        w.addSqr(m)
+    }
+    return w
+}
+
+// cmp Returns the sign of w - o.
+func (w wideSqr) cmp(o wideSqr) int {
+    return cmpU192(w[2], w[1], w[0], o[2], o[1], o[0])
+}
+
+// sqrtCeil Returns the square root of w rounded up, as a raw F64 value, saturating at fix64.MaxValue.
+func (w wideSqr) sqrtCeil() int64 {
+    r, ok := w.sqrtCeilU64()
+    if !ok || r > uint64(fix64.MaxValue) {
+        return fix64.MaxValue
+    }
+    return int64(r)
+}
+
+// sqrtCeilU64 Returns the square root of w rounded up, ok is false if it doesn't fit in 64 bits.
+func (w wideSqr) sqrtCeilU64() (uint64, bool) {
+    if w[2] != 0 {
+        return 0, false
+    }
+    var r uint64
+    for bit := 63; bit >= 0; bit-- {
+        c := r | 1<<uint(bit)
+        hi, lo := bits.Mul64(c, c)
+        if hi < w[1] || (hi == w[1] && lo <= w[0]) {
+            r = c
+        }
+    }
+    if hi, lo := bits.Mul64(r, r); hi != w[1] || lo != w[0] {
+        if r == 1<<64-1 {
+            return 0, false
+        }
+        r++
+    }
+    return r, true
+}
+
+// midpoint Returns the raw value halfway between a and b, rounded toward a, without overflow.
+func midpoint(a, b int64) int64 {
+    if a <= b {
+        return a + int64((uint64(b)-uint64(a))>>1)
+    }
+    return a - int64((uint64(a)-uint64(b))>>1)
+}
+
+// lerpFrac Returns a + (b - a) * num / den, rounded toward a, for num <= den. The difference never overflows.
+func lerpFrac(a, b int64, num, den uint64) int64 {
+    hi, lo := bits.Mul64(absDiff(a, b), num)
+    step, _ := bits.Div64(hi, lo, den)
+    if a <= b {
+        return a + int64(step)
+    }
+    return a - int64(step)
+}
+
+// orient2 Returns the sign of (b - a) x (c - a): 1 when a, b, c turn counter-clockwise, -1 when they turn
+// clockwise and 0 when they are collinear. The result is exact for any coordinates.
+func orient2(a, b, c F64Vec2) int {
+    // Compare (bx-ax)(cy-ay) with (by-ay)(cx-ax) as signed 129-bit products.
+    pNeg, pHi, pLo := mulDiff(a.RawX, b.RawX, a.RawY, c.RawY)
+    qNeg, qHi, qLo := mulDiff(a.RawY, b.RawY, a.RawX, c.RawX)
+    return cmpSigned128(pNeg, pHi, pLo, qNeg, qHi, qLo)
+}
+
+// mulDiff Returns the exact product (b1 - a1) * (b2 - a2) as a sign and a 128-bit magnitude.
+func mulDiff(a1, b1, a2, b2 int64) (neg bool, hi, lo uint64) {
+    hi, lo = bits.Mul64(absDiff(a1, b1), absDiff(a2, b2))
+    if hi == 0 && lo == 0 {
+        return false, 0, 0
+    }
+    return (b1 < a1) != (b2 < a2), hi, lo
+}
+
+// cmpSigned128 Returns the sign of p - q for values given as a sign and a 128-bit magnitude, where zero
+// is never negative.
+func cmpSigned128(pNeg bool, pHi, pLo uint64, qNeg bool, qHi, qLo uint64) int {
+    if pNeg != qNeg {
+        if pNeg {
+            return -1
+        }
+        return 1
+    }
+    c := cmpU192(0, pHi, pLo, 0, qHi, qLo)
+    if pNeg {
+        return -c
+    }
+    return c
+}
+
+// wideSum A signed 192-bit sum of raw products in two's complement, least significant word first.
+type wideSum [3]uint64
+
+// addMul Adds the exact product a * b.
+func (w *wideSum) addMul(a, b int64) {
+    w.add(a, b, false)
+}
+
+// subMul Subtracts the exact product a * b.
+func (w *wideSum) subMul(a, b int64) {
+    w.add(a, b, true)
+}
+
+// add Adds a * b, or subtracts it when negate is set.
+func (w *wideSum) add(a, b int64, negate bool) {
+    hi, lo := bits.Mul64(absRaw(a), absRaw(b))
+    w.addMag((a < 0) != (b < 0) != negate, hi, lo)
+}
+
+// addMulDiff Adds the exact product (b1 - a1) * (b2 - a2), whose factors may take 65 bits.
+func (w *wideSum) addMulDiff(a1, b1, a2, b2 int64) {
+    w.addMag(mulDiff(a1, b1, a2, b2))
+}
+
+// subMulDiff Subtracts the exact product (b1 - a1) * (b2 - a2).
+func (w *wideSum) subMulDiff(a1, b1, a2, b2 int64) {
+    neg, hi, lo := mulDiff(a1, b1, a2, b2)
+    w.addMag(!neg, hi, lo)
+}
+
+// addMag Adds the 128-bit magnitude hi:lo, negated when neg is set.
+func (w *wideSum) addMag(neg bool, hi, lo uint64) {
+    w.addSum(wideSum{lo, hi, 0}, neg)
+}
+
+// addWideMulDiff Adds x * (b - a), for a sum x of at most 126 significant bits so that the product fits.
+func (w *wideSum) addWideMulDiff(x wideSum, a, b int64) {
+    m := absDiff(a, b)
+    mag := x.abs()
+    h0, l0 := bits.Mul64(mag[0], m)
+    h1, l1 := bits.Mul64(mag[1], m)
+    mid, c := bits.Add64(h0, l1, 0)
+    w.addSum(wideSum{l0, mid, h1 + mag[2]*m + c}, (x.sign() < 0) != (b < a))
+}
+
+// addSum Adds x, or subtracts it when negate is set.
+func (w *wideSum) addSum(x wideSum, negate bool) {
+    if negate {
+        x = x.negate()
+    }
+    var c uint64
+    w[0], c = bits.Add64(w[0], x[0], 0)
+    w[1], c = bits.Add64(w[1], x[1], c)
+    w[2], _ = bits.Add64(w[2], x[2], c)
+}
+
+// shiftSat Returns the sum shifted right by n < 192 bits, rounding towards negative infinity and
+// saturating to the int64 range.
+func (w wideSum) shiftSat(n uint) int64 {
+    w = w.shr(n)
+    switch hi, mid, lo := w[2], w[1], w[0]; {
+    case hi == 0 && mid == 0 && int64(lo) >= 0, hi == ^uint64(0) && mid == ^uint64(0) && int64(lo) < 0:
+        return int64(lo)
+    case int64(hi) < 0:
+        return fix64.MinValue
+    default:
+        return fix64.MaxValue
+    }
+}
+
+// shr Returns the sum shifted right arithmetically by n < 192 bits.
+func (w wideSum) shr(n uint) wideSum {
+    for ; n >= 64; n -= 64 {
+        w = wideSum{w[1], w[2], uint64(int64(w[2]) >> 63)}
+    }
+    if n == 0 {
+        return w
+    }
+    return wideSum{w[0]>>n | w[1]<<(64-n), w[1]>>n | w[2]<<(64-n), uint64(int64(w[2]) >> n)}
+}
+
+// negate Returns -w.
+func (w wideSum) negate() wideSum {
+    var b uint64
+    w[0], b = bits.Sub64(0, w[0], 0)
+    w[1], b = bits.Sub64(0, w[1], b)
+    w[2], _ = bits.Sub64(0, w[2], b)
+    return w
+}
+
+// abs Returns |w|.
+func (w wideSum) abs() wideSum {
+    if w.sign() < 0 {
+        return w.negate()
+    }
+    return w
+}
+
+// bitLen Returns the number of significant bits of |w|.
+func (w wideSum) bitLen() uint {
+    w = w.abs()
+    switch {
+    case w[2] != 0:
+        return uint(bits.Len64(w[2])) + 128
+    case w[1] != 0:
+        return uint(bits.Len64(w[1])) + 64
+    default:
+        return uint(bits.Len64(w[0]))
+    }
+}
+
+// sign Returns -1, 0 or 1 for a negative, zero or positive sum.
+func (w wideSum) sign() int {
+    switch {
+    case int64(w[2]) < 0:
+        return -1
+    case w == wideSum{}:
+        return 0
+    default:
+        return 1
+    }
+}
+
+// bitsAt Returns the 64 bits of a non-negative sum starting at bit s, for s < 192.
+func (w wideSum) bitsAt(s uint) uint64 {
+    i, off := s/64, s%64
+    v := w[i] >> off
+    if off != 0 && i < 2 {
+        v |= w[i+1] << (64 - off)
+    }
+    return v
+}
+
+// clampFrac Returns num / den as a raw F64 clamped to [0, 1] and truncated, for den > 0.
+func clampFrac(num, den wideSum) int64 {
+    if num.sign() <= 0 {
+        return 0
+    }
+    if cmpU192(num[2], num[1], num[0], den[2], den[1], den[0]) >= 0 {
+        return fix64.One
+    }
+    // Drop the low bits of both until den fits into 64 bits, which leaves it at least 63 significant bits.
+    var s uint
+    if n := den.bitLen(); n > 64 {
+        s = n - 64
+    }
+    n, d := num.bitsAt(s), den.bitsAt(s)
+    q, _ := bits.Div64(n>>fix64.Shift, n<<fix64.Shift, d)
+    return int64(q)
+}
+
+// divSatWide Returns num / den as a raw F64, truncated and saturating at fix64.MaxValue, for num >= 0 and
+// den > 0.
+func divSatWide(num, den wideSum) int64 {
+    // Drop the low bits of both until den fits into 64 bits, like clampFrac.
+    var s uint
+    if n := den.bitLen(); n > 64 {
+        s = n - 64
+    }
+    n, d := num.shr(s), den.bitsAt(s)
+    if n.bitLen() > uint(128-fix64.Shift) {
+        return fix64.MaxValue
+    }
+    hi, lo := n[1]<<fix64.Shift|n[0]>>(64-fix64.Shift), n[0]<<fix64.Shift
+    if hi >= d {
+        return fix64.MaxValue
+    }
+    q, _ := bits.Div64(hi, lo, d)
+    if q > uint64(fix64.MaxValue) {
+        return fix64.MaxValue
+    }
+    return int64(q)
+}
@@ -0,0 +1,154 @@
+package accuracy
+
+import (
+    "fmt"
+    "math"
+    "math/big"
+    "os"
+    "regexp"
+    "strconv"
+)
+
+// Package accuracy measures fixed point functions against math/big references for the accuracy tests of
+// fixutil, fix64 and fix32.
+//
+// A Sweep compares the results of a function at inputs in ascending order with the exact results, and
+// reports the error in units of the last place (ULP) of the results, the precision in bits the function
+// achieves, the samples missing the precision it claims, and the steps against the direction of a
+// monotone function.
+
+// Mode How an error is scaled before it is turned into bits of precision.
+type Mode int
+
+const (
+    Absolute Mode = iota // the error itself, for results near zero such as logarithms and sines
+    Relative             // the error divided by the exact result, for results of any magnitude
+)
+
+// Sweep How the results of one function are measured.
+type Sweep struct {
+    Shift    int     // the number of fractional bits of the results
+    Mode     Mode    // how errors are scaled into bits of precision
+    Bits     float64 // the precision the function must achieve
+    Slack    float64 // the ULPs of error allowed beyond the precision, for the rounding of the result
+    Monotone int     // 1 if the function is non-decreasing over the inputs, -1 if non-increasing, 0 if neither
+}
+
+// Report The errors of a function over a sweep.
+type Report struct {
+    Samples    int
+    MaxULP     float64 // the largest error in ULPs
+    MeanULP    float64
+    Bits       float64 // the precision achieved beyond the slack of the sweep, +Inf if every error is within it
+    Failures   int     // the samples with errors beyond the precision and the slack of the sweep
+    Violations int     // the steps against the direction of a monotone function
+    Worst      int     // the index of the sample furthest from the precision of the sweep
+}
+
+// Run Measures the results got against the exact results want, both in the order of ascending inputs.
+func (s Sweep) Run(got []int64, want []*big.Float) Report {
+    r := Report{Samples: len(got), Bits: math.Inf(1)}
+    ulp := math.Ldexp(1, -s.Shift)
+    var sum, worst float64
+    for i, g := range got {
+        exact := newFloat().SetMantExp(want[i], s.Shift)
+        e, _ := exact.Sub(Fixed(g, 0), exact).Float64()
+        e = math.Abs(e)
+        sum += e
+        r.MaxULP = math.Max(r.MaxULP, e)
+        scale := 1.0
+        if s.Mode == Relative {
+            w, _ := want[i].Float64()
+            scale = math.Max(math.Abs(w), ulp)
+        }
+        // The error beyond the slack, relative to the scale.
+        if scaled := math.Max(e-s.Slack, 0) * ulp / scale; scaled > worst {
+            worst, r.Worst = scaled, i
+        }
+        if e > math.Ldexp(scale, s.Shift)*math.Exp2(-s.Bits)+s.Slack {
+            r.Failures++
+        }
+        if i > 0 && s.Monotone*compare(g, got[i-1]) < 0 {
+            r.Violations++
+        }
+    }
+    if len(got) > 0 {
+        r.MeanULP = sum / float64(len(got))
+    }
+    if worst > 0 {
+        r.Bits = -math.Log2(worst)
+    }
+    return r
+}
+
+func compare(a, b int64) int {
+    switch {
+    case a < b:
+        return -1
+    case a > b:
+        return 1
+    default:
+        return 0
+    }
+}
+
+func (r Report) String() string {
+    return fmt.Sprintf("%6.2f bits  max %12.2f ulp  mean %10.3f ulp  %d failed  %d non-monotone of %d",
+        r.Bits, r.MaxULP, r.MeanULP, r.Failures, r.Violations, r.Samples)
+}
+
+// Documented Returns the precisions claimed by the "Name Precision: 12.34 bits" comments of a source file.
+func Documented(path string) (map[string]float64, error) {
+    src, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+    docs := map[string]float64{}
+    for _, m := range documented.FindAllStringSubmatch(string(src), -1) {
+        if docs[m[1]], err = strconv.ParseFloat(m[2], 64); err != nil {
+            return nil, err
+        }
+    }
+    return docs, nil
+}
+
+var documented = regexp.MustCompile(`// (\w+) Precision: ([0-9.]+) bits`)
+
+// Combined Returns the precision of a result whose error sums the errors of parts with the precisions
+// bits, each scaled by its weight, less the rounding of the documented precisions to two decimals.
+func Combined(bits, weights []float64) float64 {
+    var e float64
+    for i, b := range bits {
+        e += weights[i] * math.Exp2(-b)
+    }
+    return -math.Log2(e) - 0.005
+}
+
+// Linear Returns n fixed point inputs with shift fractional bits in ascending order, evenly spread over
+// [lo, hi) and each moved by a hash within its step to reach odd inputs.
+func Linear(lo, hi float64, n, shift int) []int64 {
+    a, b := math.Ldexp(lo, shift), math.Ldexp(hi, shift)
+    step := (b - a) / float64(n)
+    in := make([]int64, n)
+    for i := range in {
+        in[i] = int64(math.Floor(a + step*(float64(i)+jitter(i))))
+    }
+    return in
+}
+
+// Geometric Returns n fixed point inputs with shift fractional bits in ascending order, spread evenly in
+// magnitude over [lo, hi) for 0 < lo < hi, see Linear.
+func Geometric(lo, hi float64, n, shift int) []int64 {
+    a, b := math.Log2(lo), math.Log2(hi)
+    step := (b - a) / float64(n)
+    in := make([]int64, n)
+    for i := range in {
+        in[i] = int64(math.Floor(math.Ldexp(math.Exp2(a+step*(float64(i)+jitter(i))), shift)))
+    }
+    return in
+}
+
+// jitter Returns a hash of i in [0, 1).
+func jitter(i int) float64 {
+    return float64(uint32(i)*2654435761) / (1 << 32)
+}
@@ -0,0 +1,74 @@
+package accuracy_test
+
+import (
+    "math"
+    "math/big"
+    "testing"
+
+    "github.com/camry/fp/internal/accuracy"
+    "github.com/stretchr/testify/assert"
+)
+
+func TestReference(t *testing.T) {
+    f := accuracy.Float
+    near := func(want float64, got *big.Float, name string, x float64) {
+        g, _ := got.Float64()
+        assert.InDelta(t, want, g, 1e-15*math.Max(1, math.Abs(want)), "%s(%v)", name, x)
+    }
+    for _, x := range []float64{-40, -3.5, -1, -0.25, 0, 1e-9, 0.5, 1, 2, 7.25, 30} {
+        near(math.Exp(x), accuracy.Exp(f(x)), "Exp", x)
+        near(math.Exp2(x), accuracy.Exp2(f(x)), "Exp2", x)
+        near(math.Sin(x), accuracy.Sin(f(x)), "Sin", x)
+        near(math.Cos(x), accuracy.Cos(f(x)), "Cos", x)
+        near(math.Atan(x), accuracy.Atan(f(x)), "Atan", x)
+        near(math.Atan2(x, -1.5), accuracy.Atan2(f(x), f(-1.5)), "Atan2", x)
+        near(math.Sinh(x), accuracy.Sinh(f(x)), "Sinh", x)
+        near(math.Cosh(x), accuracy.Cosh(f(x)), "Cosh", x)
+        near(math.Tanh(x), accuracy.Tanh(f(x)), "Tanh", x)
+        near(math.Asinh(x), accuracy.Asinh(f(x)), "Asinh", x)
+        if x > 0 {
+            near(math.Log(x), accuracy.Log(f(x)), "Log", x)
+            near(math.Log2(x), accuracy.Log2(f(x)), "Log2", x)
+            near(math.Sqrt(x), accuracy.Sqrt(f(x)), "Sqrt", x)
+        }
+        if x >= 1 {
+            near(math.Acosh(x), accuracy.Acosh(f(x)), "Acosh", x)
+        }
+        if math.Abs(x) < 1 {
+            near(math.Atanh(x), accuracy.Atanh(f(x)), "Atanh", x)
+        }
+        if math.Abs(x) <= 1 {
+            near(math.Asin(x), accuracy.Asin(f(x)), "Asin", x)
+            near(math.Acos(x), accuracy.Acos(f(x)), "Acos", x)
+        }
+    }
+    near(math.Pi, accuracy.Pi(), "Pi", 0)
+    near(math.Ln2, accuracy.Ln2(), "Ln2", 0)
+
+    // The references agree with each other far beyond float64.
+    x := accuracy.Fixed(0x1234_5678_9abc, 32)
+    diff := new(big.Float).Sub(accuracy.Log(accuracy.Exp(x)), x)
+    assert.True(t, diff.Abs(diff).Cmp(accuracy.Float(0x1p-110)) < 0)
+    s, c := accuracy.Sin(x), accuracy.Cos(x)
+    one := new(big.Float).Add(new(big.Float).Mul(s, s), new(big.Float).Mul(c, c))
+    assert.True(t, one.Sub(one, accuracy.Float(1)).Abs(one).Cmp(accuracy.Float(0x1p-110)) < 0)
+}
+
+func TestSweep(t *testing.T) {
+    want := []*big.Float{accuracy.Float(1), accuracy.Float(2), accuracy.Float(3), accuracy.Float(4)}
+    r := accuracy.Sweep{Shift: 4, Mode: accuracy.Absolute, Monotone: 1}.Run([]int64{16, 33, 31, 64}, want)
+    assert.Equal(t, 4, r.Samples)
+    assert.Equal(t, 17.0, r.MaxULP)
+    assert.Equal(t, 2, r.Worst)
+    assert.Equal(t, 1, r.Violations)
+    assert.InDelta(t, -math.Log2(17.0/16), r.Bits, 1e-12)
+    assert.Equal(t, 1, r.Failures) // Bits 0 allows 16 ulp
+    r = accuracy.Sweep{Shift: 4, Mode: accuracy.Relative, Bits: 6, Slack: 1}.Run([]int64{16, 33, 48, 64}, want)
+    assert.Equal(t, 0, r.Violations)
+    assert.Equal(t, 0, r.Failures)
+    assert.True(t, math.IsInf(r.Bits, 1))
+    r = accuracy.Sweep{Shift: 4, Mode: accuracy.Relative, Bits: 6}.Run([]int64{16, 33, 48, 64}, want)
+    assert.Equal(t, 1, r.Failures)
+    assert.Equal(t, 1, r.Worst)
+    assert.InDelta(t, 5, r.Bits, 1e-12) // 1/16 off 2
+}
@@ -0,0 +1,312 @@
+package accuracy
+
+import (
+    "math"
+    "math/big"
+    "sync"
+)
+
+// Prec The precision in bits of the reference functions, far beyond that of any fixed point result.
+const Prec = 128
+
+// epsilon Where the series of the reference functions stop.
+var epsilon = new(big.Float).SetMantExp(big.NewFloat(1), -Prec-8)
+
+func newFloat() *big.Float {
+    return new(big.Float).SetPrec(Prec)
+}
+
+// Float Returns v as a reference value.
+func Float(v float64) *big.Float {
+    return newFloat().SetFloat64(v)
+}
+
+// Fixed Returns the exact value of the fixed point number raw with shift fractional bits.
+func Fixed(raw int64, shift int) *big.Float {
+    x := newFloat().SetInt64(raw)
+    return x.SetMantExp(x, -shift)
+}
+
+var (
+    constOnce sync.Once
+    ln2, pi   *big.Float
+)
+
+func constants() {
+    constOnce.Do(func() {
+        // ln(2) = sum 1 / (k * 2^k)
+        ln2 = newFloat()
+        for k := 1; k <= Prec+16; k++ {
+            t := newFloat().SetInt64(int64(k))
+            t.SetMantExp(t, k)
+            ln2.Add(ln2, t.Quo(Float(1), t))
+        }
+        // Machin: pi = 16 atan(1/5) - 4 atan(1/239)
+        a := atanInv(5)
+        b := atanInv(239)
+        pi = newFloat().Sub(a.Mul(a, Float(16)), b.Mul(b, Float(4)))
+    })
+}
+
+// atanInv Returns atan(1/n) from its series.
+func atanInv(n int64) *big.Float {
+    sum := newFloat()
+    nn := newFloat().SetInt64(n * n)
+    p := newFloat().Quo(Float(1), newFloat().SetInt64(n)) // 1 / n^(2k+1)
+    for k := int64(0); ; k++ {
+        t := newFloat().Quo(p, newFloat().SetInt64(2*k+1))
+        if k&1 == 0 {
+            sum.Add(sum, t)
+        } else {
+            sum.Sub(sum, t)
+        }
+        if t.Cmp(epsilon) < 0 {
+            return sum
+        }
+        p.Quo(p, nn)
+    }
+}
+
+// Ln2 Returns ln(2).
+func Ln2() *big.Float {
+    constants()
+    return newFloat().Set(ln2)
+}
+
+// Pi Returns pi.
+func Pi() *big.Float {
+    constants()
+    return newFloat().Set(pi)
+}
+
+// Sqrt Returns the square root of x >= 0.
+func Sqrt(x *big.Float) *big.Float {
+    if x.Sign() == 0 {
+        return newFloat()
+    }
+    return newFloat().Sqrt(x)
+}
+
+// Exp Returns e^x.
+func Exp(x *big.Float) *big.Float {
+    // x = k ln(2) + r with |r| <= ln(2) / 2, and e^r from the series of e^(r / 2^8) squared 8 times.
+    xf, _ := x.Float64()
+    k := math.Round(xf / math.Ln2)
+    r := newFloat().Sub(x, newFloat().Mul(Ln2(), Float(k)))
+    r.SetMantExp(r, -8)
+    sum, t := Float(1), Float(1)
+    for n := int64(1); ; n++ {
+        t.Mul(t, r)
+        t.Quo(t, newFloat().SetInt64(n))
+        sum.Add(sum, t)
+        if newFloat().Abs(t).Cmp(epsilon) < 0 {
+            break
+        }
+    }
+    for i := 0; i < 8; i++ {
+        sum.Mul(sum, sum)
+    }
+    return sum.SetMantExp(sum, int(k))
+}
+
+// Exp2 Returns 2^x.
+func Exp2(x *big.Float) *big.Float {
+    return Exp(newFloat().Mul(x, Ln2()))
+}
+
+// Log Returns the natural logarithm of x > 0.
+func Log(x *big.Float) *big.Float {
+    // x = m 2^e with m in [0.5, 1), and ln(m) by Halley's iteration on e^y = m from a float64 estimate.
+    m := newFloat()
+    e := x.MantExp(m)
+    mf, _ := m.Float64()
+    y := Float(math.Log(mf))
+    for i := 0; i < 3; i++ {
+        ey := Exp(y)
+        d := newFloat().Sub(m, ey)
+        d.Quo(d, newFloat().Add(m, ey))
+        y.Add(y, d.Add(d, d))
+    }
+    return y.Add(y, newFloat().Mul(Ln2(), Float(float64(e))))
+}
+
+// Log2 Returns the base 2 logarithm of x > 0.
+func Log2(x *big.Float) *big.Float {
+    return newFloat().Quo(Log(x), Ln2())
+}
+
+// Sin Returns the sine of x.
+func Sin(x *big.Float) *big.Float {
+    r, q := reduceHalfPi(x)
+    switch q {
+    case 0:
+        return sinSeries(r)
+    case 1:
+        return cosSeries(r)
+    case 2:
+        s := sinSeries(r)
+        return s.Neg(s)
+    default:
+        c := cosSeries(r)
+        return c.Neg(c)
+    }
+}
+
+// Cos Returns the cosine of x.
+func Cos(x *big.Float) *big.Float {
+    r, q := reduceHalfPi(x)
+    switch q {
+    case 0:
+        return cosSeries(r)
+    case 1:
+        s := sinSeries(r)
+        return s.Neg(s)
+    case 2:
+        c := cosSeries(r)
+        return c.Neg(c)
+    default:
+        return sinSeries(r)
+    }
+}
+
+// Tan Returns the tangent of x.
+func Tan(x *big.Float) *big.Float {
+    return newFloat().Quo(Sin(x), Cos(x))
+}
+
+// reduceHalfPi Returns r and q with x = r + n pi/2, |r| <= pi/4 and q = n mod 4.
+func reduceHalfPi(x *big.Float) (*big.Float, int) {
+    halfPi := Pi()
+    halfPi.SetMantExp(halfPi, -1)
+    xf, _ := newFloat().Quo(x, halfPi).Float64()
+    n := math.Round(xf)
+    r := newFloat().Sub(x, newFloat().Mul(halfPi, Float(n)))
+    return r, int(math.Mod(n, 4)+4) % 4
+}
+
+func sinSeries(r *big.Float) *big.Float {
+    return series(r, newFloat().Set(r), 2)
+}
+
+func cosSeries(r *big.Float) *big.Float {
+    return series(r, Float(1), 1)
+}
+
+// series Sums t - t r^2 / (n (n+1)) + ..., the series of sin from t = r and n = 2 and of cos from t = 1
+// and n = 1.
+func series(r, t *big.Float, n int64) *big.Float {
+    rr := newFloat().Mul(r, r)
+    sum := newFloat().Set(t)
+    for ; ; n += 2 {
+        t.Mul(t, rr)
+        t.Quo(t, newFloat().SetInt64(n*(n+1)))
+        t.Neg(t)
+        sum.Add(sum, t)
+        if newFloat().Abs(t).Cmp(epsilon) < 0 {
+            return sum
+        }
+    }
+}
+
+// Atan Returns the arctangent of x.
+func Atan(x *big.Float) *big.Float {
+    if x.Sign() < 0 {
+        a := Atan(newFloat().Neg(x))
+        return a.Neg(a)
+    }
+    if x.Cmp(Float(1)) > 0 {
+        halfPi := Pi()
+        halfPi.SetMantExp(halfPi, -1)
+        return halfPi.Sub(halfPi, Atan(newFloat().Quo(Float(1), x)))
+    }
+    // atan(x) = 2 atan(x / (1 + sqrt(1 + x^2))), twice, to speed up the series.
+    r := newFloat().Set(x)
+    for i := 0; i < 2; i++ {
+        d := Sqrt(newFloat().Add(Float(1), newFloat().Mul(r, r)))
+        r.Quo(r, d.Add(d, Float(1)))
+    }
+    rr := newFloat().Mul(r, r)
+    sum, p := newFloat().Set(r), newFloat().Set(r)
+    for k := int64(1); ; k++ {
+        p.Mul(p, rr)
+        p.Neg(p)
+        t := newFloat().Quo(p, newFloat().SetInt64(2*k+1))
+        sum.Add(sum, t)
+        if newFloat().Abs(t).Cmp(epsilon) < 0 {
+            break
+        }
+    }
+    return sum.SetMantExp(sum, 2)
+}
+
+// Atan2 Returns the angle of the point (x, y), in (-pi, pi].
+func Atan2(y, x *big.Float) *big.Float {
+    switch {
+    case x.Sign() > 0:
+        return Atan(newFloat().Quo(y, x))
+    case x.Sign() < 0:
+        a := Atan(newFloat().Quo(y, x))
+        if y.Sign() < 0 {
+            return a.Sub(a, Pi())
+        }
+        return a.Add(a, Pi())
+    case y.Sign() == 0:
+        return newFloat()
+    default:
+        halfPi := Pi()
+        halfPi.SetMantExp(halfPi, -1)
+        if y.Sign() < 0 {
+            halfPi.Neg(halfPi)
+        }
+        return halfPi
+    }
+}
+
+// Asin Returns the arcsine of x in [-1, 1].
+func Asin(x *big.Float) *big.Float {
+    return Atan2(x, Sqrt(newFloat().Sub(Float(1), newFloat().Mul(x, x))))
+}
+
+// Acos Returns the arccosine of x in [-1, 1].
+func Acos(x *big.Float) *big.Float {
+    return Atan2(Sqrt(newFloat().Sub(Float(1), newFloat().Mul(x, x))), x)
+}
+
+// Sinh Returns the hyperbolic sine of x.
+func Sinh(x *big.Float) *big.Float {
+    s := newFloat().Sub(Exp(x), Exp(newFloat().Neg(x)))
+    return s.SetMantExp(s, -1)
+}
+
+// Cosh Returns the hyperbolic cosine of x.
+func Cosh(x *big.Float) *big.Float {
+    c := newFloat().Add(Exp(x), Exp(newFloat().Neg(x)))
+    return c.SetMantExp(c, -1)
+}
+
+// Tanh Returns the hyperbolic tangent of x.
+func Tanh(x *big.Float) *big.Float {
+    return newFloat().Quo(Sinh(x), Cosh(x))
+}
+
+// Asinh Returns the inverse hyperbolic sine of x.
+func Asinh(x *big.Float) *big.Float {
+    // Odd, from |x| so that nothing cancels.
+    a := newFloat().Abs(x)
+    y := Log(newFloat().Add(a, Sqrt(newFloat().Add(newFloat().Mul(a, a), Float(1)))))
+    if x.Sign() < 0 {
+        y.Neg(y)
+    }
+    return y
+}
+
+// Acosh Returns the inverse hyperbolic cosine of x >= 1.
+func Acosh(x *big.Float) *big.Float {
+    return Log(newFloat().Add(x, Sqrt(newFloat().Sub(newFloat().Mul(x, x), Float(1)))))
+}
+
+// Atanh Returns the inverse hyperbolic tangent of x in (-1, 1).
+func Atanh(x *big.Float) *big.Float {
+    y := Log(newFloat().Quo(newFloat().Add(Float(1), x), newFloat().Sub(Float(1), x)))
+    return y.SetMantExp(y, -1)
+}
@@ -0,0 +1,276 @@
+package noise
+
+import (
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/rand"
+)
+
+// Deterministic gradient and value noise.
+//
+// All noise is computed with fix64 arithmetic on a permutation table derived from a seed, so a given seed
+// and input produce the same output on every platform. Results are in [-1, 1]. The lattice repeats every
+// 256 units along each axis.
+
+// Noise A seeded noise generator. It is immutable after New and safe for concurrent use.
+type Noise struct {
+    perm [512]uint8
+}
+
+// New Returns a noise generator whose permutation table is shuffled by rand.New(seed).
+func New(seed uint64) *Noise {
+    n := &Noise{}
+    for i := 0; i < 256; i++ {
+        n.perm[i] = uint8(i)
+    }
+    r := rand.New(seed)
+    r.Shuffle(256, func(i, j int) { n.perm[i], n.perm[j] = n.perm[j], n.perm[i] })
+    copy(n.perm[256:], n.perm[:256])
+    return n
+}
+
+/************************************/
+/************** Perlin **************/
+/************************************/
+
+// Perlin2 Returns 2D Perlin noise at p.
+func (n *Noise) Perlin2(p fp.F64Vec2) fp.F64 {
+    return n.lattice(2, [4]int64{p.RawX, p.RawY}, perlinScale[2], gradDot)
+}
+
+// Perlin3 Returns 3D Perlin noise at p.
+func (n *Noise) Perlin3(p fp.F64Vec3) fp.F64 {
+    return n.lattice(3, [4]int64{p.RawX, p.RawY, p.RawZ}, perlinScale[3], gradDot)
+}
+
+// Perlin4 Returns 4D Perlin noise at p.
+func (n *Noise) Perlin4(p fp.F64Vec4) fp.F64 {
+    return n.lattice(4, [4]int64{p.RawX, p.RawY, p.RawZ, p.RawW}, perlinScale[4], gradDot)
+}
+
+/************************************/
+/************** Value ***************/
+/************************************/
+
+// Value2 Returns 2D value noise at p.
+func (n *Noise) Value2(p fp.F64Vec2) fp.F64 {
+    return n.lattice(2, [4]int64{p.RawX, p.RawY}, fix64.One, latticeValue)
+}
+
+// Value3 Returns 3D value noise at p.
+func (n *Noise) Value3(p fp.F64Vec3) fp.F64 {
+    return n.lattice(3, [4]int64{p.RawX, p.RawY, p.RawZ}, fix64.One, latticeValue)
+}
+
+// Value4 Returns 4D value noise at p.
+func (n *Noise) Value4(p fp.F64Vec4) fp.F64 {
+    return n.lattice(4, [4]int64{p.RawX, p.RawY, p.RawZ, p.RawW}, fix64.One, latticeValue)
+}
+
+/************************************/
+/************* Simplex **************/
+/************************************/
+
+// Simplex2 Returns 2D simplex noise at p.
+func (n *Noise) Simplex2(p fp.F64Vec2) fp.F64 {
+    return n.simplex(2, [4]int64{p.RawX, p.RawY})
+}
+
+// Simplex3 Returns 3D simplex noise at p.
+func (n *Noise) Simplex3(p fp.F64Vec3) fp.F64 {
+    return n.simplex(3, [4]int64{p.RawX, p.RawY, p.RawZ})
+}
+
+// Simplex4 Returns 4D simplex noise at p.
+func (n *Noise) Simplex4(p fp.F64Vec4) fp.F64 {
+    return n.simplex(4, [4]int64{p.RawX, p.RawY, p.RawZ, p.RawW})
+}
+
+/************************************/
+/************* Helpers **************/
+/************************************/
+
+// Per dimension constants, indexed by the number of dimensions.
+var (
+    perlinScale  = [5]int64{2: fix64.One, 3: fix64.One, 4: 3865470566}   // 1, 1, 0.9
+    simplexScale = [5]int64{2: 70 << 32, 3: 32 << 32, 4: 27 << 32}       // 70, 32, 27
+    simplexR2    = [5]int64{2: 2147483648, 3: 2576980378, 4: 2576980378} // 0.5, 0.6, 0.6
+    skewF        = [5]int64{2: 1572067139, 3: 1431655765, 4: 1327217885} // (sqrt(n+1)-1)/n
+    unskewG      = [5]int64{2: 907633386, 3: 715827883, 4: 593549882}    // (1-1/sqrt(n+1))/n
+)
+
+// hash Returns the permutation hash of a lattice point, given its coordinates modulo 256.
+func (n *Noise) hash(dims int, cell [4]int64) uint8 {
+    h := uint8(0)
+    for d := dims - 1; d >= 0; d-- {
+        h = n.perm[int(h)+int(cell[d]&255)]
+    }
+    return h
+}
+
+// gradDot Returns the dot product of the gradient selected by h with the offset x. Gradients point to the
+// edge midpoints of the unit square, cube or hypercube, so the products are sums of components.
+func gradDot(dims int, h uint8, x [4]int64) int64 {
+    switch dims {
+    case 2:
+        // The 8 directions (+-1, 0), (0, +-1) and (+-1, +-1).
+        a, b := x[0], x[1]
+        switch h & 7 {
+        case 0:
+            return a
+        case 1:
+            return -a
+        case 2:
+            return b
+        case 3:
+            return -b
+        case 4:
+            return a + b
+        case 5:
+            return -a + b
+        case 6:
+            return a - b
+        }
+        return -a - b
+    case 3:
+        // The 12 cube edges, with 4 repeated to fill 16 slots as in improved Perlin noise.
+        h &= 15
+        u, v := x[1], x[2]
+        if h < 8 {
+            u = x[0]
+        }
+        if h < 4 {
+            v = x[1]
+        } else if h == 12 || h == 14 {
+            v = x[0]
+        }
+        if h&1 != 0 {
+            u = -u
+        }
+        if h&2 != 0 {
+            v = -v
+        }
+        return u + v
+    }
+    // The 32 hypercube edges, one zero component chosen by h>>3 and three signs.
+    h &= 31
+    var a, b, c int64
+    switch h >> 3 {
+    case 0:
+        a, b, c = x[1], x[2], x[3]
+    case 1:
+        a, b, c = x[0], x[2], x[3]
+    case 2:
+        a, b, c = x[0], x[1], x[3]
+    default:
+        a, b, c = x[0], x[1], x[2]
+    }
+    if h&4 != 0 {
+        a = -a
+    }
+    if h&2 != 0 {
+        b = -b
+    }
+    if h&1 != 0 {
+        c = -c
+    }
+    return a + b + c
+}
+
+// latticeValue Returns the value of the lattice point with hash h, evenly spread over [-1, 1].
+func latticeValue(_ int, h uint8, _ [4]int64) int64 {
+    return (int64(h)*2 - 255) << 32 / 255
+}
+
+// fade Returns the quintic smoothstep 6t^5 - 15t^4 + 10t^3.
+func fade(t int64) int64 {
+    return fix64.Mul(fix64.Mul(fix64.Mul(t, t), t), fix64.Mul(t, fix64.Mul(t, 6*fix64.One)-15*fix64.One)+10*fix64.One)
+}
+
+// lattice Interpolates corner(dims, hash, offset) over the corners of the lattice cell containing p, with
+// the offsets from each corner to p, and scales the result.
+func (n *Noise) lattice(dims int, p [4]int64, scale int64, corner func(int, uint8, [4]int64) int64) fp.F64 {
+    var cell, frac, u [4]int64
+    for d := 0; d < dims; d++ {
+        cell[d] = p[d] >> fix64.Shift
+        frac[d] = p[d] & fix64.FractionMask
+        u[d] = fade(frac[d])
+    }
+
+    // Corner c has bit d set when it is on the far side of axis d.
+    var vals [16]int64
+    for c := 0; c < 1<<dims; c++ {
+        var at, off [4]int64
+        for d := 0; d < dims; d++ {
+            bit := int64(c >> d & 1)
+            at[d] = cell[d] + bit
+            off[d] = frac[d] - bit<<fix64.Shift
+        }
+        vals[c] = corner(dims, n.hash(dims, at), off)
+    }
+
+    // Interpolate along axis 0 first, halving the number of values each time.
+    for d := 0; d < dims; d++ {
+        for c := 0; c < 1<<(dims-d-1); c++ {
+            a, b := vals[2*c], vals[2*c+1]
+            vals[c] = a + fix64.Mul(b-a, u[d])
+        }
+    }
+    return clampUnit(fix64.Mul(vals[0], scale))
+}
+
+// simplex Sums the contributions of the corners of the simplex containing p.
+func (n *Noise) simplex(dims int, p [4]int64) fp.F64 {
+    // Skew to find the cell, then unskew to get the offset from its origin.
+    var sum int64
+    for d := 0; d < dims; d++ {
+        sum += p[d]
+    }
+    s := fix64.Mul(sum, skewF[dims])
+    var cell, x0 [4]int64
+    var cellSum int64
+    for d := 0; d < dims; d++ {
+        cell[d] = (p[d] + s) >> fix64.Shift
+        cellSum += cell[d]
+    }
+    t := fix64.Mul(cellSum<<fix64.Shift, unskewG[dims])
+    for d := 0; d < dims; d++ {
+        x0[d] = p[d] - (cell[d]<<fix64.Shift - t)
+    }
+
+    // The simplex steps along the axes in order of decreasing offset, ties broken by axis.
+    var order [4]int
+    for d := 0; d < dims; d++ {
+        order[d] = d
+    }
+    for i := 1; i < dims; i++ {
+        for j := i; j > 0 && x0[order[j]] > x0[order[j-1]]; j-- {
+            order[j], order[j-1] = order[j-1], order[j]
+        }
+    }
+
+    var total int64
+    var step [4]int64
+    for k := 0; k <= dims; k++ {
+        if k > 0 {
+            step[order[k-1]] = 1
+        }
+        var at, off [4]int64
+        r2 := simplexR2[dims]
+        for d := 0; d < dims; d++ {
+            at[d] = cell[d] + step[d]
+            off[d] = x0[d] - step[d]<<fix64.Shift + int64(k)*unskewG[dims]
+            r2 -= fix64.Mul(off[d], off[d])
+        }
+        if r2 > 0 {
+            r4 := fix64.Mul(r2, r2)
+            total += fix64.Mul(fix64.Mul(r4, r4), gradDot(dims, n.hash(dims, at), off))
+        }
+    }
+    return clampUnit(fix64.Mul(total, simplexScale[dims]))
+}
+
+// clampUnit Clamps raw to [-1, 1].
+func clampUnit(raw int64) fp.F64 {
+    return fp.F64FromRaw(fix64.Clamp(raw, -fix64.One, fix64.One))
+}
@@ -0,0 +1,63 @@
+package noise
+
+import (
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+)
+
+// Octave combinators, which sum several layers of a noise function at increasing frequencies. The noise
+// is passed as a method value, for example:
+//
+//	height := noise.FBm(n.Simplex2, p, noise.DefaultOctaves)
+
+// Octaves Configures the octave combinators.
+type Octaves struct {
+    Count      int    // number of layers
+    Lacunarity fp.F64 // frequency multiplier per layer
+    Gain       fp.F64 // amplitude multiplier per layer
+}
+
+// DefaultOctaves Five layers, each at twice the frequency and half the amplitude of the previous one.
+var DefaultOctaves = Octaves{Count: 5, Lacunarity: fp.F64Two, Gain: fp.F64Half}
+
+// Point A noise input, one of fp.F64Vec2, fp.F64Vec3 and fp.F64Vec4.
+type Point[P any] interface {
+    MulF64(b fp.F64) P
+}
+
+// FBm Returns fractal Brownian motion, the sum of the octaves of noise at p, in [-1, 1].
+func FBm[P Point[P]](noise func(P) fp.F64, p P, o Octaves) fp.F64 {
+    return octaves(noise, p, o, func(v int64) int64 { return v })
+}
+
+// Ridged Returns ridged multifractal noise, the sum of (1 - |noise|)^2 over the octaves, in [0, 1]. It
+// has sharp crests where the noise crosses zero.
+func Ridged[P Point[P]](noise func(P) fp.F64, p P, o Octaves) fp.F64 {
+    return octaves(noise, p, o, func(v int64) int64 {
+        r := fix64.One - fix64.Abs(v)
+        return fix64.Mul(r, r)
+    })
+}
+
+// Turbulence Returns the sum of |noise| over the octaves, in [0, 1].
+func Turbulence[P Point[P]](noise func(P) fp.F64, p P, o Octaves) fp.F64 {
+    return octaves(noise, p, o, fix64.Abs)
+}
+
+// octaves Sums shape(noise) over the octaves, normalized by the total amplitude. A zero count or
+// amplitude returns 0.
+func octaves[P Point[P]](noise func(P) fp.F64, p P, o Octaves, shape func(int64) int64) fp.F64 {
+    var sum, total int64
+    freq, amp := fix64.One, fix64.One
+    for i := 0; i < o.Count; i++ {
+        v := shape(noise(p.MulF64(fp.F64FromRaw(freq))).Raw)
+        sum += fix64.Mul(v, amp)
+        total += amp
+        freq = fix64.Mul(freq, o.Lacunarity.Raw)
+        amp = fix64.Mul(amp, o.Gain.Raw)
+    }
+    if total <= 0 {
+        return fp.F64Zero
+    }
+    return fp.F64FromRaw(fix64.Clamp(fix64.DivPrecise(sum, total), -fix64.One, fix64.One))
+}
@@ -0,0 +1,108 @@
+package noise_test
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/noise"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestNoise_Golden(t *testing.T) {
+    n := noise.New(2024)
+    funcs2 := []struct {
+        name string
+        f    func(fp.F64Vec2) fp.F64
+    }{
+        {"Perlin2", n.Perlin2}, {"Simplex2", n.Simplex2}, {"Value2", n.Value2},
+        {"FBm(Simplex2)", func(p fp.F64Vec2) fp.F64 { return noise.FBm(n.Simplex2, p, noise.DefaultOctaves) }},
+        {"Ridged(Perlin2)", func(p fp.F64Vec2) fp.F64 { return noise.Ridged(n.Perlin2, p, noise.DefaultOctaves) }},
+        {"Turbulence(Value2)", func(p fp.F64Vec2) fp.F64 { return noise.Turbulence(n.Value2, p, noise.DefaultOctaves) }},
+    }
+    funcs3 := []struct {
+        name string
+        f    func(fp.F64Vec3) fp.F64
+    }{
+        {"Perlin3", n.Perlin3}, {"Simplex3", n.Simplex3}, {"Value3", n.Value3},
+        {"FBm(Perlin3)", func(p fp.F64Vec3) fp.F64 { return noise.FBm(n.Perlin3, p, noise.DefaultOctaves) }},
+    }
+    funcs4 := []struct {
+        name string
+        f    func(fp.F64Vec4) fp.F64
+    }{
+        {"Perlin4", n.Perlin4}, {"Simplex4", n.Simplex4}, {"Value4", n.Value4},
+    }
+
+    // Raw values, so that any change of a single ulp shows up.
+    var sb strings.Builder
+    coord := func(i int) fp.F64 { return fp.F64Ratio(int32(i*37-100), 16) }
+    for _, fx := range funcs2 {
+        fmt.Fprintf(&sb, "%s:", fx.name)
+        for i := 0; i < 8; i++ {
+            fmt.Fprintf(&sb, " %d", fx.f(fp.F64Vec2FromF64(coord(i), coord(7-i))).Raw)
+        }
+        sb.WriteString("\n")
+    }
+    for _, fx := range funcs3 {
+        fmt.Fprintf(&sb, "%s:", fx.name)
+        for i := 0; i < 8; i++ {
+            fmt.Fprintf(&sb, " %d", fx.f(fp.F64Vec3FromF64(coord(i), coord(7-i), coord(i*3%8))).Raw)
+        }
+        sb.WriteString("\n")
+    }
+    for _, fx := range funcs4 {
+        fmt.Fprintf(&sb, "%s:", fx.name)
+        for i := 0; i < 8; i++ {
+            fmt.Fprintf(&sb, " %d", fx.f(fp.F64Vec4FromF64(coord(i), coord(7-i), coord(i*3%8), coord(i*5%8))).Raw)
+        }
+        sb.WriteString("\n")
+    }
+
+    golden := filepath.Join("testdata", "noise.golden")
+    if *update {
+        assert.NoError(t, os.MkdirAll("testdata", 0o755))
+        assert.NoError(t, os.WriteFile(golden, []byte(sb.String()), 0o644))
+    }
+    want, err := os.ReadFile(golden)
+    assert.NoError(t, err)
+    assert.Equal(t, string(want), sb.String())
+}
+
+func TestNoise_Properties(t *testing.T) {
+    n := noise.New(1)
+    step := fp.F64FromRaw(1 << 20) // 2^-12
+    for i := 0; i < 500; i++ {
+        x, y, z := fp.F64Ratio(int32(i*7919%4001)-2000, 97), fp.F64Ratio(int32(i*104729%3001)-1500, 89), fp.F64Ratio(int32(i), 13)
+        p := fp.F64Vec3FromF64(x, y, z)
+        q := fp.F64Vec3FromF64(x.Add(step), y, z)
+        for _, f := range []func(fp.F64Vec3) fp.F64{n.Perlin3, n.Simplex3, n.Value3} {
+            v := f(p)
+            assert.True(t, v.GE(fp.F64Neg1) && v.LE(fp.F64One))
+            // Continuous: a small step changes the value a little.
+            assert.True(t, f(q).Sub(v).Abs().LT(fp.F64Ratio(1, 100)))
+        }
+        r := noise.Ridged(n.Simplex3, p, noise.DefaultOctaves)
+        assert.True(t, r.GE(fp.F64Zero) && r.LE(fp.F64One))
+        u := noise.Turbulence(n.Perlin3, p, noise.DefaultOctaves)
+        assert.True(t, u.GE(fp.F64Zero) && u.LE(fp.F64One))
+    }
+
+    // Perlin noise is zero at lattice points, and the lattice repeats every 256 units.
+    p := fp.F64Vec2FromInt32(3, -7)
+    assert.Equal(t, fp.F64Zero, n.Perlin2(p))
+    a := fp.F64Vec2FromF64(fp.F64Ratio(13, 10), fp.F64Ratio(-27, 10))
+    assert.Equal(t, n.Perlin2(a), n.Perlin2(a.Add(fp.F64Vec2FromInt32(256, -512))))
+
+    // Different seeds give different noise, the same seed the same noise.
+    assert.NotEqual(t, n.Simplex2(a), noise.New(2).Simplex2(a))
+    assert.Equal(t, n.Simplex2(a), noise.New(1).Simplex2(a))
+    assert.Equal(t, fp.F64Zero, noise.FBm(n.Simplex2, a, noise.Octaves{}))
+}
@@ -0,0 +1,105 @@
+package physics2d
+
+import (
+    "github.com/camry/fp"
+)
+
+// Body A rigid body. Position is the center of mass in world coordinates and Angle the rotation in radians
+// counter-clockwise. The exported fields may be changed between steps.
+type Body struct {
+    Position        fp.F64Vec2
+    Angle           fp.F64
+    Velocity        fp.F64Vec2
+    AngularVelocity fp.F64
+    // Friction Coulomb friction coefficient. A contact uses the geometric mean of both bodies.
+    Friction fp.F64
+    // Restitution Bounciness in [0, 1]. A contact uses the larger value of both bodies.
+    Restitution fp.F64
+
+    shape      Shape
+    mass       fp.F64
+    invMass    fp.F64
+    inertia    fp.F64
+    invInertia fp.F64
+    force      fp.F64Vec2
+    torque     fp.F64
+    xf         transform
+}
+
+// NewBody Returns a body at rest at the origin with mass and inertia computed from the shape and density.
+// A density of zero creates a static body, which never moves and only collides with dynamic bodies.
+func NewBody(shape Shape, density fp.F64) *Body {
+    b := &Body{shape: shape, Friction: fp.F64Ratio10(3)}
+    if density.Raw > 0 {
+        b.mass, b.inertia = shape.massData(density)
+        if b.mass.Raw > 0 {
+            b.invMass = fp.F64One.DivPrecise(b.mass)
+        }
+        if b.inertia.Raw > 0 {
+            b.invInertia = fp.F64One.DivPrecise(b.inertia)
+        }
+    }
+    b.updateTransform()
+    return b
+}
+
+func (b *Body) Shape() Shape {
+    return b.shape
+}
+
+func (b *Body) Mass() fp.F64 {
+    return b.mass
+}
+
+// Inertia Returns the rotational inertia about the center of mass.
+func (b *Body) Inertia() fp.F64 {
+    return b.inertia
+}
+
+// IsStatic Reports whether the body has no mass and never moves.
+func (b *Body) IsStatic() bool {
+    return b.invMass.Raw == 0
+}
+
+// ApplyForce Applies a force at a point in world coordinates until the next step.
+func (b *Body) ApplyForce(force, point fp.F64Vec2) {
+    b.force = b.force.Add(force)
+    b.torque = b.torque.Add(cross(point.Sub(b.Position), force))
+}
+
+// ApplyForceToCenter Applies a force at the center of mass until the next step.
+func (b *Body) ApplyForceToCenter(force fp.F64Vec2) {
+    b.force = b.force.Add(force)
+}
+
+// ApplyTorque Applies a torque until the next step.
+func (b *Body) ApplyTorque(torque fp.F64) {
+    b.torque = b.torque.Add(torque)
+}
+
+// ApplyImpulse Changes the velocities immediately as if the impulse was applied at a point in world
+// coordinates.
+func (b *Body) ApplyImpulse(impulse, point fp.F64Vec2) {
+    b.Velocity = b.Velocity.Add(impulse.MulF64(b.invMass))
+    b.AngularVelocity = b.AngularVelocity.Add(b.invInertia.Mul(cross(point.Sub(b.Position), impulse)))
+}
+
+// WorldPoint Returns the world coordinates of a point given relative to the body.
+func (b *Body) WorldPoint(local fp.F64Vec2) fp.F64Vec2 {
+    return transform{p: b.Position, r: rotationFromAngle(b.Angle)}.apply(local)
+}
+
+// AABB Returns the bounding box of the body's shape in world coordinates.
+func (b *Body) AABB() fp.F64AABB2 {
+    return b.shape.aabb(transform{p: b.Position, r: rotationFromAngle(b.Angle)})
+}
+
+// updateTransform Caches the transform for the current position and angle.
+func (b *Body) updateTransform() {
+    b.xf = transform{p: b.Position, r: rotationFromAngle(b.Angle)}
+}
+
+// velocityAt Returns the velocity of the point at r relative to the center of mass.
+func (b *Body) velocityAt(r fp.F64Vec2) fp.F64Vec2 {
+    return b.Velocity.Add(crossSV(b.AngularVelocity, r))
+}
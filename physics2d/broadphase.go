@@ -0,0 +1,113 @@
+package physics2d
+
+import (
+    "sort"
+
+    "github.com/camry/fp"
+)
+
+// maxCellsPerBody Bodies covering more cells than this, such as the ground, are tested against every other
+// body instead of being inserted into each cell.
+const maxCellsPerBody = 64
+
+// cellEntry A body overlapping the grid cell x, y.
+type cellEntry struct {
+    x, y int64
+    body int
+}
+
+// pair Two body indices with a < b.
+type pair struct {
+    a, b int
+}
+
+// lessPair Orders pairs by a and then b.
+func lessPair(p, q pair) bool {
+    if p.a != q.a {
+        return p.a < q.a
+    }
+    return p.b < q.b
+}
+
+// spatialHash Uniform grid broad phase. Instead of hashing into buckets, it sorts the cell entries, which
+// gives the same pair order on every run without depending on map iteration.
+type spatialHash struct {
+    entries []cellEntry
+    pairs   []pair
+    large   []int
+}
+
+// findPairs Returns the pairs of bodies whose bounding boxes overlap and of which at least one is dynamic,
+// sorted by a and then b. boxes holds the bounding box of each body.
+func (h *spatialHash) findPairs(bodies []*Body, boxes []fp.F64AABB2, cellSize fp.F64) []pair {
+    h.entries, h.pairs, h.large = h.entries[:0], h.pairs[:0], h.large[:0]
+    for i, box := range boxes {
+        x0, y0 := cellOf(box.Min.X(), cellSize), cellOf(box.Min.Y(), cellSize)
+        x1, y1 := cellOf(box.Max.X(), cellSize), cellOf(box.Max.Y(), cellSize)
+        if (x1-x0+1)*(y1-y0+1) > maxCellsPerBody {
+            h.large = append(h.large, i)
+            continue
+        }
+        for y := y0; y <= y1; y++ {
+            for x := x0; x <= x1; x++ {
+                h.entries = append(h.entries, cellEntry{x: x, y: y, body: i})
+            }
+        }
+    }
+    sort.Slice(h.entries, func(i, j int) bool {
+        ei, ej := h.entries[i], h.entries[j]
+        if ei.x != ej.x {
+            return ei.x < ej.x
+        }
+        if ei.y != ej.y {
+            return ei.y < ej.y
+        }
+        return ei.body < ej.body
+    })
+
+    add := func(a, b int) {
+        if a > b {
+            a, b = b, a
+        }
+        if (bodies[a].IsStatic() && bodies[b].IsStatic()) || !boxes[a].Overlaps(boxes[b]) {
+            return
+        }
+        h.pairs = append(h.pairs, pair{a: a, b: b})
+    }
+    for start := 0; start < len(h.entries); {
+        end := start + 1
+        for end < len(h.entries) && h.entries[end].x == h.entries[start].x && h.entries[end].y == h.entries[start].y {
+            end++
+        }
+        for i := start; i < end; i++ {
+            for j := i + 1; j < end; j++ {
+                add(h.entries[i].body, h.entries[j].body)
+            }
+        }
+        start = end
+    }
+    for _, i := range h.large {
+        for j := range bodies {
+            if j != i {
+                add(i, j)
+            }
+        }
+    }
+
+    // Bodies sharing several cells, and large bodies, produce the same pair more than once.
+    sort.Slice(h.pairs, func(i, j int) bool { return lessPair(h.pairs[i], h.pairs[j]) })
+    n := 0
+    for i, p := range h.pairs {
+        if i == 0 || p != h.pairs[n-1] {
+            h.pairs[n] = p
+            n++
+        }
+    }
+    h.pairs = h.pairs[:n]
+    return h.pairs
+}
+
+// cellOf Returns the index of the grid cell containing the coordinate v.
+func cellOf(v, cellSize fp.F64) int64 {
+    return int64(v.DivPrecise(cellSize).FloorToInt())
+}
@@ -0,0 +1,197 @@
+package physics2d
+
+import (
+    "github.com/camry/fp"
+)
+
+// manifold Up to two contact points between two shapes, with the normal pointing from the first shape to
+// the second and the penetration depth at each point. The ids name the features that produced each point,
+// so that the solver can recognize the point in the next step.
+type manifold struct {
+    normal fp.F64Vec2
+    points [2]fp.F64Vec2
+    depths [2]fp.F64
+    ids    [2]uint32
+    count  int
+}
+
+// collide Returns the contacts between the shapes of a and b, with the normal from a to b.
+func collide(a, b *Body) (m manifold, ok bool) {
+    switch sa := a.shape.(type) {
+    case *Circle:
+        switch sb := b.shape.(type) {
+        case *Circle:
+            return collideCircles(sa, a.xf, sb, b.xf)
+        case *Polygon:
+            m, ok = collidePolygonCircle(sb, b.xf, sa, a.xf)
+            m.normal = m.normal.Negate()
+            return m, ok
+        }
+    case *Polygon:
+        switch sb := b.shape.(type) {
+        case *Circle:
+            return collidePolygonCircle(sa, a.xf, sb, b.xf)
+        case *Polygon:
+            return collidePolygons(sa, a.xf, sb, b.xf)
+        }
+    }
+    return manifold{}, false
+}
+
+func collideCircles(a *Circle, xfA transform, b *Circle, xfB transform) (manifold, bool) {
+    r := a.Radius.Add(b.Radius)
+    d := xfB.p.Sub(xfA.p)
+    if d.LengthSqr().GT(r.Mul(r)) {
+        return manifold{}, false
+    }
+    n, dist := normalize(d)
+    if dist.Raw == 0 {
+        n = fp.F64Vec2AxisX // concentric, push apart along x
+    }
+    m := manifold{normal: n, count: 1}
+    m.points[0] = xfA.p.Add(n.MulF64(a.Radius))
+    m.depths[0] = r.Sub(dist)
+    return m, true
+}
+
+// collidePolygonCircle Returns the contact between a polygon and a circle, with the normal from the polygon
+// to the circle.
+func collidePolygonCircle(a *Polygon, xfA transform, b *Circle, xfB transform) (manifold, bool) {
+    // Work in the frame of the polygon.
+    c := xfA.applyInv(xfB.p)
+    face, sep := 0, fp.F64MinValue
+    for i, n := range a.normals {
+        if s := n.Dot(c.Sub(a.vertices[i])); s.GT(sep) {
+            face, sep = i, s
+        }
+    }
+    if sep.GT(b.Radius) {
+        return manifold{}, false
+    }
+
+    v1, v2 := a.vertices[face], a.vertices[(face+1)%len(a.vertices)]
+    var n fp.F64Vec2
+    var dist fp.F64
+    switch {
+    case sep.Raw <= 0:
+        // The center is inside the polygon.
+        n, dist = a.normals[face], sep
+    case c.Sub(v1).Dot(v2.Sub(v1)).Raw <= 0:
+        n, dist = normalize(c.Sub(v1))
+    case c.Sub(v2).Dot(v1.Sub(v2)).Raw <= 0:
+        n, dist = normalize(c.Sub(v2))
+    default:
+        n, dist = a.normals[face], sep
+    }
+    if dist.GT(b.Radius) {
+        return manifold{}, false
+    }
+    n = xfA.r.apply(n)
+    m := manifold{normal: n, count: 1}
+    m.points[0] = xfB.p.Sub(n.MulF64(b.Radius))
+    m.depths[0] = b.Radius.Sub(dist)
+    m.ids[0] = uint32(face)
+    return m, true
+}
+
+// collidePolygons Returns the contacts between two convex polygons using the separating axis theorem, with
+// the incident edge clipped against the side planes of the reference edge.
+func collidePolygons(a *Polygon, xfA transform, b *Polygon, xfB transform) (manifold, bool) {
+    edgeA, sepA := maxSeparation(a, xfA, b, xfB)
+    if sepA.Raw > 0 {
+        return manifold{}, false
+    }
+    edgeB, sepB := maxSeparation(b, xfB, a, xfA)
+    if sepB.Raw > 0 {
+        return manifold{}, false
+    }
+
+    // Prefer a as the reference unless b separates clearly better, so that the choice doesn't flicker.
+    ref, xfRef, inc, xfInc, edge, flip := a, xfA, b, xfB, edgeA, false
+    if sepB.GT(sepA.Add(linearSlop.Div2())) {
+        ref, xfRef, inc, xfInc, edge, flip = b, xfB, a, xfA, edgeB, true
+    }
+
+    // The incident edge is the one whose normal is most anti-parallel to the reference normal.
+    refN := xfRef.r.apply(ref.normals[edge])
+    incEdge, minDot := 0, fp.F64MaxValue
+    for i, n := range inc.normals {
+        if d := xfInc.r.apply(n).Dot(refN); d.LT(minDot) {
+            incEdge, minDot = i, d
+        }
+    }
+    incident := [2]fp.F64Vec2{
+        xfInc.apply(inc.vertices[incEdge]),
+        xfInc.apply(inc.vertices[(incEdge+1)%len(inc.vertices)]),
+    }
+
+    v1 := xfRef.apply(ref.vertices[edge])
+    v2 := xfRef.apply(ref.vertices[(edge+1)%len(ref.vertices)])
+    tangent, _ := normalize(v2.Sub(v1))
+    clipped, n := clipSegment(incident, tangent.Negate(), tangent.Negate().Dot(v1))
+    if n < 2 {
+        return manifold{}, false
+    }
+    clipped, n = clipSegment(clipped, tangent, tangent.Dot(v2))
+    if n < 2 {
+        return manifold{}, false
+    }
+
+    front := refN.Dot(v1)
+    m := manifold{normal: refN}
+    id := uint32(edge)<<16 | uint32(incEdge)<<1
+    if flip {
+        m.normal = refN.Negate()
+        id |= 1 << 31
+    }
+    for i, p := range clipped {
+        if sep := refN.Dot(p).Sub(front); sep.Raw <= 0 {
+            m.points[m.count] = p
+            m.depths[m.count] = sep.Negate()
+            m.ids[m.count] = id | uint32(i)
+            m.count++
+        }
+    }
+    return m, m.count > 0
+}
+
+// maxSeparation Returns the edge of a whose normal separates b the most, and that separation, which is
+// positive when the polygons don't overlap.
+func maxSeparation(a *Polygon, xfA transform, b *Polygon, xfB transform) (int, fp.F64) {
+    best, bestSep := 0, fp.F64MinValue
+    for i, ln := range a.normals {
+        n := xfA.r.apply(ln)
+        v := xfA.apply(a.vertices[i])
+        sep := fp.F64MaxValue
+        for _, w := range b.vertices {
+            if s := n.Dot(xfB.apply(w).Sub(v)); s.LT(sep) {
+                sep = s
+            }
+        }
+        if sep.GT(bestSep) {
+            best, bestSep = i, sep
+        }
+    }
+    return best, bestSep
+}
+
+// clipSegment Returns the part of the segment where n.Dot(p) <= offset, and how many of its two points
+// remain.
+func clipSegment(in [2]fp.F64Vec2, n fp.F64Vec2, offset fp.F64) (out [2]fp.F64Vec2, count int) {
+    d0, d1 := n.Dot(in[0]).Sub(offset), n.Dot(in[1]).Sub(offset)
+    if d0.Raw <= 0 {
+        out[count] = in[0]
+        count++
+    }
+    if d1.Raw <= 0 {
+        out[count] = in[1]
+        count++
+    }
+    if count < 2 && (d0.Raw < 0) != (d1.Raw < 0) {
+        // The ends are on opposite sides, keep the crossing point.
+        t := d0.DivPrecise(d0.Sub(d1))
+        out[count] = in[0].Add(in[1].Sub(in[0]).MulF64(t))
+        count++
+    }
+    return out, count
+}
@@ -0,0 +1,67 @@
+package physics2d
+
+import (
+    "github.com/camry/fp"
+)
+
+var (
+    // linearSlop Penetration allowed before the solver pushes bodies apart, which keeps contacts stable.
+    linearSlop = fp.F64Ratio(1, 200)
+    // baumgarte Fraction of the remaining penetration removed per step.
+    baumgarte = fp.F64Ratio(1, 5)
+    // restitutionThreshold Approach speed below which collisions are treated as inelastic.
+    restitutionThreshold = fp.F64One
+)
+
+// rotation Cosine and sine of an angle.
+type rotation struct {
+    c fp.F64
+    s fp.F64
+}
+
+func rotationFromAngle(angle fp.F64) rotation {
+    return rotation{c: angle.Cos(), s: angle.Sin()}
+}
+
+// apply Rotates v by the angle.
+func (r rotation) apply(v fp.F64Vec2) fp.F64Vec2 {
+    return fp.F64Vec2FromF64(r.c.Mul(v.X()).Sub(r.s.Mul(v.Y())), r.s.Mul(v.X()).Add(r.c.Mul(v.Y())))
+}
+
+// applyInv Rotates v by the negated angle.
+func (r rotation) applyInv(v fp.F64Vec2) fp.F64Vec2 {
+    return fp.F64Vec2FromF64(r.c.Mul(v.X()).Add(r.s.Mul(v.Y())), r.c.Mul(v.Y()).Sub(r.s.Mul(v.X())))
+}
+
+// transform Position and rotation of a body, mapping local to world coordinates.
+type transform struct {
+    p fp.F64Vec2
+    r rotation
+}
+
+func (xf transform) apply(v fp.F64Vec2) fp.F64Vec2 {
+    return xf.r.apply(v).Add(xf.p)
+}
+
+func (xf transform) applyInv(v fp.F64Vec2) fp.F64Vec2 {
+    return xf.r.applyInv(v.Sub(xf.p))
+}
+
+// cross Returns the z component of the 3D cross product of a and b.
+func cross(a, b fp.F64Vec2) fp.F64 {
+    return a.X().Mul(b.Y()).Sub(a.Y().Mul(b.X()))
+}
+
+// crossSV Returns the cross product of the z axis scaled by s with v.
+func crossSV(s fp.F64, v fp.F64Vec2) fp.F64Vec2 {
+    return fp.F64Vec2FromF64(s.Mul(v.Y()).Negate(), s.Mul(v.X()))
+}
+
+// normalize Returns v scaled to unit length and its original length, or zeros when v is zero.
+func normalize(v fp.F64Vec2) (fp.F64Vec2, fp.F64) {
+    l := v.LengthSqr().SqrtPrecise()
+    if l.Raw == 0 {
+        return fp.F64Vec2Zero, fp.F64Zero
+    }
+    return v.DivPreciseF64(l), l
+}
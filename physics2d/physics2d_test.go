@@ -0,0 +1,227 @@
+package physics2d_test
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/physics2d"
+    "github.com/camry/fp/rand"
+)
+
+var (
+    dt      = fp.F64Ratio(1, 60)
+    gravity = fp.F64Vec2FromInt32(0, -10)
+)
+
+func f(v float64) fp.F64 {
+    return fp.F64FromFloat64(v)
+}
+
+func vec(x, y float64) fp.F64Vec2 {
+    return fp.F64Vec2FromFloat64(x, y)
+}
+
+// newGround Returns a world with a static floor whose top is at y = 0.
+func newGround() *physics2d.World {
+    w := physics2d.NewWorld(gravity)
+    ground := physics2d.NewBody(physics2d.NewBox(f(50), f(0.5)), fp.F64Zero)
+    ground.Position = vec(0, -0.5)
+    w.Add(ground)
+    return w
+}
+
+func step(w *physics2d.World, n int) {
+    for i := 0; i < n; i++ {
+        w.Step(dt)
+    }
+}
+
+func TestMassData(t *testing.T) {
+    box := physics2d.NewBody(physics2d.NewBox(fp.F64One, fp.F64Two), fp.F64One)
+    assert.Equal(t, fp.F64FromInt32(8), box.Mass())
+    assert.InDelta(t, 8*(4+16)/12.0, box.Inertia().Float64(), 1e-8)
+    assert.False(t, box.IsStatic())
+
+    circle := physics2d.NewBody(physics2d.NewCircle(fp.F64Two), fp.F64One)
+    assert.InDelta(t, 4*3.14159265, circle.Mass().Float64(), 1e-6)
+    assert.InDelta(t, 4*3.14159265*2, circle.Inertia().Float64(), 1e-6)
+
+    static := physics2d.NewBody(physics2d.NewCircle(fp.F64One), fp.F64Zero)
+    assert.True(t, static.IsStatic())
+    assert.Equal(t, fp.F64Zero, static.Mass())
+
+    // A right triangle, moved so that its centroid is at the origin.
+    tri, ok := physics2d.NewPolygon([]fp.F64Vec2{vec(0, 0), vec(3, 0), vec(0, 3), vec(1, 1)})
+    assert.True(t, ok)
+    assert.Len(t, tri.Vertices(), 3)
+    assert.Equal(t, vec(-1, -1), tri.Vertices()[0])
+    assert.InDelta(t, 4.5, physics2d.NewBody(tri, fp.F64One).Mass().Float64(), 1e-8)
+    _, ok = physics2d.NewPolygon([]fp.F64Vec2{vec(0, 0), vec(1, 1), vec(2, 2)})
+    assert.False(t, ok)
+}
+
+func TestFreeFall(t *testing.T) {
+    w := physics2d.NewWorld(gravity)
+    b := physics2d.NewBody(physics2d.NewCircle(fp.F64One), fp.F64One)
+    b.Position = vec(0, 100)
+    w.Add(b)
+    step(w, 60)
+    assert.InDelta(t, -10, b.Velocity.Y().Float64(), 1e-6)
+    // Semi-implicit Euler falls 10 * (1 + 2 + ... + 60) / 60^2.
+    assert.InDelta(t, 100-10*61/120.0, b.Position.Y().Float64(), 1e-6)
+    assert.Equal(t, fp.F64Zero, b.Velocity.X())
+    assert.Equal(t, fp.F64Zero, b.Angle)
+
+    b.ApplyForceToCenter(fp.F64Vec2FromF64(b.Mass().Mul(fp.F64FromInt32(60)), fp.F64Zero))
+    w.Step(dt)
+    assert.InDelta(t, 1, b.Velocity.X().Float64(), 1e-6)
+    w.Step(dt)
+    assert.InDelta(t, 1, b.Velocity.X().Float64(), 1e-6)
+
+    h := w.Hash()
+    w.Step(fp.F64Zero)
+    assert.Equal(t, h, w.Hash())
+    assert.True(t, w.Remove(b))
+    assert.False(t, w.Remove(b))
+    assert.Empty(t, w.Bodies())
+    assert.NotEqual(t, h, w.Hash())
+}
+
+func TestResting(t *testing.T) {
+    w := newGround()
+    ball := physics2d.NewBody(physics2d.NewCircle(f(0.5)), fp.F64One)
+    ball.Position = vec(-3, 2)
+    box := physics2d.NewBody(physics2d.NewBox(f(0.5), f(0.5)), fp.F64One)
+    box.Position = vec(3, 2)
+    w.Add(ball)
+    w.Add(box)
+    step(w, 180)
+    for _, b := range []*physics2d.Body{ball, box} {
+        assert.InDelta(t, 0.5, b.Position.Y().Float64(), 0.01)
+        assert.InDelta(t, 0, b.Velocity.Length().Float64(), 0.01)
+    }
+    assert.InDelta(t, 0, box.Angle.Float64(), 1e-3)
+}
+
+func TestRestitution(t *testing.T) {
+    w := newGround()
+    ball := physics2d.NewBody(physics2d.NewCircle(f(0.5)), fp.F64One)
+    ball.Position = vec(0, 5.5)
+    ball.Restitution = fp.F64One
+    w.Add(ball)
+    top := fp.F64Zero
+    for i := 0; i < 120; i++ {
+        w.Step(dt)
+        if i > 70 {
+            top = fp.F64Max(top, ball.Position.Y())
+        }
+    }
+    // The ball hits the ground after 1 s and climbs most of the way back.
+    assert.Greater(t, top.Float64(), 4.5)
+}
+
+func TestFriction(t *testing.T) {
+    w := newGround()
+    box := physics2d.NewBody(physics2d.NewBox(f(0.5), f(0.5)), fp.F64One)
+    box.Position = vec(0, 0.5)
+    box.Velocity = vec(5, 0)
+    box.Friction = f(0.5)
+    w.Bodies()[0].Friction = f(0.5)
+    w.Add(box)
+    step(w, 120)
+    // Sliding stops after v^2 / (2 mu g) = 2.5 m.
+    assert.InDelta(t, 0, box.Velocity.X().Float64(), 0.01)
+    assert.InDelta(t, 2.5, box.Position.X().Float64(), 0.2)
+
+    box.Position, box.Velocity, box.Friction = vec(0, 0.5), vec(5, 0), fp.F64Zero
+    step(w, 60)
+    assert.InDelta(t, 5, box.Velocity.X().Float64(), 0.01)
+}
+
+func TestStack(t *testing.T) {
+    w := newGround()
+    var boxes []*physics2d.Body
+    for i := 0; i < 5; i++ {
+        b := physics2d.NewBody(physics2d.NewBox(f(0.5), f(0.5)), fp.F64One)
+        b.Position = vec(0, 0.5+1.01*float64(i))
+        w.Add(b)
+        boxes = append(boxes, b)
+    }
+    step(w, 300)
+    for i, b := range boxes {
+        assert.InDelta(t, 0, b.Position.X().Float64(), 0.05, "box %d", i)
+        assert.InDelta(t, 0.5+float64(i), b.Position.Y().Float64(), 0.1, "box %d", i)
+        assert.InDelta(t, 0, b.Angle.Float64(), 0.05, "box %d", i)
+    }
+}
+
+// newScene Returns a world with a pile of random circles, boxes and polygons above the ground, between two
+// walls.
+func newScene() *physics2d.World {
+    w := newGround()
+    for _, x := range []float64{-10, 10} {
+        wall := physics2d.NewBody(physics2d.NewBox(f(0.5), f(10)), fp.F64Zero)
+        wall.Position = vec(x, 10)
+        w.Add(wall)
+    }
+    r := rand.New(17)
+    for i := 0; i < 60; i++ {
+        var shape physics2d.Shape
+        size := r.F64Range(f(0.25), f(0.75))
+        switch i % 3 {
+        case 0:
+            shape = physics2d.NewCircle(size)
+        case 1:
+            shape = physics2d.NewBox(size, r.F64Range(f(0.25), f(0.75)))
+        default:
+            points := make([]fp.F64Vec2, 6)
+            for j := range points {
+                points[j] = r.F64Vec2InDisk().MulF64(size)
+            }
+            poly, ok := physics2d.NewPolygon(points)
+            if !ok {
+                continue
+            }
+            shape = poly
+        }
+        b := physics2d.NewBody(shape, fp.F64One)
+        b.Position = fp.F64Vec2FromF64(r.F64Range(f(-8), f(8)), f(2).Add(fp.F64FromInt32(int32(i)).Div2()))
+        b.Angle = r.F64Range(fp.F64Zero, fp.F64Pi)
+        b.Restitution = r.F64Range(fp.F64Zero, f(0.5))
+        w.Add(b)
+    }
+    return w
+}
+
+func TestReplayHash(t *testing.T) {
+    // Pinned hashes after 1, 2 and 4 seconds. Any change to them breaks lockstep replays, so they may only
+    // change together with a deliberate change to the simulation.
+    want := map[int]uint64{60: 0xcc085bd3028055cb, 120: 0x7e16ee40a944971f, 240: 0xd2b5085989e8f1b1}
+    run := func() map[int]uint64 {
+        w := newScene()
+        got := map[int]uint64{}
+        for i := 1; i <= 240; i++ {
+            w.Step(dt)
+            if _, ok := want[i]; ok {
+                got[i] = w.Hash()
+            }
+        }
+        return got
+    }
+    first := run()
+    assert.Equal(t, first, run())
+    assert.Equal(t, want, first)
+
+    // Everything settles on the ground between the walls.
+    w := newScene()
+    step(w, 600)
+    for _, b := range w.Bodies() {
+        if b.IsStatic() {
+            continue
+        }
+        assert.True(t, b.Position.Y().Float64() > 0 && b.Position.X().Abs().Float64() < 9.5, "%v", b.Position)
+        assert.InDelta(t, 0, b.Velocity.Length().Float64(), 0.1, "%v", b.Velocity)
+    }
+}
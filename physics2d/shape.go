@@ -0,0 +1,99 @@
+package physics2d
+
+import (
+    "github.com/camry/fp"
+)
+
+// Shape The collision geometry of a body, in local coordinates centered on the body's center of mass.
+// Shapes are immutable and may be shared between bodies. The implementations are Circle and Polygon.
+type Shape interface {
+    // massData Returns the mass and the rotational inertia about the origin for the density.
+    massData(density fp.F64) (mass, inertia fp.F64)
+    // aabb Returns the bounding box of the shape placed by xf.
+    aabb(xf transform) fp.F64AABB2
+}
+
+// Circle A disk around the body's center.
+type Circle struct {
+    Radius fp.F64
+}
+
+// NewCircle Returns a circle with the radius.
+func NewCircle(radius fp.F64) *Circle {
+    return &Circle{Radius: radius}
+}
+
+func (c *Circle) massData(density fp.F64) (mass, inertia fp.F64) {
+    rr := c.Radius.Mul(c.Radius)
+    mass = density.Mul(fp.F64Pi).Mul(rr)
+    return mass, mass.Mul(rr).Div2()
+}
+
+func (c *Circle) aabb(xf transform) fp.F64AABB2 {
+    return fp.F64AABB2FromCenterExtents(xf.p, fp.F64Vec2FromF64(c.Radius, c.Radius))
+}
+
+// Polygon A convex polygon with counter-clockwise vertices and the outward unit normals of its edges,
+// where edge i runs from vertex i to vertex i + 1.
+type Polygon struct {
+    vertices []fp.F64Vec2
+    normals  []fp.F64Vec2
+}
+
+// NewBox Returns a rectangle with the half width and half height, centered on the origin.
+func NewBox(halfWidth, halfHeight fp.F64) *Polygon {
+    return newPolygon([]fp.F64Vec2{
+        fp.F64Vec2FromF64(halfWidth.Negate(), halfHeight.Negate()),
+        fp.F64Vec2FromF64(halfWidth, halfHeight.Negate()),
+        fp.F64Vec2FromF64(halfWidth, halfHeight),
+        fp.F64Vec2FromF64(halfWidth.Negate(), halfHeight),
+    })
+}
+
+// NewPolygon Returns the convex hull of the points, moved so that its centroid is at the origin. ok is
+// false when the points span no area.
+func NewPolygon(points []fp.F64Vec2) (p *Polygon, ok bool) {
+    hull := fp.F64ConvexHull(points)
+    if len(hull) < 3 {
+        return nil, false
+    }
+    c := hull.Centroid()
+    for i := range hull {
+        hull[i] = hull[i].Sub(c)
+    }
+    return newPolygon(hull), true
+}
+
+func newPolygon(vertices []fp.F64Vec2) *Polygon {
+    p := &Polygon{vertices: vertices, normals: make([]fp.F64Vec2, len(vertices))}
+    for i, v := range vertices {
+        e := vertices[(i+1)%len(vertices)].Sub(v)
+        p.normals[i], _ = normalize(fp.F64Vec2FromF64(e.Y(), e.X().Negate()))
+    }
+    return p
+}
+
+// Vertices Returns the vertices counter-clockwise. The slice must not be modified.
+func (p *Polygon) Vertices() []fp.F64Vec2 {
+    return p.vertices
+}
+
+func (p *Polygon) massData(density fp.F64) (mass, inertia fp.F64) {
+    // Sum the triangles fanning out from the origin, which is inside the polygon.
+    var area, i fp.F64
+    for k, e1 := range p.vertices {
+        e2 := p.vertices[(k+1)%len(p.vertices)]
+        d := cross(e1, e2)
+        area = area.Add(d.Div2())
+        i = i.Add(d.Mul(e1.Dot(e1).Add(e1.Dot(e2)).Add(e2.Dot(e2))))
+    }
+    return density.Mul(area), density.Mul(i).DivPrecise(fp.F64FromInt32(12))
+}
+
+func (p *Polygon) aabb(xf transform) fp.F64AABB2 {
+    b := fp.F64AABB2Empty
+    for _, v := range p.vertices {
+        b = b.ExpandToInclude(xf.apply(v))
+    }
+    return b
+}
@@ -0,0 +1,127 @@
+package physics2d
+
+import (
+    "github.com/camry/fp"
+)
+
+// contactPoint A contact point with the solver state of its normal and friction constraints.
+type contactPoint struct {
+    id             uint32
+    rA, rB         fp.F64Vec2 // from the centers of mass to the point
+    normalMass     fp.F64
+    tangentMass    fp.F64
+    bias           fp.F64 // target separating velocity for position correction and restitution
+    bounce         bool   // whether the bias includes restitution
+    normalImpulse  fp.F64
+    tangentImpulse fp.F64
+}
+
+// contact The non-penetration and friction constraints between two touching bodies, solved with
+// sequential impulses. The impulses accumulated for a point carry over to the next step while its
+// features still touch, so that resting contacts start close to their solution.
+type contact struct {
+    pair        pair
+    a, b        *Body
+    normal      fp.F64Vec2
+    points      [2]contactPoint
+    count       int
+    friction    fp.F64
+    restitution fp.F64
+}
+
+func newContact(p pair, a, b *Body, m manifold, invDt fp.F64) contact {
+    c := contact{
+        pair:        p,
+        a:           a,
+        b:           b,
+        normal:      m.normal,
+        count:       m.count,
+        friction:    a.Friction.Mul(b.Friction).SqrtPrecise(),
+        restitution: fp.F64Max(a.Restitution, b.Restitution),
+    }
+    tangent := fp.F64Vec2FromF64(m.normal.Y(), m.normal.X().Negate())
+    for i := 0; i < m.count; i++ {
+        cp := &c.points[i]
+        cp.id = m.ids[i]
+        cp.rA, cp.rB = m.points[i].Sub(a.Position), m.points[i].Sub(b.Position)
+        cp.normalMass = c.effectiveMass(cp, m.normal)
+        cp.tangentMass = c.effectiveMass(cp, tangent)
+
+        // Push apart the penetration beyond the slop over a few steps, and bounce off fast approaches.
+        cp.bias = baumgarte.Mul(invDt).Mul(fp.F64Max(m.depths[i].Sub(linearSlop), fp.F64Zero))
+        vn := b.velocityAt(cp.rB).Sub(a.velocityAt(cp.rA)).Dot(m.normal)
+        if vn.LT(restitutionThreshold.Negate()) && c.restitution.Raw > 0 {
+            cp.bias = fp.F64Max(cp.bias, c.restitution.Mul(vn).Negate())
+            cp.bounce = true
+        }
+    }
+    return c
+}
+
+// warmStart Takes over the impulses of the points of old with the same features, and applies them. Points
+// that bounce in either step start from zero, as the impulse of an impact would otherwise be applied again.
+func (c *contact) warmStart(old *contact) {
+    tangent := fp.F64Vec2FromF64(c.normal.Y(), c.normal.X().Negate())
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        if cp.bounce {
+            continue
+        }
+        for j := 0; j < old.count; j++ {
+            if op := &old.points[j]; op.id == cp.id && !op.bounce {
+                cp.normalImpulse, cp.tangentImpulse = op.normalImpulse, op.tangentImpulse
+                c.apply(cp, c.normal.MulF64(cp.normalImpulse).Add(tangent.MulF64(cp.tangentImpulse)))
+                break
+            }
+        }
+    }
+}
+
+// effectiveMass Returns the inverse of the mass the constraint along dir sees at the point.
+func (c *contact) effectiveMass(cp *contactPoint, dir fp.F64Vec2) fp.F64 {
+    ra, rb := cross(cp.rA, dir), cross(cp.rB, dir)
+    k := c.a.invMass.Add(c.b.invMass).
+        Add(c.a.invInertia.Mul(ra.Mul(ra))).
+        Add(c.b.invInertia.Mul(rb.Mul(rb)))
+    if k.Raw <= 0 {
+        return fp.F64Zero
+    }
+    return fp.F64One.DivPrecise(k)
+}
+
+// solve Applies one round of impulses, normal constraints before friction, whose limit depends on them.
+func (c *contact) solve() {
+    tangent := fp.F64Vec2FromF64(c.normal.Y(), c.normal.X().Negate())
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        vn := c.relativeVelocity(cp).Dot(c.normal)
+        impulse := cp.normalMass.Mul(cp.bias.Sub(vn))
+        // Clamp the accumulated impulse, so that the contact can only push.
+        total := fp.F64Max(cp.normalImpulse.Add(impulse), fp.F64Zero)
+        impulse, cp.normalImpulse = total.Sub(cp.normalImpulse), total
+        c.apply(cp, c.normal.MulF64(impulse))
+    }
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        vt := c.relativeVelocity(cp).Dot(tangent)
+        impulse := cp.tangentMass.Mul(vt.Negate())
+        limit := c.friction.Mul(cp.normalImpulse)
+        total := cp.tangentImpulse.Add(impulse).Clamp(limit.Negate(), limit)
+        impulse, cp.tangentImpulse = total.Sub(cp.tangentImpulse), total
+        c.apply(cp, tangent.MulF64(impulse))
+    }
+}
+
+// relativeVelocity Returns the velocity of b relative to a at the point.
+func (c *contact) relativeVelocity(cp *contactPoint) fp.F64Vec2 {
+    return c.b.velocityAt(cp.rB).Sub(c.a.velocityAt(cp.rA))
+}
+
+// apply Applies the impulse to b and its opposite to a.
+func (c *contact) apply(cp *contactPoint, impulse fp.F64Vec2) {
+    a, b := c.a, c.b
+    a.Velocity = a.Velocity.Sub(impulse.MulF64(a.invMass))
+    a.AngularVelocity = a.AngularVelocity.Sub(a.invInertia.Mul(cross(cp.rA, impulse)))
+    b.Velocity = b.Velocity.Add(impulse.MulF64(b.invMass))
+    b.AngularVelocity = b.AngularVelocity.Add(b.invInertia.Mul(cross(cp.rB, impulse)))
+}
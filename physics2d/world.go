@@ -0,0 +1,139 @@
+package physics2d
+
+import (
+    "encoding/binary"
+    "hash/fnv"
+
+    "github.com/camry/fp"
+)
+
+// Deterministic 2D rigid body physics.
+//
+// Every quantity is an F64 or F64Vec2 and every step runs in a fixed order: bodies in the order they were
+// added, broad phase pairs sorted by body index, and a fixed number of solver iterations. Given the same
+// initial state and the same sequence of Step calls, a World therefore produces bit-identical results on
+// every platform, which is what lockstep simulations need. Use World.Hash to compare states across
+// machines.
+//
+// Units are up to the caller, but the solver tolerances assume meters, kilograms and seconds, with bodies
+// between a few centimeters and a few hundred meters in size.
+
+// World A collection of bodies simulated together. It is not safe for concurrent use.
+type World struct {
+    Gravity fp.F64Vec2
+    // Iterations Number of velocity solver iterations per step. More iterations make stacks stiffer.
+    Iterations int
+    // CellSize Width of the broad phase grid cells, best set to about the size of a typical body.
+    CellSize fp.F64
+
+    bodies   []*Body
+    boxes    []fp.F64AABB2
+    contacts []contact
+    previous []contact
+    hash     spatialHash
+}
+
+// NewWorld Returns an empty world with the gravity, 10 solver iterations and 2 unit cells.
+func NewWorld(gravity fp.F64Vec2) *World {
+    return &World{Gravity: gravity, Iterations: 10, CellSize: fp.F64Two}
+}
+
+// Add Adds the body to the world. Bodies are simulated in the order they were added.
+func (w *World) Add(b *Body) {
+    w.bodies = append(w.bodies, b)
+}
+
+// Remove Removes the body, keeping the order of the others. It reports whether the body was found.
+func (w *World) Remove(b *Body) bool {
+    for i, o := range w.bodies {
+        if o == b {
+            w.bodies = append(w.bodies[:i], w.bodies[i+1:]...)
+            return true
+        }
+    }
+    return false
+}
+
+// Bodies Returns the bodies in simulation order. The slice must not be modified.
+func (w *World) Bodies() []*Body {
+    return w.bodies
+}
+
+// Step Advances the world by dt, which should be the same every step for reproducible behavior. Forces
+// applied since the previous step act during dt and are then cleared.
+func (w *World) Step(dt fp.F64) {
+    if dt.Raw <= 0 {
+        return
+    }
+    invDt := fp.F64One.DivPrecise(dt)
+
+    // Integrate forces with semi-implicit Euler.
+    for _, b := range w.bodies {
+        b.updateTransform()
+        if b.IsStatic() {
+            continue
+        }
+        acc := w.Gravity.Add(b.force.MulF64(b.invMass))
+        b.Velocity = b.Velocity.Add(acc.MulF64(dt))
+        b.AngularVelocity = b.AngularVelocity.Add(b.torque.Mul(b.invInertia).Mul(dt))
+        b.force, b.torque = fp.F64Vec2Zero, fp.F64Zero
+    }
+
+    // Find the contacts.
+    w.boxes = w.boxes[:0]
+    for _, b := range w.bodies {
+        w.boxes = append(w.boxes, b.shape.aabb(b.xf))
+    }
+    w.contacts, w.previous = w.previous[:0], w.contacts
+    for _, p := range w.hash.findPairs(w.bodies, w.boxes, w.CellSize) {
+        a, b := w.bodies[p.a], w.bodies[p.b]
+        m, ok := collide(a, b)
+        if !ok {
+            continue
+        }
+        w.contacts = append(w.contacts, newContact(p, a, b, m, invDt))
+    }
+    // Warm start only once every contact has measured its approach velocity for restitution. Both lists
+    // are sorted by pair, so the previous contact of a pair is found by merging.
+    old := 0
+    for k := range w.contacts {
+        c := &w.contacts[k]
+        for old < len(w.previous) && lessPair(w.previous[old].pair, c.pair) {
+            old++
+        }
+        if old < len(w.previous) && w.previous[old].a == c.a && w.previous[old].b == c.b {
+            c.warmStart(&w.previous[old])
+        }
+    }
+
+    // Solve the velocity constraints, then move the bodies.
+    for i := 0; i < w.Iterations; i++ {
+        for k := range w.contacts {
+            w.contacts[k].solve()
+        }
+    }
+    for _, b := range w.bodies {
+        if b.IsStatic() {
+            continue
+        }
+        b.Position = b.Position.Add(b.Velocity.MulF64(dt))
+        b.Angle = b.Angle.Add(b.AngularVelocity.Mul(dt))
+    }
+}
+
+// Hash Returns a 64-bit FNV-1a hash of the position, angle and velocities of every body in order. Equal
+// hashes on two machines mean their simulations are, with overwhelming probability, in sync.
+func (w *World) Hash() uint64 {
+    h := fnv.New64a()
+    var buf [8]byte
+    for _, b := range w.bodies {
+        for _, raw := range [...]int64{
+            b.Position.RawX, b.Position.RawY, b.Angle.Raw,
+            b.Velocity.RawX, b.Velocity.RawY, b.AngularVelocity.Raw,
+        } {
+            binary.LittleEndian.PutUint64(buf[:], uint64(raw))
+            h.Write(buf[:])
+        }
+    }
+    return h.Sum64()
+}
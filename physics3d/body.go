@@ -0,0 +1,128 @@
+package physics3d
+
+import (
+    "github.com/camry/fp"
+)
+
+// Body A rigid body. Position is the center of mass in world coordinates and Orientation the unit
+// quaternion rotating local into world coordinates. The exported fields may be changed between steps.
+type Body struct {
+    Position        fp.F64Vec3
+    Orientation     fp.F64Quat
+    Velocity        fp.F64Vec3
+    AngularVelocity fp.F64Vec3
+    // Friction Coulomb friction coefficient. A contact uses the geometric mean of both bodies.
+    Friction fp.F64
+    // Restitution Bounciness in [0, 1]. A contact uses the larger value of both bodies.
+    Restitution fp.F64
+
+    shape      Shape
+    mass       fp.F64
+    invMass    fp.F64
+    inertia    fp.F64Mat3 // local
+    invInertia fp.F64Mat3 // local
+    invWorld   fp.F64Mat3 // world, for the current orientation
+    force      fp.F64Vec3
+    torque     fp.F64Vec3
+    xf         transform
+}
+
+// NewBody Returns a body at rest at the origin with mass and inertia tensor computed from the shape and
+// density. A density of zero creates a static body, which never moves and only collides with dynamic
+// bodies.
+func NewBody(shape Shape, density fp.F64) *Body {
+    b := &Body{shape: shape, Orientation: fp.Identity, Friction: fp.F64Ratio10(5)}
+    if density.Raw > 0 {
+        var i fp.F64Vec3
+        b.mass, i = shape.massData(density)
+        b.inertia = diagonal(i.X(), i.Y(), i.Z())
+        if b.mass.Raw > 0 {
+            b.invMass = fp.F64One.DivPrecise(b.mass)
+            b.invInertia = diagonal(rcp(i.X()), rcp(i.Y()), rcp(i.Z()))
+        }
+    }
+    b.update()
+    return b
+}
+
+func (b *Body) Shape() Shape {
+    return b.shape
+}
+
+func (b *Body) Mass() fp.F64 {
+    return b.mass
+}
+
+// Inertia Returns the inertia tensor about the center of mass in local coordinates.
+func (b *Body) Inertia() fp.F64Mat3 {
+    return b.inertia
+}
+
+// IsStatic Reports whether the body has no mass and never moves.
+func (b *Body) IsStatic() bool {
+    return b.invMass.Raw == 0
+}
+
+// ApplyForce Applies a force at a point in world coordinates until the next step.
+func (b *Body) ApplyForce(force, point fp.F64Vec3) {
+    b.force = b.force.Add(force)
+    b.torque = b.torque.Add(point.Sub(b.Position).Cross(force))
+}
+
+// ApplyForceToCenter Applies a force at the center of mass until the next step.
+func (b *Body) ApplyForceToCenter(force fp.F64Vec3) {
+    b.force = b.force.Add(force)
+}
+
+// ApplyTorque Applies a torque in world coordinates until the next step.
+func (b *Body) ApplyTorque(torque fp.F64Vec3) {
+    b.torque = b.torque.Add(torque)
+}
+
+// ApplyImpulse Changes the velocities immediately as if the impulse was applied at a point in world
+// coordinates.
+func (b *Body) ApplyImpulse(impulse, point fp.F64Vec3) {
+    b.update()
+    b.applyImpulse(impulse, point.Sub(b.Position))
+}
+
+// WorldPoint Returns the world coordinates of a point given relative to the body.
+func (b *Body) WorldPoint(local fp.F64Vec3) fp.F64Vec3 {
+    return transformOf(b.Position, b.Orientation).apply(local)
+}
+
+// AABB Returns the bounding box of the body's shape in world coordinates.
+func (b *Body) AABB() fp.F64AABB3 {
+    return b.shape.aabb(transformOf(b.Position, b.Orientation))
+}
+
+// KineticEnergy Returns the translational plus rotational kinetic energy.
+func (b *Body) KineticEnergy() fp.F64 {
+    w := b.xf.rotateInv(b.AngularVelocity)
+    lin := b.mass.Mul(b.Velocity.LengthSqr())
+    return lin.Add(w.Dot(b.inertia.MulVec3(w))).Div2()
+}
+
+// update Caches the transform and world inverse inertia for the current position and orientation.
+func (b *Body) update() {
+    b.xf = transformOf(b.Position, b.Orientation)
+    b.invWorld = b.xf.r.Mul(b.invInertia).Mul(b.xf.r.Transpose())
+}
+
+// applyImpulse Applies the impulse at r relative to the center of mass.
+func (b *Body) applyImpulse(impulse, r fp.F64Vec3) {
+    b.Velocity = b.Velocity.Add(impulse.MulF64(b.invMass))
+    b.AngularVelocity = b.AngularVelocity.Add(b.invWorld.MulVec3(r.Cross(impulse)))
+}
+
+// velocityAt Returns the velocity of the point at r relative to the center of mass.
+func (b *Body) velocityAt(r fp.F64Vec3) fp.F64Vec3 {
+    return b.Velocity.Add(b.AngularVelocity.Cross(r))
+}
+
+func rcp(v fp.F64) fp.F64 {
+    if v.Raw <= 0 {
+        return fp.F64Zero
+    }
+    return fp.F64One.DivPrecise(v)
+}
@@ -0,0 +1,60 @@
+package physics3d
+
+import (
+    "sort"
+
+    "github.com/camry/fp"
+)
+
+// pair Two body indices with a < b.
+type pair struct {
+    a, b int
+}
+
+// lessPair Orders pairs by a and then b.
+func lessPair(p, q pair) bool {
+    if p.a != q.a {
+        return p.a < q.a
+    }
+    return p.b < q.b
+}
+
+// sweepAndPrune Broad phase that sorts the bounding boxes along x and only tests boxes whose x intervals
+// overlap. Ties are broken by body index, so the pair order never depends on the sort algorithm.
+type sweepAndPrune struct {
+    order []int
+    pairs []pair
+}
+
+// findPairs Returns the pairs of bodies whose bounding boxes overlap and of which at least one is dynamic,
+// sorted by a and then b. boxes holds the bounding box of each body.
+func (s *sweepAndPrune) findPairs(bodies []*Body, boxes []fp.F64AABB3) []pair {
+    s.order, s.pairs = s.order[:0], s.pairs[:0]
+    for i := range boxes {
+        s.order = append(s.order, i)
+    }
+    sort.Slice(s.order, func(i, j int) bool {
+        bi, bj := boxes[s.order[i]], boxes[s.order[j]]
+        if bi.Min.RawX != bj.Min.RawX {
+            return bi.Min.RawX < bj.Min.RawX
+        }
+        return s.order[i] < s.order[j]
+    })
+    for k, i := range s.order {
+        for _, j := range s.order[k+1:] {
+            if boxes[j].Min.RawX > boxes[i].Max.RawX {
+                break
+            }
+            if (bodies[i].IsStatic() && bodies[j].IsStatic()) || !boxes[i].Overlaps(boxes[j]) {
+                continue
+            }
+            if i < j {
+                s.pairs = append(s.pairs, pair{a: i, b: j})
+            } else {
+                s.pairs = append(s.pairs, pair{a: j, b: i})
+            }
+        }
+    }
+    sort.Slice(s.pairs, func(i, j int) bool { return lessPair(s.pairs[i], s.pairs[j]) })
+    return s.pairs
+}
@@ -0,0 +1,298 @@
+package physics3d
+
+import (
+    "github.com/camry/fp"
+)
+
+// maxPoints The most contact points between two shapes, reached by box faces clipped against each other.
+const maxPoints = 8
+
+// manifold Contact points between two shapes, with the normal pointing from the first shape to the second
+// and the penetration depth at each point. The ids name the features that produced each point, so that the
+// solver can recognize the point in the next step.
+type manifold struct {
+    normal fp.F64Vec3
+    points [maxPoints]fp.F64Vec3
+    depths [maxPoints]fp.F64
+    ids    [maxPoints]uint32
+    count  int
+}
+
+// add Adds a contact point unless the manifold is full.
+func (m *manifold) add(p fp.F64Vec3, depth fp.F64, id uint32) {
+    if m.count < maxPoints {
+        m.points[m.count], m.depths[m.count], m.ids[m.count] = p, depth, id
+        m.count++
+    }
+}
+
+// rank Orders the shape types, so that collide only handles pairs with the lower rank first.
+func rank(s Shape) int {
+    switch s.(type) {
+    case *Sphere:
+        return 0
+    case *Capsule:
+        return 1
+    default:
+        return 2
+    }
+}
+
+// collide Returns the contacts between the shapes of a and b, with the normal from a to b.
+func collide(a, b *Body) (manifold, bool) {
+    if rank(a.shape) > rank(b.shape) {
+        m, ok := collide(b, a)
+        m.normal = m.normal.Negate()
+        return m, ok
+    }
+    var m manifold
+    switch sa := a.shape.(type) {
+    case *Sphere:
+        switch sb := b.shape.(type) {
+        case *Sphere:
+            collideSpheres(&m, a.xf.p, sa.Radius, b.xf.p, sb.Radius, 0)
+        case *Capsule:
+            p0, p1 := sb.segment(b.xf)
+            _, c := fp.F64Segment3FromPoints(p0, p1).ClosestPoint(a.xf.p)
+            collideSpheres(&m, a.xf.p, sa.Radius, c, sb.Radius, 0)
+        case *Box:
+            collideSphereBox(&m, a.xf.p, sa.Radius, sb, b.xf, 0)
+        }
+    case *Capsule:
+        a0, a1 := sa.segment(a.xf)
+        switch sb := b.shape.(type) {
+        case *Capsule:
+            b0, b1 := sb.segment(b.xf)
+            _, _, ca, cb := fp.F64Segment3FromPoints(a0, a1).ClosestPoints(fp.F64Segment3FromPoints(b0, b1))
+            collideSpheres(&m, ca, sa.Radius, cb, sb.Radius, 0)
+        case *Box:
+            // Both ends, for a capsule lying on a face, and the point nearest the box center, for one
+            // crossing an edge.
+            _, c := fp.F64Segment3FromPoints(a0, a1).ClosestPoint(b.xf.p)
+            collideSphereBox(&m, a0, sa.Radius, sb, b.xf, 0)
+            collideSphereBox(&m, a1, sa.Radius, sb, b.xf, 1)
+            if c != a0 && c != a1 {
+                collideSphereBox(&m, c, sa.Radius, sb, b.xf, 2)
+            }
+        }
+    case *Box:
+        collideBoxes(&m, sa, a.xf, b.shape.(*Box), b.xf)
+    }
+    return m, m.count > 0
+}
+
+// collideSpheres Adds the contact between two spheres, halfway between their surfaces. All contacts
+// of a manifold share one normal, so a sphere pair sets it.
+func collideSpheres(m *manifold, ca fp.F64Vec3, ra fp.F64, cb fp.F64Vec3, rb fp.F64, id uint32) {
+    r := ra.Add(rb)
+    d := cb.Sub(ca)
+    if d.LengthSqr().GT(r.Mul(r)) {
+        return
+    }
+    n, dist := normalize(d)
+    if dist.Raw == 0 {
+        n = fp.F64Vec3Up // concentric, push apart vertically
+    }
+    depth := r.Sub(dist)
+    m.normal = n
+    m.add(ca.Add(n.MulF64(ra.Sub(depth.Div2()))), depth, id)
+}
+
+// collideSphereBox Adds the contact between a sphere and a box, with the normal from the sphere to the
+// box.
+func collideSphereBox(m *manifold, c fp.F64Vec3, r fp.F64, b *Box, xf transform, id uint32) {
+    local := xf.applyInv(c)
+    h := b.HalfExtents
+    closest := local.Clamp(h.Negate(), h)
+    var n fp.F64Vec3 // outward box normal, in local coordinates
+    var dist fp.F64
+    if closest != local {
+        n, dist = normalize(local.Sub(closest))
+        if dist.GT(r) {
+            return
+        }
+    } else {
+        // The center is inside the box, push it out through the nearest face.
+        axis, best := 0, fp.F64MaxValue
+        for i := 0; i < 3; i++ {
+            if d := component(h, i).Sub(component(local, i).Abs()); d.LT(best) {
+                axis, best = i, d
+            }
+        }
+        v := [3]fp.F64{}
+        v[axis] = fp.F64One
+        if component(local, axis).Raw < 0 {
+            v[axis] = fp.F64Neg1
+        }
+        n, dist = fp.F64Vec3FromF64(v[0], v[1], v[2]), best.Negate()
+        closest = local.Add(n.MulF64(best))
+    }
+    n = xf.r.MulVec3(n)
+    depth := r.Sub(dist)
+    if m.count > 0 {
+        // Capsules add several points, keep the normal of the deepest one.
+        if depth.GT(m.depths[0]) {
+            m.normal = n.Negate()
+        }
+    } else {
+        m.normal = n.Negate()
+    }
+    m.add(xf.apply(closest).Add(n.MulF64(depth.Div2())), depth, id)
+}
+
+// collideBoxes Adds the contacts between two boxes. The separating axis test picks the axis of least
+// penetration among the 3 + 3 face normals and 9 edge cross products. Face contacts clip the incident
+// face against the side planes of the reference face; edge contacts are the closest points of the edges.
+func collideBoxes(m *manifold, a *Box, xfA transform, b *Box, xfB transform) {
+    t := xfB.p.Sub(xfA.p)
+    axesA := [3]fp.F64Vec3{xfA.r.Col(0), xfA.r.Col(1), xfA.r.Col(2)}
+    axesB := [3]fp.F64Vec3{xfB.r.Col(0), xfB.r.Col(1), xfB.r.Col(2)}
+    project := func(axes [3]fp.F64Vec3, h fp.F64Vec3, l fp.F64Vec3) fp.F64 {
+        return axes[0].Dot(l).Abs().Mul(h.X()).Add(axes[1].Dot(l).Abs().Mul(h.Y())).Add(axes[2].Dot(l).Abs().Mul(h.Z()))
+    }
+    separation := func(l fp.F64Vec3) fp.F64 {
+        return t.Dot(l).Abs().Sub(project(axesA, a.HalfExtents, l)).Sub(project(axesB, b.HalfExtents, l))
+    }
+
+    // Axes 0-2 are the faces of a, 3-5 the faces of b and 6-14 the edge pairs.
+    best, bestSep, bestAxis := -1, fp.F64MinValue, fp.F64Vec3Zero
+    for i := 0; i < 6; i++ {
+        l := axesA[i%3]
+        if i >= 3 {
+            l = axesB[i%3]
+        }
+        s := separation(l)
+        if s.Raw > 0 {
+            return
+        }
+        if s.GT(bestSep) {
+            best, bestSep, bestAxis = i, s, l
+        }
+    }
+    faceSep := bestSep
+    for i := 0; i < 3; i++ {
+        for j := 0; j < 3; j++ {
+            l, length := normalize(axesA[i].Cross(axesB[j]))
+            if length.LT(fp.F64Ratio(1, 1000)) {
+                continue // parallel edges, covered by the face axes
+            }
+            s := separation(l)
+            if s.Raw > 0 {
+                return
+            }
+            // Prefer faces, which give stable contacts, unless an edge separates clearly better.
+            if s.GT(bestSep) && s.GT(faceSep.Add(linearSlop)) {
+                best, bestSep, bestAxis = 6+3*i+j, s, l
+            }
+        }
+    }
+    n := bestAxis
+    if t.Dot(n).Raw < 0 {
+        n = n.Negate()
+    }
+    m.normal = n
+
+    if best >= 6 {
+        // The edge of each box that is furthest towards the other one.
+        i, j := (best-6)/3, (best-6)%3
+        ea := supportEdge(axesA, a.HalfExtents, xfA.p, i, n)
+        eb := supportEdge(axesB, b.HalfExtents, xfB.p, j, n.Negate())
+        _, _, ca, cb := ea.ClosestPoints(eb)
+        depth := bestSep.Negate()
+        m.add(ca.Add(cb).MulF64(fp.F64Half), depth, uint32(best)<<16)
+        return
+    }
+
+    // Face contact, with the reference face on a for axes 0-2 and on b for axes 3-5.
+    refAxes, refH, refP, incAxes, incH, incP, refN := axesA, a.HalfExtents, xfA.p, axesB, b.HalfExtents, xfB.p, n
+    if best >= 3 {
+        refAxes, refH, refP, incAxes, incH, incP, refN = axesB, b.HalfExtents, xfB.p, axesA, a.HalfExtents, xfA.p, n.Negate()
+    }
+    ri := best % 3
+    refCenter := refP.Add(refN.MulF64(component(refH, ri)))
+
+    // The incident face is the one most anti-parallel to the reference normal.
+    ii, maxDot := 0, fp.F64MinValue
+    for k := 0; k < 3; k++ {
+        if d := incAxes[k].Dot(refN).Abs(); d.GT(maxDot) {
+            ii, maxDot = k, d
+        }
+    }
+    incN := incAxes[ii]
+    if incN.Dot(refN).Raw > 0 {
+        incN = incN.Negate()
+    }
+    incCenter := incP.Add(incN.MulF64(component(incH, ii)))
+    u, v := incAxes[(ii+1)%3].MulF64(component(incH, (ii+1)%3)), incAxes[(ii+2)%3].MulF64(component(incH, (ii+2)%3))
+    poly := []clipVertex{
+        {p: incCenter.Add(u).Add(v), id: 0},
+        {p: incCenter.Sub(u).Add(v), id: 1},
+        {p: incCenter.Sub(u).Sub(v), id: 2},
+        {p: incCenter.Add(u).Sub(v), id: 3},
+    }
+    for k, plane := 0, 0; k < 2; k++ {
+        axis := refAxes[(ri+1+k)%3]
+        offset := axis.Dot(refCenter)
+        ext := component(refH, (ri + 1 + k) % 3)
+        poly = clipPolygon(poly, axis, offset.Add(ext), plane)
+        poly = clipPolygon(poly, axis.Negate(), offset.Negate().Add(ext), plane+1)
+        plane += 2
+    }
+
+    front := refN.Dot(refCenter)
+    for _, cv := range poly {
+        depth := front.Sub(refN.Dot(cv.p))
+        if depth.Raw < 0 {
+            continue
+        }
+        // Halfway between the incident point and the reference face.
+        p := cv.p.Add(refN.MulF64(depth.Div2()))
+        m.add(p, depth, uint32(best)<<16|uint32(ii)<<12|cv.id&0xfff)
+    }
+}
+
+// supportEdge Returns the edge of a box parallel to its axis i that is furthest in direction d.
+func supportEdge(axes [3]fp.F64Vec3, h fp.F64Vec3, center fp.F64Vec3, i int, d fp.F64Vec3) fp.F64Segment3 {
+    c := center
+    for k := 0; k < 3; k++ {
+        if k == i {
+            continue
+        }
+        e := axes[k].MulF64(component(h, k))
+        if axes[k].Dot(d).Raw < 0 {
+            e = e.Negate()
+        }
+        c = c.Add(e)
+    }
+    e := axes[i].MulF64(component(h, i))
+    return fp.F64Segment3FromPoints(c.Sub(e), c.Add(e))
+}
+
+// clipVertex A point of a clipped polygon, with an id naming the features it came from.
+type clipVertex struct {
+    p  fp.F64Vec3
+    id uint32
+}
+
+// clipPolygon Returns the part of the convex polygon where n.Dot(p) <= offset, using Sutherland-Hodgman.
+func clipPolygon(in []clipVertex, n fp.F64Vec3, offset fp.F64, plane int) []clipVertex {
+    if len(in) == 0 {
+        return in
+    }
+    out := make([]clipVertex, 0, len(in)+1)
+    s := in[len(in)-1]
+    ds := n.Dot(s.p).Sub(offset)
+    for _, e := range in {
+        de := n.Dot(e.p).Sub(offset)
+        if (ds.Raw <= 0) != (de.Raw <= 0) {
+            t := ds.DivPrecise(ds.Sub(de))
+            p := s.p.Add(e.p.Sub(s.p).MulF64(t))
+            out = append(out, clipVertex{p: p, id: (s.id*31+e.id)*8 + uint32(plane) + 16})
+        }
+        if de.Raw <= 0 {
+            out = append(out, e)
+        }
+        s, ds = e, de
+    }
+    return out
+}
@@ -0,0 +1,84 @@
+package physics3d
+
+import (
+    "github.com/camry/fp"
+)
+
+var (
+    // linearSlop Penetration allowed before the solver pushes bodies apart, which keeps contacts stable.
+    linearSlop = fp.F64Ratio(1, 200)
+    // baumgarte Fraction of the remaining penetration removed per step.
+    baumgarte = fp.F64Ratio(1, 5)
+    // restitutionThreshold Approach speed below which collisions are treated as inelastic.
+    restitutionThreshold = fp.F64One
+)
+
+// transform Position and rotation of a body, mapping local to world coordinates. The columns of r are the
+// local axes in world coordinates.
+type transform struct {
+    p fp.F64Vec3
+    r fp.F64Mat3
+}
+
+func transformOf(p fp.F64Vec3, q fp.F64Quat) transform {
+    return transform{p: p, r: fp.F64Mat3FromQuat(q)}
+}
+
+func (xf transform) apply(v fp.F64Vec3) fp.F64Vec3 {
+    return xf.r.MulVec3(v).Add(xf.p)
+}
+
+func (xf transform) applyInv(v fp.F64Vec3) fp.F64Vec3 {
+    return xf.rotateInv(v.Sub(xf.p))
+}
+
+// rotateInv Rotates a world direction into local coordinates.
+func (xf transform) rotateInv(v fp.F64Vec3) fp.F64Vec3 {
+    return fp.F64Vec3FromF64(xf.r.Col(0).Dot(v), xf.r.Col(1).Dot(v), xf.r.Col(2).Dot(v))
+}
+
+// normalize Returns v scaled to unit length and its original length, or zeros when v is zero.
+func normalize(v fp.F64Vec3) (fp.F64Vec3, fp.F64) {
+    l := v.LengthSqr().SqrtPrecise()
+    if l.Raw == 0 {
+        return fp.F64Vec3Zero, fp.F64Zero
+    }
+    return v.DivPreciseF64(l), l
+}
+
+// normalizeQuat Returns q scaled to unit length, or the identity when q is zero.
+func normalizeQuat(q fp.F64Quat) fp.F64Quat {
+    l := q.LengthSqr().SqrtPrecise()
+    if l.Raw == 0 {
+        return fp.Identity
+    }
+    return fp.FromF64(q.QuatX().DivPrecise(l), q.QuatY().DivPrecise(l), q.QuatZ().DivPrecise(l), q.QuatW().DivPrecise(l))
+}
+
+// tangents Returns two unit vectors that form a right-handed basis with the unit normal n.
+func tangents(n fp.F64Vec3) (fp.F64Vec3, fp.F64Vec3) {
+    var t fp.F64Vec3
+    if n.X().Abs().GE(fp.F64Ratio(57735, 100000)) {
+        t, _ = normalize(fp.F64Vec3FromF64(n.Y(), n.X().Negate(), fp.F64Zero))
+    } else {
+        t, _ = normalize(fp.F64Vec3FromF64(fp.F64Zero, n.Z(), n.Y().Negate()))
+    }
+    return t, n.Cross(t)
+}
+
+// diagonal Returns the diagonal matrix with the entries.
+func diagonal(x, y, z fp.F64) fp.F64Mat3 {
+    return fp.F64Mat3FromScale(fp.F64Vec3FromF64(x, y, z))
+}
+
+// component Returns the x, y or z component of v for i = 0, 1 or 2.
+func component(v fp.F64Vec3, i int) fp.F64 {
+    switch i {
+    case 0:
+        return v.X()
+    case 1:
+        return v.Y()
+    default:
+        return v.Z()
+    }
+}
@@ -0,0 +1,286 @@
+package physics3d_test
+
+import (
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/physics3d"
+    "github.com/camry/fp/rand"
+)
+
+var (
+    dt      = fp.F64Ratio(1, 60)
+    gravity = fp.F64Vec3FromInt32(0, -10, 0)
+)
+
+func f(v float64) fp.F64 {
+    return fp.F64FromFloat64(v)
+}
+
+func vec(x, y, z float64) fp.F64Vec3 {
+    return fp.F64Vec3FromFloat64(x, y, z)
+}
+
+// newGround Returns a world with a static floor whose top is at y = 0.
+func newGround() *physics3d.World {
+    w := physics3d.NewWorld(gravity)
+    ground := physics3d.NewBody(physics3d.NewBox(vec(50, 0.5, 50)), fp.F64Zero)
+    ground.Position = vec(0, -0.5, 0)
+    w.Add(ground)
+    return w
+}
+
+func step(w *physics3d.World, n int) {
+    for i := 0; i < n; i++ {
+        w.Step(dt)
+    }
+}
+
+func TestMassData(t *testing.T) {
+    box := physics3d.NewBody(physics3d.NewBox(vec(1, 2, 3)), fp.F64One)
+    assert.Equal(t, fp.F64FromInt32(48), box.Mass())
+    i := box.Inertia()
+    assert.InDelta(t, 48*(16+36)/12.0, i.Col(0).X().Float64(), 1e-6)
+    assert.InDelta(t, 48*(4+36)/12.0, i.Col(1).Y().Float64(), 1e-6)
+    assert.InDelta(t, 48*(4+16)/12.0, i.Col(2).Z().Float64(), 1e-6)
+    assert.Equal(t, fp.F64Zero, i.Col(0).Y())
+    assert.False(t, box.IsStatic())
+
+    sphere := physics3d.NewBody(physics3d.NewSphere(fp.F64Two), fp.F64One)
+    m := 4 / 3.0 * 3.14159265 * 8
+    assert.InDelta(t, m, sphere.Mass().Float64(), 1e-6)
+    assert.InDelta(t, 0.4*m*4, sphere.Inertia().Col(1).Y().Float64(), 1e-5)
+
+    // A capsule of radius 1 and no cylinder is a unit sphere.
+    capsule := physics3d.NewBody(physics3d.NewCapsule(fp.F64Zero, fp.F64One), fp.F64One)
+    assert.InDelta(t, 4/3.0*3.14159265, capsule.Mass().Float64(), 1e-6)
+    assert.InDelta(t, 0.4*4/3.0*3.14159265, capsule.Inertia().Col(0).X().Float64(), 1e-6)
+    capsule = physics3d.NewBody(physics3d.NewCapsule(fp.F64One, f(0.5)), fp.F64One)
+    assert.InDelta(t, 3.14159265*(0.25*2+0.5/3), capsule.Mass().Float64(), 1e-6)
+    // The cylinder, pi/2 (1/3 + 1/16), and the hemispheres, pi/6 (2/5 r^2 + h^2 + 3/4 h r).
+    pi := 3.14159265
+    assert.InDelta(t, pi/2*(1/3.0+1/16.0)+pi/6*(0.1+1+0.375), capsule.Inertia().Col(0).X().Float64(), 1e-6)
+    assert.InDelta(t, pi/2*0.125+pi/6*0.1, capsule.Inertia().Col(1).Y().Float64(), 1e-6)
+    assert.Equal(t, capsule.Inertia().Col(0).X(), capsule.Inertia().Col(2).Z())
+
+    static := physics3d.NewBody(physics3d.NewSphere(fp.F64One), fp.F64Zero)
+    assert.True(t, static.IsStatic())
+    assert.Equal(t, fp.F64Zero, static.Mass())
+}
+
+func TestFreeFall(t *testing.T) {
+    for _, integrator := range []physics3d.Integrator{physics3d.SemiImplicitEuler, physics3d.Verlet} {
+        w := physics3d.NewWorld(gravity)
+        w.Integrator = integrator
+        b := physics3d.NewBody(physics3d.NewSphere(fp.F64One), fp.F64One)
+        b.Position = vec(0, 100, 0)
+        b.Velocity = vec(3, 0, 0)
+        w.Add(b)
+        step(w, 60)
+        assert.InDelta(t, -10, b.Velocity.Y().Float64(), 1e-6)
+        assert.InDelta(t, 3, b.Position.X().Float64(), 1e-6)
+        if integrator == physics3d.Verlet {
+            // Exactly g t^2 / 2.
+            assert.InDelta(t, 95, b.Position.Y().Float64(), 1e-6)
+        } else {
+            // Semi-implicit Euler falls 10 * (1 + 2 + ... + 60) / 60^2.
+            assert.InDelta(t, 100-10*61/120.0, b.Position.Y().Float64(), 1e-6)
+        }
+        assert.Equal(t, fp.Identity, b.Orientation)
+    }
+
+    w := physics3d.NewWorld(fp.F64Vec3Zero)
+    b := physics3d.NewBody(physics3d.NewSphere(fp.F64One), fp.F64One)
+    w.Add(b)
+    b.ApplyForceToCenter(vec(0, 0, 1).MulF64(b.Mass().Mul(fp.F64FromInt32(60))))
+    w.Step(dt)
+    assert.InDelta(t, 1, b.Velocity.Z().Float64(), 1e-6)
+    w.Step(dt)
+    assert.InDelta(t, 1, b.Velocity.Z().Float64(), 1e-6)
+
+    h := w.Hash()
+    w.Step(fp.F64Zero)
+    assert.Equal(t, h, w.Hash())
+    assert.True(t, w.Remove(b))
+    assert.False(t, w.Remove(b))
+    assert.Empty(t, w.Bodies())
+    assert.NotEqual(t, h, w.Hash())
+}
+
+func TestSpin(t *testing.T) {
+    w := physics3d.NewWorld(fp.F64Vec3Zero)
+    b := physics3d.NewBody(physics3d.NewBox(vec(1, 0.5, 0.25)), fp.F64One)
+    w.Add(b)
+    // A torque about y for one step spins the box at torque * dt / I.
+    iy := b.Inertia().Col(1).Y()
+    b.ApplyTorque(fp.F64Vec3FromF64(fp.F64Zero, iy.Mul(fp.F64FromInt32(60)), fp.F64Zero))
+    w.Step(dt)
+    assert.InDelta(t, 1, b.AngularVelocity.Y().Float64(), 1e-6)
+    assert.InDelta(t, iy.Float64()/2, b.KineticEnergy().Float64(), 1e-5)
+
+    // Spinning about a principal axis at 1 rad/s for pi seconds turns the box half way around.
+    for i := 1; i < 189; i++ {
+        w.Step(dt)
+    }
+    x := b.WorldPoint(vec(1, 0, 0))
+    assert.InDelta(t, -1, x.X().Float64(), 1e-2)
+    assert.InDelta(t, 0, x.Y().Float64(), 1e-6)
+    assert.InDelta(t, 1, b.Orientation.Length().Float64(), 1e-6)
+
+    // An off-center impulse pushes and spins.
+    b.AngularVelocity = fp.F64Vec3Zero
+    b.ApplyImpulse(vec(0, 0, 1), b.Position.Add(vec(0, 1, 0)))
+    assert.InDelta(t, 1/b.Mass().Float64(), b.Velocity.Z().Float64(), 1e-6)
+    assert.True(t, b.AngularVelocity.LengthSqr().Raw > 0)
+}
+
+func TestResting(t *testing.T) {
+    w := newGround()
+    ball := physics3d.NewBody(physics3d.NewSphere(f(0.5)), fp.F64One)
+    ball.Position = vec(-3, 2, 0)
+    box := physics3d.NewBody(physics3d.NewBox(vec(0.5, 0.5, 0.5)), fp.F64One)
+    box.Position = vec(0, 2, 0)
+    capsule := physics3d.NewBody(physics3d.NewCapsule(f(0.5), f(0.5)), fp.F64One)
+    capsule.Position = vec(3, 2, 0)
+    capsule.Orientation = fp.FromAxisAngle(fp.F64Vec3Forward, fp.F64PiHalf)
+    w.Add(ball)
+    w.Add(box)
+    w.Add(capsule)
+    step(w, 180)
+    for _, b := range []*physics3d.Body{ball, box, capsule} {
+        assert.InDelta(t, 0.5, b.Position.Y().Float64(), 0.01, "%v", b.Position)
+        assert.InDelta(t, 0, b.Velocity.Length().Float64(), 0.01, "%v", b.Velocity)
+        assert.InDelta(t, 0, b.AngularVelocity.Length().Float64(), 0.01, "%v", b.AngularVelocity)
+    }
+    assert.InDelta(t, 1, box.Orientation.QuatW().Abs().Float64(), 1e-3)
+}
+
+func TestRestitution(t *testing.T) {
+    w := newGround()
+    ball := physics3d.NewBody(physics3d.NewSphere(f(0.5)), fp.F64One)
+    ball.Position = vec(0, 5.5, 0)
+    ball.Restitution = fp.F64One
+    w.Add(ball)
+    top := fp.F64Zero
+    for i := 0; i < 120; i++ {
+        w.Step(dt)
+        if i > 70 {
+            top = fp.F64Max(top, ball.Position.Y())
+        }
+    }
+    // The ball hits the ground after 1 s and climbs most of the way back.
+    assert.Greater(t, top.Float64(), 4.5)
+}
+
+func TestFriction(t *testing.T) {
+    w := newGround()
+    box := physics3d.NewBody(physics3d.NewBox(vec(0.5, 0.5, 0.5)), fp.F64One)
+    box.Position = vec(0, 0.5, 0)
+    box.Velocity = vec(3, 0, 4)
+    w.Add(box)
+    step(w, 120)
+    // Sliding stops after v^2 / (2 mu g) = 2.5 m, along the initial direction.
+    assert.InDelta(t, 0, box.Velocity.Length().Float64(), 0.01)
+    assert.InDelta(t, 1.5, box.Position.X().Float64(), 0.15)
+    assert.InDelta(t, 2, box.Position.Z().Float64(), 0.2)
+
+    box.Position, box.Velocity, box.Friction = vec(0, 0.5, 0), vec(5, 0, 0), fp.F64Zero
+    step(w, 60)
+    assert.InDelta(t, 5, box.Velocity.X().Float64(), 0.01)
+}
+
+func TestStack(t *testing.T) {
+    w := newGround()
+    var boxes []*physics3d.Body
+    for i := 0; i < 5; i++ {
+        b := physics3d.NewBody(physics3d.NewBox(vec(0.5, 0.5, 0.5)), fp.F64One)
+        b.Position = vec(0, 0.5+1.01*float64(i), 0)
+        w.Add(b)
+        boxes = append(boxes, b)
+    }
+    step(w, 300)
+    for i, b := range boxes {
+        assert.InDelta(t, 0, b.Position.X().Float64(), 0.05, "box %d", i)
+        assert.InDelta(t, 0, b.Position.Z().Float64(), 0.05, "box %d", i)
+        assert.InDelta(t, 0.5+float64(i), b.Position.Y().Float64(), 0.1, "box %d", i)
+        assert.InDelta(t, 1, b.Orientation.QuatW().Abs().Float64(), 0.01, "box %d", i)
+    }
+}
+
+// newScene Returns a world with a pile of random spheres, boxes and capsules above the ground, inside four
+// walls.
+func newScene(integrator physics3d.Integrator) *physics3d.World {
+    w := newGround()
+    w.Integrator = integrator
+    for _, p := range []fp.F64Vec3{vec(-6, 5, 0), vec(6, 5, 0), vec(0, 5, -6), vec(0, 5, 6)} {
+        h := vec(0.5, 5, 6)
+        if p.X().Raw == 0 {
+            h = vec(6, 5, 0.5)
+        }
+        wall := physics3d.NewBody(physics3d.NewBox(h), fp.F64Zero)
+        wall.Position = p
+        w.Add(wall)
+    }
+    r := rand.New(17)
+    for i := 0; i < 40; i++ {
+        var shape physics3d.Shape
+        size := r.F64Range(f(0.25), f(0.6))
+        switch i % 3 {
+        case 0:
+            shape = physics3d.NewSphere(size)
+        case 1:
+            shape = physics3d.NewBox(fp.F64Vec3FromF64(size, r.F64Range(f(0.25), f(0.6)), r.F64Range(f(0.25), f(0.6))))
+        default:
+            shape = physics3d.NewCapsule(r.F64Range(f(0.2), f(0.5)), size)
+        }
+        b := physics3d.NewBody(shape, fp.F64One)
+        b.Position = fp.F64Vec3FromF64(r.F64Range(f(-4), f(4)), f(2).Add(fp.F64FromInt32(int32(i)).Div2()), r.F64Range(f(-4), f(4)))
+        b.Orientation = r.F64Quat()
+        b.Restitution = r.F64Range(fp.F64Zero, f(0.5))
+        w.Add(b)
+    }
+    return w
+}
+
+func TestReplayHash(t *testing.T) {
+    // Pinned hashes after 1, 2 and 4 seconds. Any change to them breaks lockstep replays, so they may only
+    // change together with a deliberate change to the simulation.
+    want := map[physics3d.Integrator]map[int]uint64{
+        physics3d.SemiImplicitEuler: {60: 0xeba464258c968602, 120: 0xcfb47a78bafa904c, 240: 0x2133fd663c328d0c},
+        physics3d.Verlet:            {60: 0x6b6c330433c59e86, 120: 0xae905d572734332b, 240: 0xddfd5071fdae7d54},
+    }
+    for integrator, want := range want {
+        run := func() map[int]uint64 {
+            w := newScene(integrator)
+            got := map[int]uint64{}
+            for i := 1; i <= 240; i++ {
+                w.Step(dt)
+                if _, ok := want[i]; ok {
+                    got[i] = w.Hash()
+                }
+            }
+            return got
+        }
+        first := run()
+        assert.Equal(t, first, run())
+        assert.Equal(t, want, first, "integrator %d", integrator)
+    }
+
+    // Everything settles on the ground inside the walls. Without rolling resistance spheres and capsules
+    // may keep rolling, but boxes come to rest.
+    w := newScene(physics3d.SemiImplicitEuler)
+    step(w, 600)
+    for _, b := range w.Bodies() {
+        if b.IsStatic() {
+            continue
+        }
+        p := b.Position
+        assert.True(t, p.Y().Float64() > 0 && p.X().Abs().Float64() < 5.5 && p.Z().Abs().Float64() < 5.5, "%v", p)
+        assert.InDelta(t, 0, b.Velocity.Y().Float64(), 0.01, "%v", b.Velocity)
+        if _, ok := b.Shape().(*physics3d.Box); ok {
+            assert.InDelta(t, 0, b.Velocity.Length().Float64(), 0.1, "%v", b.Velocity)
+        }
+    }
+}
@@ -0,0 +1,99 @@
+package physics3d
+
+import (
+    "github.com/camry/fp"
+)
+
+// Shape The collision geometry of a body, in local coordinates centered on the body's center of mass.
+// Shapes are immutable and may be shared between bodies. The implementations are Sphere, Box and Capsule.
+type Shape interface {
+    // massData Returns the mass and the diagonal of the inertia tensor about the origin for the density.
+    massData(density fp.F64) (mass fp.F64, inertia fp.F64Vec3)
+    // aabb Returns the bounding box of the shape placed by xf.
+    aabb(xf transform) fp.F64AABB3
+}
+
+// Sphere A ball around the body's center.
+type Sphere struct {
+    Radius fp.F64
+}
+
+// NewSphere Returns a sphere with the radius.
+func NewSphere(radius fp.F64) *Sphere {
+    return &Sphere{Radius: radius}
+}
+
+func (s *Sphere) massData(density fp.F64) (fp.F64, fp.F64Vec3) {
+    rr := s.Radius.Mul(s.Radius)
+    mass := density.Mul(fp.F64Pi).Mul(rr).Mul(s.Radius).Mul(fp.F64Ratio(4, 3))
+    i := mass.Mul(rr).Mul(fp.F64Ratio(2, 5))
+    return mass, fp.F64Vec3FromF64(i, i, i)
+}
+
+func (s *Sphere) aabb(xf transform) fp.F64AABB3 {
+    return fp.F64AABB3FromCenterExtents(xf.p, fp.F64Vec3FromF64(s.Radius, s.Radius, s.Radius))
+}
+
+// Box A cuboid centered on the body's center, aligned with its local axes.
+type Box struct {
+    HalfExtents fp.F64Vec3
+}
+
+// NewBox Returns a box with the half extents.
+func NewBox(halfExtents fp.F64Vec3) *Box {
+    return &Box{HalfExtents: halfExtents}
+}
+
+func (b *Box) massData(density fp.F64) (fp.F64, fp.F64Vec3) {
+    h := b.HalfExtents
+    mass := density.Mul(h.X()).Mul(h.Y()).Mul(h.Z()).Mul(fp.F64FromInt32(8))
+    xx, yy, zz := h.X().Mul(h.X()), h.Y().Mul(h.Y()), h.Z().Mul(h.Z())
+    third := mass.DivPrecise(fp.F64FromInt32(3))
+    return mass, fp.F64Vec3FromF64(third.Mul(yy.Add(zz)), third.Mul(xx.Add(zz)), third.Mul(xx.Add(yy)))
+}
+
+func (b *Box) aabb(xf transform) fp.F64AABB3 {
+    // The extents of the rotated box are the absolute rotation matrix applied to the half extents.
+    var e [3]fp.F64
+    for i := 0; i < 3; i++ {
+        r := xf.r.Row(i)
+        e[i] = r.X().Abs().Mul(b.HalfExtents.X()).Add(r.Y().Abs().Mul(b.HalfExtents.Y())).Add(r.Z().Abs().Mul(b.HalfExtents.Z()))
+    }
+    return fp.F64AABB3FromCenterExtents(xf.p, fp.F64Vec3FromF64(e[0], e[1], e[2]))
+}
+
+// Capsule The points within Radius of the segment from -HalfHeight to HalfHeight along the local y axis.
+type Capsule struct {
+    HalfHeight fp.F64
+    Radius     fp.F64
+}
+
+// NewCapsule Returns a capsule along the local y axis.
+func NewCapsule(halfHeight, radius fp.F64) *Capsule {
+    return &Capsule{HalfHeight: halfHeight, Radius: radius}
+}
+
+func (c *Capsule) massData(density fp.F64) (fp.F64, fp.F64Vec3) {
+    r, h := c.Radius, c.HalfHeight
+    rr, hh := r.Mul(r), h.Mul(h)
+    // A cylinder of height 2h and two hemispheres, which together form a sphere. About an axis through the
+    // center across the capsule, each hemisphere adds 2/5 r^2 + h^2 + 3/4 h r per unit mass, moved from its
+    // own center of mass 3/8 r off its flat face to the capsule's center.
+    cyl := density.Mul(fp.F64Pi).Mul(rr).Mul(h.Mul(fp.F64Two))
+    sph := density.Mul(fp.F64Pi).Mul(rr).Mul(r).Mul(fp.F64Ratio(4, 3))
+    iy := cyl.Mul(rr).Div2().Add(sph.Mul(rr).Mul(fp.F64Ratio(2, 5)))
+    ix := cyl.Mul(hh.DivPrecise(fp.F64FromInt32(3)).Add(rr.Mul(fp.F64Ratio(1, 4)))).
+        Add(sph.Mul(rr.Mul(fp.F64Ratio(2, 5)).Add(hh).Add(h.Mul(r).Mul(fp.F64Ratio(3, 4)))))
+    return cyl.Add(sph), fp.F64Vec3FromF64(ix, iy, ix)
+}
+
+func (c *Capsule) aabb(xf transform) fp.F64AABB3 {
+    a, b := c.segment(xf)
+    return fp.F64AABB3FromPoints(a, b).Expand(c.Radius)
+}
+
+// segment Returns the world end points of the capsule's core segment.
+func (c *Capsule) segment(xf transform) (fp.F64Vec3, fp.F64Vec3) {
+    axis := xf.r.Col(1).MulF64(c.HalfHeight)
+    return xf.p.Sub(axis), xf.p.Add(axis)
+}
@@ -0,0 +1,136 @@
+package physics3d
+
+import (
+    "github.com/camry/fp"
+)
+
+// contactPoint A contact point with the solver state of its normal and friction constraints.
+type contactPoint struct {
+    id              uint32
+    rA, rB          fp.F64Vec3 // from the centers of mass to the point
+    normalMass      fp.F64
+    tangentMass     [2]fp.F64
+    bias            fp.F64 // target separating velocity for position correction and restitution
+    bounce          bool   // whether the bias includes restitution
+    normalImpulse   fp.F64
+    tangentImpulses [2]fp.F64
+}
+
+// contact The non-penetration and friction constraints between two touching bodies, solved with
+// sequential impulses. The impulses accumulated for a point carry over to the next step while its
+// features still touch, so that resting contacts start close to their solution.
+type contact struct {
+    pair        pair
+    a, b        *Body
+    normal      fp.F64Vec3
+    tangents    [2]fp.F64Vec3
+    points      [maxPoints]contactPoint
+    count       int
+    friction    fp.F64
+    restitution fp.F64
+}
+
+func newContact(p pair, a, b *Body, m *manifold, invDt fp.F64) contact {
+    c := contact{
+        pair:        p,
+        a:           a,
+        b:           b,
+        normal:      m.normal,
+        count:       m.count,
+        friction:    a.Friction.Mul(b.Friction).SqrtPrecise(),
+        restitution: fp.F64Max(a.Restitution, b.Restitution),
+    }
+    c.tangents[0], c.tangents[1] = tangents(m.normal)
+    for i := 0; i < m.count; i++ {
+        cp := &c.points[i]
+        cp.id = m.ids[i]
+        cp.rA, cp.rB = m.points[i].Sub(a.Position), m.points[i].Sub(b.Position)
+        cp.normalMass = c.effectiveMass(cp, m.normal)
+        cp.tangentMass[0] = c.effectiveMass(cp, c.tangents[0])
+        cp.tangentMass[1] = c.effectiveMass(cp, c.tangents[1])
+
+        // Push apart the penetration beyond the slop over a few steps, and bounce off fast approaches.
+        cp.bias = baumgarte.Mul(invDt).Mul(fp.F64Max(m.depths[i].Sub(linearSlop), fp.F64Zero))
+        vn := c.relativeVelocity(cp).Dot(m.normal)
+        if vn.LT(restitutionThreshold.Negate()) && c.restitution.Raw > 0 {
+            cp.bias = fp.F64Max(cp.bias, c.restitution.Mul(vn).Negate())
+            cp.bounce = true
+        }
+    }
+    return c
+}
+
+// warmStart Takes over the impulses of the points of old with the same features, and applies them. Points
+// that bounce in either step start from zero, as the impulse of an impact would otherwise be applied again.
+func (c *contact) warmStart(old *contact) {
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        if cp.bounce {
+            continue
+        }
+        for j := 0; j < old.count; j++ {
+            if op := &old.points[j]; op.id == cp.id && !op.bounce {
+                cp.normalImpulse, cp.tangentImpulses = op.normalImpulse, op.tangentImpulses
+                impulse := c.normal.MulF64(cp.normalImpulse).
+                    Add(c.tangents[0].MulF64(cp.tangentImpulses[0])).
+                    Add(c.tangents[1].MulF64(cp.tangentImpulses[1]))
+                c.apply(cp, impulse)
+                break
+            }
+        }
+    }
+}
+
+// effectiveMass Returns the inverse of the mass the constraint along dir sees at the point.
+func (c *contact) effectiveMass(cp *contactPoint, dir fp.F64Vec3) fp.F64 {
+    ra, rb := cp.rA.Cross(dir), cp.rB.Cross(dir)
+    k := c.a.invMass.Add(c.b.invMass).
+        Add(c.a.invWorld.MulVec3(ra).Dot(ra)).
+        Add(c.b.invWorld.MulVec3(rb).Dot(rb))
+    if k.Raw <= 0 {
+        return fp.F64Zero
+    }
+    return fp.F64One.DivPrecise(k)
+}
+
+// solve Applies one round of impulses, normal constraints before friction, whose limit depends on them.
+func (c *contact) solve() {
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        vn := c.relativeVelocity(cp).Dot(c.normal)
+        impulse := cp.normalMass.Mul(cp.bias.Sub(vn))
+        // Clamp the accumulated impulse, so that the contact can only push.
+        total := fp.F64Max(cp.normalImpulse.Add(impulse), fp.F64Zero)
+        impulse, cp.normalImpulse = total.Sub(cp.normalImpulse), total
+        c.apply(cp, c.normal.MulF64(impulse))
+    }
+    for i := 0; i < c.count; i++ {
+        cp := &c.points[i]
+        limit := c.friction.Mul(cp.normalImpulse)
+        vr := c.relativeVelocity(cp)
+        var total [2]fp.F64
+        for k, t := range c.tangents {
+            total[k] = cp.tangentImpulses[k].Sub(cp.tangentMass[k].Mul(vr.Dot(t)))
+        }
+        // Clamp to the friction cone rather than each tangent on its own, which would allow up to sqrt(2)
+        // times the friction and pull sliding bodies towards the tangent diagonals.
+        if l := total[0].Mul(total[0]).Add(total[1].Mul(total[1])).SqrtPrecise(); l.GT(limit) {
+            total[0], total[1] = total[0].Mul(limit).DivPrecise(l), total[1].Mul(limit).DivPrecise(l)
+        }
+        impulse := c.tangents[0].MulF64(total[0].Sub(cp.tangentImpulses[0])).
+            Add(c.tangents[1].MulF64(total[1].Sub(cp.tangentImpulses[1])))
+        cp.tangentImpulses = total
+        c.apply(cp, impulse)
+    }
+}
+
+// relativeVelocity Returns the velocity of b relative to a at the point.
+func (c *contact) relativeVelocity(cp *contactPoint) fp.F64Vec3 {
+    return c.b.velocityAt(cp.rB).Sub(c.a.velocityAt(cp.rA))
+}
+
+// apply Applies the impulse to b and its opposite to a.
+func (c *contact) apply(cp *contactPoint, impulse fp.F64Vec3) {
+    c.a.applyImpulse(impulse.Negate(), cp.rA)
+    c.b.applyImpulse(impulse, cp.rB)
+}
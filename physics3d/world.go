@@ -0,0 +1,177 @@
+package physics3d
+
+import (
+    "encoding/binary"
+    "hash/fnv"
+
+    "github.com/camry/fp"
+)
+
+// Deterministic 3D rigid body physics.
+//
+// Every quantity is an F64, F64Vec3, F64Quat or F64Mat3 and every step runs in a fixed order: bodies in
+// the order they were added, broad phase pairs sorted by body index, and a fixed number of solver
+// iterations. Given the same initial state and the same sequence of Step calls, a World therefore produces
+// bit-identical results on every platform, which is what lockstep simulations need. Use World.Hash to
+// compare states across machines.
+//
+// Units are up to the caller, but the solver tolerances assume meters, kilograms and seconds, with bodies
+// between a few centimeters and a few hundred meters in size.
+
+// Integrator Method used to advance positions and orientations from the velocities.
+type Integrator int
+
+const (
+    // SemiImplicitEuler Updates velocities first and moves with the new velocities. It is stable and the
+    // default.
+    SemiImplicitEuler Integrator = iota
+    // Verlet Moves with the average of the old and new velocities, velocity Verlet. Motion under constant
+    // forces, such as a projectile, follows the exact parabola.
+    Verlet
+)
+
+// World A collection of bodies simulated together. It is not safe for concurrent use.
+type World struct {
+    Gravity fp.F64Vec3
+    // Iterations Number of velocity solver iterations per step. More iterations make stacks stiffer.
+    Iterations int
+    Integrator Integrator
+
+    bodies   []*Body
+    accs     []acceleration
+    boxes    []fp.F64AABB3
+    contacts []contact
+    previous []contact
+    sap      sweepAndPrune
+}
+
+// acceleration The linear and angular acceleration of a body from the forces of one step.
+type acceleration struct{ linear, angular fp.F64Vec3 }
+
+// NewWorld Returns an empty world with the gravity, 10 solver iterations and semi-implicit Euler
+// integration.
+func NewWorld(gravity fp.F64Vec3) *World {
+    return &World{Gravity: gravity, Iterations: 10}
+}
+
+// Add Adds the body to the world. Bodies are simulated in the order they were added.
+func (w *World) Add(b *Body) {
+    w.bodies = append(w.bodies, b)
+}
+
+// Remove Removes the body, keeping the order of the others. It reports whether the body was found.
+func (w *World) Remove(b *Body) bool {
+    for i, o := range w.bodies {
+        if o == b {
+            w.bodies = append(w.bodies[:i], w.bodies[i+1:]...)
+            return true
+        }
+    }
+    return false
+}
+
+// Bodies Returns the bodies in simulation order. The slice must not be modified.
+func (w *World) Bodies() []*Body {
+    return w.bodies
+}
+
+// Step Advances the world by dt, which should be the same every step for reproducible behavior. Forces
+// and torques applied since the previous step act during dt and are then cleared.
+func (w *World) Step(dt fp.F64) {
+    if dt.Raw <= 0 {
+        return
+    }
+    invDt := fp.F64One.DivPrecise(dt)
+
+    // Integrate forces. The accelerations are kept for the Verlet position update.
+    w.accs = w.accs[:0]
+    for _, b := range w.bodies {
+        b.update()
+        if b.IsStatic() {
+            w.accs = append(w.accs, acceleration{})
+            continue
+        }
+        acc := acceleration{
+            linear:  w.Gravity.Add(b.force.MulF64(b.invMass)),
+            angular: b.invWorld.MulVec3(b.torque),
+        }
+        b.Velocity = b.Velocity.Add(acc.linear.MulF64(dt))
+        b.AngularVelocity = b.AngularVelocity.Add(acc.angular.MulF64(dt))
+        b.force, b.torque = fp.F64Vec3Zero, fp.F64Vec3Zero
+        w.accs = append(w.accs, acc)
+    }
+
+    // Find the contacts.
+    w.boxes = w.boxes[:0]
+    for _, b := range w.bodies {
+        w.boxes = append(w.boxes, b.shape.aabb(b.xf))
+    }
+    w.contacts, w.previous = w.previous[:0], w.contacts
+    for _, p := range w.sap.findPairs(w.bodies, w.boxes) {
+        a, b := w.bodies[p.a], w.bodies[p.b]
+        m, ok := collide(a, b)
+        if !ok {
+            continue
+        }
+        w.contacts = append(w.contacts, newContact(p, a, b, &m, invDt))
+    }
+    // Warm start only once every contact has measured its approach velocity for restitution. Both lists
+    // are sorted by pair, so the previous contact of a pair is found by merging.
+    old := 0
+    for k := range w.contacts {
+        c := &w.contacts[k]
+        for old < len(w.previous) && lessPair(w.previous[old].pair, c.pair) {
+            old++
+        }
+        if old < len(w.previous) && w.previous[old].a == c.a && w.previous[old].b == c.b {
+            c.warmStart(&w.previous[old])
+        }
+    }
+
+    // Solve the velocity constraints, then move the bodies.
+    for i := 0; i < w.Iterations; i++ {
+        for k := range w.contacts {
+            w.contacts[k].solve()
+        }
+    }
+    halfDt := dt.Div2()
+    for i, b := range w.bodies {
+        if b.IsStatic() {
+            continue
+        }
+        v, omega := b.Velocity, b.AngularVelocity
+        if w.Integrator == Verlet {
+            // v(t) + a dt / 2, the average velocity over the step when a is constant.
+            v = v.Sub(w.accs[i].linear.MulF64(halfDt))
+            omega = omega.Sub(w.accs[i].angular.MulF64(halfDt))
+        }
+        b.Position = b.Position.Add(v.MulF64(dt))
+        b.Orientation = integrateQuat(b.Orientation, omega, halfDt)
+    }
+}
+
+// integrateQuat Returns q advanced by the angular velocity omega over 2 halfDt, q + (omega, 0) q halfDt,
+// normalized.
+func integrateQuat(q fp.F64Quat, omega fp.F64Vec3, halfDt fp.F64) fp.F64Quat {
+    dq := fp.FromVector(omega.MulF64(halfDt), fp.F64Zero).Mul(q)
+    return normalizeQuat(fp.QuatFromRaw(q.RawX+dq.RawX, q.RawY+dq.RawY, q.RawZ+dq.RawZ, q.RawW+dq.RawW))
+}
+
+// Hash Returns a 64-bit FNV-1a hash of the position, orientation and velocities of every body in order.
+// Equal hashes on two machines mean their simulations are, with overwhelming probability, in sync.
+func (w *World) Hash() uint64 {
+    h := fnv.New64a()
+    var buf [8]byte
+    for _, b := range w.bodies {
+        for _, raw := range [...]int64{
+            b.Position.RawX, b.Position.RawY, b.Position.RawZ,
+            b.Orientation.RawX, b.Orientation.RawY, b.Orientation.RawZ, b.Orientation.RawW,
+            b.Velocity.RawX, b.Velocity.RawY, b.Velocity.RawZ,
+            b.AngularVelocity.RawX, b.AngularVelocity.RawY, b.AngularVelocity.RawZ,
+        } {
+            binary.LittleEndian.PutUint64(buf[:], uint64(raw))
+            h.Write(buf[:])
+        }
+    }
+    return h.Sum64()
+}
@@ -0,0 +1,255 @@
+package rand
+
+import (
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "math/bits"
+
+    "github.com/camry/fp"
+)
+
+// Deterministic pseudo-random numbers for fixed point values.
+//
+// Rand is xoshiro256** seeded through splitmix64. Everything is computed with integer and fixed point
+// arithmetic, so the same seed produces the same sequence on every platform, which is what lockstep
+// simulations need. The state can be copied by value, or serialized with MarshalBinary. Rand is not
+// safe for concurrent use.
+
+// Rand A seedable pseudo-random number generator. The zero value is not seeded and must not be used, create
+// generators with New.
+type Rand struct {
+    s [4]uint64
+}
+
+// stateSize The size of the MarshalBinary form in bytes.
+const stateSize = 32
+
+// New Returns a generator seeded with seed.
+func New(seed uint64) *Rand {
+    r := &Rand{}
+    r.Seed(seed)
+    return r
+}
+
+// Seed Resets the generator to the state derived from seed. Every seed, including 0, is valid.
+func (r *Rand) Seed(seed uint64) {
+    for i := range r.s {
+        seed += 0x9e3779b97f4a7c15
+        z := seed
+        z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+        z = (z ^ z>>27) * 0x94d049bb133111eb
+        r.s[i] = z ^ z>>31
+    }
+}
+
+// Uint64 Returns 64 uniformly distributed random bits.
+func (r *Rand) Uint64() uint64 {
+    s := &r.s
+    result := bits.RotateLeft64(s[1]*5, 7) * 9
+    t := s[1] << 17
+    s[2] ^= s[0]
+    s[3] ^= s[1]
+    s[1] ^= s[2]
+    s[0] ^= s[3]
+    s[2] ^= t
+    s[3] = bits.RotateLeft64(s[3], 45)
+    return result
+}
+
+// Uint32 Returns 32 uniformly distributed random bits.
+func (r *Rand) Uint32() uint32 {
+    return uint32(r.Uint64() >> 32)
+}
+
+// Uint64n Returns a uniformly distributed value in [0, n). It panics if n is 0.
+func (r *Rand) Uint64n(n uint64) uint64 {
+    if n == 0 {
+        panic("rand: invalid argument to Uint64n")
+    }
+    // Lemire's multiply and reject, which has no modulo bias.
+    hi, lo := bits.Mul64(r.Uint64(), n)
+    if lo < n {
+        thresh := -n % n
+        for lo < thresh {
+            hi, lo = bits.Mul64(r.Uint64(), n)
+        }
+    }
+    return hi
+}
+
+// Intn Returns a uniformly distributed value in [0, n). It panics if n <= 0.
+func (r *Rand) Intn(n int) int {
+    if n <= 0 {
+        panic("rand: invalid argument to Intn")
+    }
+    return int(r.Uint64n(uint64(n)))
+}
+
+// Perm Returns a random permutation of the integers [0, n).
+func (r *Rand) Perm(n int) []int {
+    m := make([]int, n)
+    for i := range m {
+        m[i] = i
+    }
+    r.Shuffle(n, func(i, j int) { m[i], m[j] = m[j], m[i] })
+    return m
+}
+
+// Shuffle Randomizes the order of n elements with the Fisher-Yates algorithm. swap swaps the elements
+// with indexes i and j. It panics if n < 0.
+func (r *Rand) Shuffle(n int, swap func(i, j int)) {
+    if n < 0 {
+        panic("rand: invalid argument to Shuffle")
+    }
+    for i := n - 1; i > 0; i-- {
+        swap(i, int(r.Uint64n(uint64(i+1))))
+    }
+}
+
+/************************************/
+/************* Scalars **************/
+/************************************/
+
+// F64 Returns a uniformly distributed value in [0, 1).
+func (r *Rand) F64() fp.F64 {
+    return fp.F64FromRaw(int64(r.Uint64() >> 32))
+}
+
+// F64Range Returns a uniformly distributed value in [min, max), or min when max <= min.
+func (r *Rand) F64Range(min, max fp.F64) fp.F64 {
+    if max.Raw <= min.Raw {
+        return min
+    }
+    return fp.F64FromRaw(min.Raw + int64(r.Uint64n(uint64(max.Raw-min.Raw))))
+}
+
+// F32 Returns a uniformly distributed value in [0, 1).
+func (r *Rand) F32() fp.F32 {
+    return fp.F32FromRaw(int32(r.Uint64() >> 48))
+}
+
+// F32Range Returns a uniformly distributed value in [min, max), or min when max <= min.
+func (r *Rand) F32Range(min, max fp.F32) fp.F32 {
+    if max.Raw <= min.Raw {
+        return min
+    }
+    return fp.F32FromRaw(min.Raw + int32(r.Uint64n(uint64(int64(max.Raw)-int64(min.Raw)))))
+}
+
+// signedUnit Returns a uniformly distributed raw F64 value in [-1, 1).
+func (r *Rand) signedUnit() int64 {
+    return int64(r.Uint64()) >> 31
+}
+
+// Weighted Returns an index into weights chosen with probability proportional to its weight. Negative
+// weights count as zero. It returns -1 when no weight is positive. The sum of the weights must not
+// exceed fp.F64MaxValue.
+func (r *Rand) Weighted(weights []fp.F64) int {
+    var total uint64
+    for _, w := range weights {
+        if w.Raw > 0 {
+            total += uint64(w.Raw)
+        }
+    }
+    if total == 0 {
+        return -1
+    }
+    u := r.Uint64n(total)
+    for i, w := range weights {
+        if w.Raw <= 0 {
+            continue
+        }
+        if u < uint64(w.Raw) {
+            return i
+        }
+        u -= uint64(w.Raw)
+    }
+    panic("unreachable")
+}
+
+/************************************/
+/************* Vectors **************/
+/************************************/
+
+// minLengthSqr Points closer to the origin are rejected before normalizing, as their direction is too coarse.
+var minLengthSqr = fp.F64Ratio(1, 16)
+
+// F64Vec2InDisk Returns a uniformly distributed point inside the unit disk.
+func (r *Rand) F64Vec2InDisk() fp.F64Vec2 {
+    for {
+        v := fp.F64Vec2FromRaw(r.signedUnit(), r.signedUnit())
+        if v.LengthSqr().LT(fp.F64One) {
+            return v
+        }
+    }
+}
+
+// F64Vec2OnCircle Returns a uniformly distributed direction on the unit circle.
+func (r *Rand) F64Vec2OnCircle() fp.F64Vec2 {
+    for {
+        v := r.F64Vec2InDisk()
+        if v.LengthSqr().GE(minLengthSqr) {
+            return v.Normalize()
+        }
+    }
+}
+
+// F64Vec3InBall Returns a uniformly distributed point inside the unit ball.
+func (r *Rand) F64Vec3InBall() fp.F64Vec3 {
+    for {
+        v := fp.F64Vec3FromRaw(r.signedUnit(), r.signedUnit(), r.signedUnit())
+        if v.LengthSqr().LT(fp.F64One) {
+            return v
+        }
+    }
+}
+
+// F64Vec3OnSphere Returns a uniformly distributed direction on the unit sphere.
+func (r *Rand) F64Vec3OnSphere() fp.F64Vec3 {
+    for {
+        v := r.F64Vec3InBall()
+        if v.LengthSqr().GE(minLengthSqr) {
+            return v.Normalize()
+        }
+    }
+}
+
+// F64Quat Returns a uniformly distributed rotation, using Shoemake's subgroup algorithm.
+func (r *Rand) F64Quat() fp.F64Quat {
+    u1 := r.F64()
+    a := fp.F64Pi2.Mul(r.F64())
+    b := fp.F64Pi2.Mul(r.F64())
+    s1 := fp.F64One.Sub(u1).Sqrt()
+    s2 := u1.Sqrt()
+    return fp.FromF64(s1.Mul(a.Sin()), s1.Mul(a.Cos()), s2.Mul(b.Sin()), s2.Mul(b.Cos()))
+}
+
+/************************************/
+/************** State ***************/
+/************************************/
+
+// MarshalBinary Implements encoding.BinaryMarshaler, returning the 32 byte generator state.
+func (r *Rand) MarshalBinary() ([]byte, error) {
+    data := make([]byte, 0, stateSize)
+    for _, s := range r.s {
+        data = binary.LittleEndian.AppendUint64(data, s)
+    }
+    return data, nil
+}
+
+// UnmarshalBinary Implements encoding.BinaryUnmarshaler, restoring a state written by MarshalBinary.
+func (r *Rand) UnmarshalBinary(data []byte) error {
+    if len(data) != stateSize {
+        return fmt.Errorf("rand: Rand.UnmarshalBinary: expected %d bytes, got %d", stateSize, len(data))
+    }
+    var s [4]uint64
+    for i := range s {
+        s[i] = binary.LittleEndian.Uint64(data[i*8:])
+    }
+    if s == [4]uint64{} {
+        return errors.New("rand: Rand.UnmarshalBinary: invalid all-zero state")
+    }
+    r.s = s
+    return nil
+}
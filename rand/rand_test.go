@@ -0,0 +1,117 @@
+package rand_test
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/rand"
+)
+
+func TestRand_Sequence(t *testing.T) {
+    // Reference values of xoshiro256** seeded by splitmix64.
+    r := rand.New(1)
+    for _, want := range []uint64{0xb3f2af6d0fc710c5, 0x853b559647364cea, 0x92f89756082a4514, 0x642e1c7bc266a3a7} {
+        assert.Equal(t, want, r.Uint64())
+    }
+    r = rand.New(0)
+    assert.Equal(t, uint64(0x99ec5f36cb75f2b4), r.Uint64())
+    assert.Equal(t, uint32(0xbf6e1f78), r.Uint32())
+}
+
+func TestRand_Pinned(t *testing.T) {
+    r := rand.New(42)
+    for _, want := range []int64{0x15780b2e, 0x6104d986, 0xae175332} {
+        assert.Equal(t, want, r.F64().Raw)
+    }
+    for _, want := range []int64{0x87e6d898c, 0x9d60939fd, 0x56510e7d4} {
+        assert.Equal(t, want, r.F64Range(fp.F64FromInt32(-10), fp.F64FromInt32(10)).Raw)
+    }
+    for _, want := range []int32{0xb821, 0xd99a, 0xc2e9} {
+        assert.Equal(t, want, r.F32().Raw)
+    }
+    for _, want := range []int32{109235, 161193, -44210} {
+        assert.Equal(t, want, r.F32Range(fp.F32FromInt32(-3), fp.F32FromInt32(5)).Raw)
+    }
+    assert.Equal(t, "(-0.5263672294, 0.8502573364)", fmt.Sprint(r.F64Vec2OnCircle()))
+    assert.Equal(t, "(-0.5777001104, -0.2444655409)", fmt.Sprint(r.F64Vec2InDisk()))
+    assert.Equal(t, "(-0.8229805953, 0.2615663533, 0.504267845)", fmt.Sprint(r.F64Vec3OnSphere()))
+    assert.Equal(t, "(-0.754537365, -0.27367464, -0.1104818294)", fmt.Sprint(r.F64Vec3InBall()))
+    assert.Equal(t, "(-0.430964666, -0.0081251452, -0.8811078006, 0.19455711)", fmt.Sprint(r.F64Quat()))
+    assert.Equal(t, []int{7, 1, 6, 4, 2, 5, 3, 0}, r.Perm(8))
+    assert.Equal(t, 898, r.Intn(1000))
+    assert.Equal(t, 0, r.Weighted([]fp.F64{fp.F64One, fp.F64Two, fp.F64Zero}))
+}
+
+func TestRand_Ranges(t *testing.T) {
+    r := rand.New(7)
+    tol := fp.F64FromRaw(512) // precision of Normalize
+    for i := 0; i < 2000; i++ {
+        f := r.F64()
+        assert.True(t, f.GE(fp.F64Zero) && f.LT(fp.F64One))
+        g := r.F32Range(fp.F32FromInt32(-2), fp.F32Half)
+        assert.True(t, g.GE(fp.F32FromInt32(-2)) && g.LT(fp.F32Half))
+        h := r.F64Range(fp.F64MinValue, fp.F64MaxValue)
+        assert.True(t, h.LT(fp.F64MaxValue))
+
+        assert.True(t, r.F64Vec2InDisk().LengthSqr().LT(fp.F64One))
+        assert.True(t, r.F64Vec3InBall().LengthSqr().LT(fp.F64One))
+        assert.True(t, r.F64Vec2OnCircle().Length().Sub(fp.F64One).Abs().LE(tol))
+        assert.True(t, r.F64Vec3OnSphere().Length().Sub(fp.F64One).Abs().LE(tol))
+        assert.True(t, r.F64Quat().Length().Sub(fp.F64One).Abs().LE(fp.F64FromRaw(1024)))
+    }
+    assert.Equal(t, fp.F64One, r.F64Range(fp.F64One, fp.F64One))
+}
+
+func TestRand_Weighted(t *testing.T) {
+    r := rand.New(3)
+    weights := []fp.F64{fp.F64One, fp.F64Zero, fp.F64FromInt32(3), fp.F64Neg1}
+    counts := make([]int, len(weights))
+    for i := 0; i < 40000; i++ {
+        counts[r.Weighted(weights)]++
+    }
+    assert.Equal(t, 0, counts[1])
+    assert.Equal(t, 0, counts[3])
+    assert.InDelta(t, 10000, counts[0], 400)
+    assert.InDelta(t, 30000, counts[2], 400)
+    assert.Equal(t, -1, r.Weighted([]fp.F64{fp.F64Zero}))
+    assert.Equal(t, -1, r.Weighted(nil))
+}
+
+func TestRand_Shuffle(t *testing.T) {
+    r := rand.New(5)
+    s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+    r.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+    seen := make(map[int]bool)
+    for _, v := range s {
+        seen[v] = true
+    }
+    assert.Len(t, seen, 10)
+    assert.NotEqual(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, s)
+    assert.Panics(t, func() { r.Intn(0) })
+}
+
+func TestRand_State(t *testing.T) {
+    r := rand.New(99)
+    r.Uint64()
+    data, err := r.MarshalBinary()
+    assert.NoError(t, err)
+    assert.Len(t, data, 32)
+
+    var restored rand.Rand
+    assert.NoError(t, restored.UnmarshalBinary(data))
+    copied := *r
+    for i := 0; i < 10; i++ {
+        want := r.F64()
+        assert.Equal(t, want, restored.F64())
+        assert.Equal(t, want, copied.F64())
+    }
+
+    assert.Error(t, restored.UnmarshalBinary(data[:31]))
+    assert.Error(t, restored.UnmarshalBinary(make([]byte, 32)))
+
+    r.Seed(99)
+    assert.Equal(t, *rand.New(99), *r)
+}
@@ -0,0 +1,370 @@
+package spatial
+
+import (
+    "sort"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+)
+
+// BVH A dynamic bounding volume hierarchy over 3D boxes: a binary tree whose leaves hold the boxes grown
+// by a margin and whose inner nodes hold the union of their children. New leaves go where they grow the
+// surface area of the tree least, and rotations keep the tree balanced. It needs no bounds or cell size,
+// and suits boxes of any size and distribution. A box that moves within its margin does not change the
+// tree.
+type BVH struct {
+    margin fp.F64
+    nodes  []bvhNode
+    free   []int32
+    root   int32
+    leaves map[int]int32
+}
+
+type bvhNode struct {
+    box         fp.F64AABB3 // grown by the margin for leaves
+    tight       fp.F64AABB3 // the box of a leaf
+    parent      int32
+    left, right int32 // -1 for leaves
+    height      int32
+    id          int
+}
+
+// NewBVH Returns an empty hierarchy that grows the boxes of leaves by the margin.
+func NewBVH(margin fp.F64) *BVH {
+    return &BVH{margin: margin, root: -1, leaves: map[int]int32{}}
+}
+
+func (b *BVH) Insert(id int, box fp.F64AABB3) {
+    if leaf, ok := b.leaves[id]; ok {
+        b.moveLeaf(leaf, box)
+        return
+    }
+    leaf := b.alloc()
+    b.nodes[leaf] = bvhNode{box: box.Expand(b.margin), tight: box, parent: -1, left: -1, right: -1, id: id}
+    b.leaves[id] = leaf
+    b.insertLeaf(leaf)
+}
+
+func (b *BVH) Update(id int, box fp.F64AABB3) bool {
+    leaf, ok := b.leaves[id]
+    if ok {
+        b.moveLeaf(leaf, box)
+    }
+    return ok
+}
+
+func (b *BVH) Remove(id int) bool {
+    leaf, ok := b.leaves[id]
+    if !ok {
+        return false
+    }
+    delete(b.leaves, id)
+    b.removeLeaf(leaf)
+    b.release(leaf)
+    return true
+}
+
+func (b *BVH) Len() int {
+    return len(b.leaves)
+}
+
+func (b *BVH) Box(id int) (fp.F64AABB3, bool) {
+    leaf, ok := b.leaves[id]
+    if !ok {
+        return fp.F64AABB3{}, false
+    }
+    return b.nodes[leaf].tight, true
+}
+
+func (b *BVH) Query(box fp.F64AABB3, dst []int) []int {
+    start := len(dst)
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &b.nodes[n]
+        if !node.box.Overlaps(box) {
+            return
+        }
+        if node.left < 0 {
+            if node.tight.Overlaps(box) {
+                dst = append(dst, node.id)
+            }
+            return
+        }
+        visit(node.left)
+        visit(node.right)
+    }
+    if b.root >= 0 {
+        visit(b.root)
+    }
+    return sortedUnique(dst, start)
+}
+
+func (b *BVH) QueryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int {
+    start, r := len(dst), distOf(radius)
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &b.nodes[n]
+        if distTo(center, node.box).cmp(r) > 0 {
+            return
+        }
+        if node.left < 0 {
+            if distTo(center, node.tight).cmp(r) <= 0 {
+                dst = append(dst, node.id)
+            }
+            return
+        }
+        visit(node.left)
+        visit(node.right)
+    }
+    if b.root >= 0 && radius.Raw >= 0 {
+        visit(b.root)
+    }
+    return sortedUnique(dst, start)
+}
+
+func (b *BVH) Nearest(p fp.F64Vec3, k int, dst []int) []int {
+    if k <= 0 {
+        return dst
+    }
+    best := nearest{k: k}
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &b.nodes[n]
+        if node.left < 0 {
+            best.offer(node.id, distTo(p, node.tight))
+            return
+        }
+        // The nearer child first, so that the farther one is more likely to be pruned.
+        children := [2]neighbor{
+            {d: distTo(p, b.nodes[node.left].box), id: int(node.left)},
+            {d: distTo(p, b.nodes[node.right].box), id: int(node.right)},
+        }
+        sort.Slice(children[:], func(i, j int) bool { return children[i].less(children[j]) })
+        for _, c := range children {
+            if !best.prunes(c.d) {
+                visit(int32(c.id))
+            }
+        }
+    }
+    if b.root >= 0 {
+        visit(b.root)
+    }
+    return best.appendTo(dst)
+}
+
+func (b *BVH) Raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    h := hit{maxT: maxT}
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &b.nodes[n]
+        if t, _, ok := ray.IntersectAABB(node.box); !ok || h.prunes(t) {
+            return
+        }
+        if node.left < 0 {
+            h.offer(node.id, ray, node.tight)
+            return
+        }
+        visit(node.left)
+        visit(node.right)
+    }
+    if b.root >= 0 {
+        visit(b.root)
+    }
+    return h.id, h.t, h.ok
+}
+
+// Height Returns the number of levels of the tree, which is about log2 of Len when it is balanced.
+func (b *BVH) Height() int {
+    if b.root < 0 {
+        return 0
+    }
+    return int(b.nodes[b.root].height) + 1
+}
+
+func (b *BVH) alloc() int32 {
+    if k := len(b.free); k > 0 {
+        n := b.free[k-1]
+        b.free = b.free[:k-1]
+        return n
+    }
+    b.nodes = append(b.nodes, bvhNode{})
+    return int32(len(b.nodes) - 1)
+}
+
+func (b *BVH) release(n int32) {
+    b.nodes[n] = bvhNode{}
+    b.free = append(b.free, n)
+}
+
+// moveLeaf Sets the box of the leaf, and moves the leaf in the tree if the box left its margin.
+func (b *BVH) moveLeaf(leaf int32, box fp.F64AABB3) {
+    node := &b.nodes[leaf]
+    node.tight = box
+    if node.box.ContainsAABB(box) {
+        return
+    }
+    b.removeLeaf(leaf)
+    b.nodes[leaf].box = box.Expand(b.margin)
+    b.insertLeaf(leaf)
+}
+
+// unionArea Returns the surface area of the union of the boxes, saturated.
+func unionArea(a, c fp.F64AABB3) int64 {
+    return a.Union(c).SurfaceArea().Raw
+}
+
+func (b *BVH) insertLeaf(leaf int32) {
+    if b.root < 0 {
+        b.root = leaf
+        b.nodes[leaf].parent = -1
+        return
+    }
+
+    // Descend to the sibling that grows the total surface area least.
+    box := b.nodes[leaf].box
+    n := b.root
+    for b.nodes[n].left >= 0 {
+        node := &b.nodes[n]
+        area := node.box.SurfaceArea().Raw
+        combined := unionArea(node.box, box)
+        // A new parent here costs its area, and every ancestor grows as in the inheritance.
+        here := fix64.AddSat(combined, combined)
+        inheritance := fix64.AddSat(fix64.SubSat(combined, area), fix64.SubSat(combined, area))
+        child := func(c int32) int64 {
+            cb := b.nodes[c].box
+            if b.nodes[c].left < 0 {
+                return fix64.AddSat(unionArea(cb, box), inheritance)
+            }
+            return fix64.AddSat(fix64.SubSat(unionArea(cb, box), cb.SurfaceArea().Raw), inheritance)
+        }
+        left, right := child(node.left), child(node.right)
+        if here < left && here < right {
+            break
+        }
+        if left <= right {
+            n = node.left
+        } else {
+            n = node.right
+        }
+    }
+
+    sibling := n
+    oldParent := b.nodes[sibling].parent
+    parent := b.alloc()
+    b.nodes[parent] = bvhNode{
+        box:    b.nodes[sibling].box.Union(box),
+        parent: oldParent,
+        left:   sibling,
+        right:  leaf,
+        height: b.nodes[sibling].height + 1,
+    }
+    if oldParent >= 0 {
+        b.replaceChild(oldParent, sibling, parent)
+    } else {
+        b.root = parent
+    }
+    b.nodes[sibling].parent = parent
+    b.nodes[leaf].parent = parent
+    b.refit(parent)
+}
+
+func (b *BVH) removeLeaf(leaf int32) {
+    if leaf == b.root {
+        b.root = -1
+        return
+    }
+    parent := b.nodes[leaf].parent
+    grand := b.nodes[parent].parent
+    sibling := b.nodes[parent].left
+    if sibling == leaf {
+        sibling = b.nodes[parent].right
+    }
+    if grand >= 0 {
+        b.replaceChild(grand, parent, sibling)
+        b.nodes[sibling].parent = grand
+        b.release(parent)
+        b.refit(grand)
+    } else {
+        b.root = sibling
+        b.nodes[sibling].parent = -1
+        b.release(parent)
+    }
+}
+
+func (b *BVH) replaceChild(parent, old, child int32) {
+    if b.nodes[parent].left == old {
+        b.nodes[parent].left = child
+    } else {
+        b.nodes[parent].right = child
+    }
+}
+
+// refit Rebalances n and its ancestors and recomputes their boxes and heights.
+func (b *BVH) refit(n int32) {
+    for n >= 0 {
+        n = b.balance(n)
+        node := &b.nodes[n]
+        l, r := &b.nodes[node.left], &b.nodes[node.right]
+        node.height = maxHeight(l.height, r.height) + 1
+        node.box = l.box.Union(r.box)
+        n = node.parent
+    }
+}
+
+// balance Rotates the taller child of a up if the heights of its children differ by more than one, and
+// returns the node now at the place of a.
+func (b *BVH) balance(a int32) int32 {
+    A := &b.nodes[a]
+    if A.left < 0 || A.height < 2 {
+        return a
+    }
+    d := b.nodes[A.right].height - b.nodes[A.left].height
+    switch {
+    case d > 1:
+        return b.rotate(a, A.right, A.left, false)
+    case d < -1:
+        return b.rotate(a, A.left, A.right, true)
+    default:
+        return a
+    }
+}
+
+// rotate Moves the child up to the place of a, and makes a its child. a keeps other, its other child, and
+// takes the shorter child of up. left tells whether up was the left child of a.
+func (b *BVH) rotate(a, up, other int32, left bool) int32 {
+    U := &b.nodes[up]
+    f, g := U.left, U.right
+    if b.nodes[f].height > b.nodes[g].height {
+        f, g = g, f // keep the taller grandchild g under up
+    }
+    U.left, U.right = a, g
+    U.parent = b.nodes[a].parent
+    if U.parent >= 0 {
+        b.replaceChild(U.parent, a, up)
+    } else {
+        b.root = up
+    }
+
+    A := &b.nodes[a]
+    A.parent = up
+    if left {
+        A.left = f
+    } else {
+        A.right = f
+    }
+    b.nodes[f].parent = a
+    A.box = b.nodes[other].box.Union(b.nodes[f].box)
+    A.height = maxHeight(b.nodes[other].height, b.nodes[f].height) + 1
+
+    U = &b.nodes[up]
+    U.box = A.box.Union(b.nodes[g].box)
+    U.height = maxHeight(A.height, b.nodes[g].height) + 1
+    return up
+}
+
+func maxHeight(a, b int32) int32 {
+    if a > b {
+        return a
+    }
+    return b
+}
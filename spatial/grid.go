@@ -0,0 +1,391 @@
+package spatial
+
+import (
+    "math"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+)
+
+// maxCellsPerItem Boxes covering more grid cells are kept in a separate list and tested by every query.
+const maxCellsPerItem = 64
+
+// cellKey The integer coordinates of a grid cell.
+type cellKey [3]int64
+
+// hashGrid A uniform grid of square or cubic cells, stored sparsely in a map. Every box is listed in each
+// cell it overlaps, so queries only look at the cells they cover.
+type hashGrid struct {
+    cellSize fp.F64
+    cells    map[cellKey][]int
+    items    map[int]gridItem
+    large    []int
+    scratch  []int
+    // lo, hi The range of cells that held a box at some point, which bounds the ray traversal.
+    lo, hi cellKey
+}
+
+type gridItem struct {
+    box    fp.F64AABB3
+    lo, hi cellKey
+    large  bool
+}
+
+func newHashGrid(cellSize fp.F64) hashGrid {
+    if cellSize.Raw <= 0 {
+        panic("spatial: cell size must be positive")
+    }
+    return hashGrid{cellSize: cellSize, cells: map[cellKey][]int{}, items: map[int]gridItem{}}
+}
+
+// cellOf Returns the index of the cell containing the coordinate v.
+func (g *hashGrid) cellOf(v int64) int64 {
+    c := g.cellSize.Raw
+    q := v / c
+    if v%c < 0 {
+        q--
+    }
+    return q
+}
+
+func (g *hashGrid) cellRange(box fp.F64AABB3) (cellKey, cellKey) {
+    return cellKey{g.cellOf(box.Min.RawX), g.cellOf(box.Min.RawY), g.cellOf(box.Min.RawZ)},
+        cellKey{g.cellOf(box.Max.RawX), g.cellOf(box.Max.RawY), g.cellOf(box.Max.RawZ)}
+}
+
+// cellCount Returns the number of cells from lo to hi, saturated at math.MaxInt64.
+func cellCount(lo, hi cellKey) uint64 {
+    n := uint64(1)
+    for i := range lo {
+        d := uint64(hi[i]-lo[i]) + 1
+        if hi[i] < lo[i] {
+            return 0
+        }
+        if d == 0 || n > math.MaxInt64/d {
+            return math.MaxInt64
+        }
+        n *= d
+    }
+    return n
+}
+
+func (g *hashGrid) insert(id int, box fp.F64AABB3) {
+    g.remove(id)
+    lo, hi := g.cellRange(box)
+    it := gridItem{box: box, lo: lo, hi: hi, large: box.IsEmpty() || cellCount(lo, hi) > maxCellsPerItem}
+    g.items[id] = it
+    if it.large {
+        g.large = append(g.large, id)
+        return
+    }
+    if len(g.cells) == 0 {
+        g.lo, g.hi = lo, hi
+    }
+    for i := range lo {
+        g.lo[i], g.hi[i] = fix64.Min(g.lo[i], lo[i]), fix64.Max(g.hi[i], hi[i])
+    }
+    forCells(lo, hi, func(k cellKey) {
+        g.cells[k] = append(g.cells[k], id)
+    })
+}
+
+func (g *hashGrid) update(id int, box fp.F64AABB3) bool {
+    it, ok := g.items[id]
+    if !ok {
+        return false
+    }
+    if lo, hi := g.cellRange(box); !it.large && lo == it.lo && hi == it.hi {
+        it.box = box
+        g.items[id] = it
+        return true
+    }
+    g.insert(id, box)
+    return true
+}
+
+func (g *hashGrid) remove(id int) bool {
+    it, ok := g.items[id]
+    if !ok {
+        return false
+    }
+    delete(g.items, id)
+    if it.large {
+        g.large = removeID(g.large, id)
+        return true
+    }
+    forCells(it.lo, it.hi, func(k cellKey) {
+        if ids := removeID(g.cells[k], id); len(ids) > 0 {
+            g.cells[k] = ids
+        } else {
+            delete(g.cells, k)
+        }
+    })
+    return true
+}
+
+func (g *hashGrid) box(id int) (fp.F64AABB3, bool) {
+    it, ok := g.items[id]
+    return it.box, ok
+}
+
+// candidates Appends the ids of the boxes that may overlap box, possibly more than once.
+func (g *hashGrid) candidates(box fp.F64AABB3, dst []int) []int {
+    dst = append(dst, g.large...)
+    lo, hi := g.cellRange(box)
+    if n := cellCount(lo, hi); n > maxCellsPerItem && n > uint64(len(g.items)) {
+        // Cheaper to look at every box than at every cell.
+        for id, it := range g.items {
+            if !it.large {
+                dst = append(dst, id)
+            }
+        }
+        return dst
+    }
+    forCells(lo, hi, func(k cellKey) {
+        dst = append(dst, g.cells[k]...)
+    })
+    return dst
+}
+
+func (g *hashGrid) query(box fp.F64AABB3, dst []int) []int {
+    start := len(dst)
+    g.scratch = g.candidates(box, g.scratch[:0])
+    for _, id := range g.scratch {
+        if g.items[id].box.Overlaps(box) {
+            dst = append(dst, id)
+        }
+    }
+    return sortedUnique(dst, start)
+}
+
+func (g *hashGrid) queryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int {
+    start := len(dst)
+    if radius.Raw < 0 {
+        return dst
+    }
+    r := distOf(radius)
+    g.scratch = g.candidates(fp.F64AABB3FromCenterExtents(center, fp.F64Vec3FromF64(radius, radius, radius)), g.scratch[:0])
+    for _, id := range g.scratch {
+        if distTo(center, g.items[id].box).cmp(r) <= 0 {
+            dst = append(dst, id)
+        }
+    }
+    return sortedUnique(dst, start)
+}
+
+func (g *hashGrid) nearest(p fp.F64Vec3, k int, dst []int) []int {
+    if k <= 0 || len(g.items) == 0 {
+        return dst
+    }
+    // Search ever larger cubes around p. Once the k-th best lies within the cube's inner radius, no box
+    // outside the cube can be nearer.
+    for r := g.cellSize; ; r = fp.F64FromRaw(fix64.AddSat(r.Raw, r.Raw)) {
+        best := nearest{k: k}
+        g.scratch = sortedUnique(g.candidates(fp.F64AABB3FromCenterExtents(p, fp.F64Vec3FromF64(r, r, r)), g.scratch[:0]), 0)
+        for _, id := range g.scratch {
+            best.offer(id, distTo(p, g.items[id].box))
+        }
+        if len(g.scratch) == len(g.items) || (len(best.best) == k && best.best[k-1].d.cmp(distOf(r)) <= 0) {
+            return best.appendTo(dst)
+        }
+    }
+}
+
+func (g *hashGrid) raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    h := hit{maxT: maxT}
+    for _, id := range g.large {
+        h.offer(id, ray, g.items[id].box)
+    }
+    if len(g.cells) == 0 {
+        return h.id, h.t, h.ok
+    }
+
+    // Walk the cells along the ray with a 3D DDA, from where it enters the occupied range.
+    region := fp.F64AABB3FromMinMax(g.corner(g.lo, 0), g.corner(g.hi, 1))
+    t0, p, ok := ray.IntersectAABB(region)
+    if !ok || h.prunes(t0) {
+        return h.id, h.t, h.ok
+    }
+    origin := [3]int64{ray.Origin.RawX, ray.Origin.RawY, ray.Origin.RawZ}
+    dir := [3]int64{ray.Dir.RawX, ray.Dir.RawY, ray.Dir.RawZ}
+    cell := cellKey{g.cellOf(p.RawX), g.cellOf(p.RawY), g.cellOf(p.RawZ)}
+    var next [3]int64
+    for i := range cell {
+        cell[i] = fix64.Clamp(cell[i], g.lo[i], g.hi[i])
+        next[i] = g.crossing(origin[i], dir[i], cell[i])
+    }
+    for {
+        for _, id := range g.cells[cell] {
+            h.offer(id, ray, g.items[id].box)
+        }
+        axis := 0
+        for i := 1; i < 3; i++ {
+            if next[i] < next[axis] {
+                axis = i
+            }
+        }
+        // Later cells are only entered after the ray leaves this one.
+        if next[axis] == fix64.MaxValue || h.prunes(fp.F64FromRaw(next[axis])) {
+            return h.id, h.t, h.ok
+        }
+        if dir[axis] > 0 {
+            cell[axis]++
+        } else {
+            cell[axis]--
+        }
+        if cell[axis] < g.lo[axis] || cell[axis] > g.hi[axis] {
+            return h.id, h.t, h.ok
+        }
+        next[axis] = g.crossing(origin[axis], dir[axis], cell[axis])
+    }
+}
+
+// corner Returns the lower corner of the cell k, or its upper corner for upper = 1, saturated.
+func (g *hashGrid) corner(k cellKey, upper int64) fp.F64Vec3 {
+    var v [3]int64
+    for i := range k {
+        v[i] = g.boundary(k[i] + upper)
+    }
+    return fp.F64Vec3FromRaw(v[0], v[1], v[2])
+}
+
+// boundary Returns the coordinate of the lower boundary of the cell with index i, saturated.
+func (g *hashGrid) boundary(i int64) int64 {
+    c := g.cellSize.Raw
+    if i > fix64.MaxValue/c {
+        return fix64.MaxValue
+    }
+    if i < fix64.MinValue/c {
+        return fix64.MinValue
+    }
+    return i * c
+}
+
+// crossing Returns the ray parameter at which the ray leaves the cell with index i along one axis, or
+// fix64.MaxValue if it never does.
+func (g *hashGrid) crossing(origin, dir, i int64) int64 {
+    switch {
+    case dir > 0:
+        return fix64.DivSat(fix64.SubSat(g.boundary(i+1), origin), dir)
+    case dir < 0:
+        return fix64.DivSat(fix64.SubSat(g.boundary(i), origin), dir)
+    default:
+        return fix64.MaxValue
+    }
+}
+
+// forCells Calls f for every cell from lo to hi, in z, y, x order.
+func forCells(lo, hi cellKey, f func(k cellKey)) {
+    for z := lo[2]; z <= hi[2]; z++ {
+        for y := lo[1]; y <= hi[1]; y++ {
+            for x := lo[0]; x <= hi[0]; x++ {
+                f(cellKey{x, y, z})
+            }
+        }
+    }
+}
+
+// removeID Removes the first occurrence of id from ids.
+func removeID(ids []int, id int) []int {
+    for i, o := range ids {
+        if o == id {
+            return append(ids[:i], ids[i+1:]...)
+        }
+    }
+    return ids
+}
+
+// Grid2 A uniform hash grid over 2D boxes, best for many boxes of similar sizes that move every step.
+// Cells should be about the size of a typical box; boxes covering more than 64 cells are kept in a list
+// tested by every query.
+type Grid2 struct {
+    g hashGrid
+}
+
+// NewGrid2 Returns an empty grid with square cells of the size. It panics if the size is not positive.
+func NewGrid2(cellSize fp.F64) *Grid2 {
+    return &Grid2{g: newHashGrid(cellSize)}
+}
+
+func (g *Grid2) Insert(id int, box fp.F64AABB2) {
+    g.g.insert(id, box3(box))
+}
+
+func (g *Grid2) Update(id int, box fp.F64AABB2) bool {
+    return g.g.update(id, box3(box))
+}
+
+func (g *Grid2) Remove(id int) bool {
+    return g.g.remove(id)
+}
+
+func (g *Grid2) Len() int {
+    return len(g.g.items)
+}
+
+func (g *Grid2) Box(id int) (fp.F64AABB2, bool) {
+    b, ok := g.g.box(id)
+    return box2(b), ok
+}
+
+func (g *Grid2) Query(box fp.F64AABB2, dst []int) []int {
+    return g.g.query(box3(box), dst)
+}
+
+func (g *Grid2) QueryRadius(center fp.F64Vec2, radius fp.F64, dst []int) []int {
+    return g.g.queryRadius(vec3(center), radius, dst)
+}
+
+func (g *Grid2) Nearest(p fp.F64Vec2, k int, dst []int) []int {
+    return g.g.nearest(vec3(p), k, dst)
+}
+
+func (g *Grid2) Raycast(origin, dir fp.F64Vec2, maxT fp.F64) (int, fp.F64, bool) {
+    return g.g.raycast(fp.F64Ray3FromOriginDir(vec3(origin), vec3(dir)), maxT)
+}
+
+// Grid3 A uniform hash grid over 3D boxes, see Grid2.
+type Grid3 struct {
+    g hashGrid
+}
+
+// NewGrid3 Returns an empty grid with cubic cells of the size. It panics if the size is not positive.
+func NewGrid3(cellSize fp.F64) *Grid3 {
+    return &Grid3{g: newHashGrid(cellSize)}
+}
+
+func (g *Grid3) Insert(id int, box fp.F64AABB3) {
+    g.g.insert(id, box)
+}
+
+func (g *Grid3) Update(id int, box fp.F64AABB3) bool {
+    return g.g.update(id, box)
+}
+
+func (g *Grid3) Remove(id int) bool {
+    return g.g.remove(id)
+}
+
+func (g *Grid3) Len() int {
+    return len(g.g.items)
+}
+
+func (g *Grid3) Box(id int) (fp.F64AABB3, bool) {
+    return g.g.box(id)
+}
+
+func (g *Grid3) Query(box fp.F64AABB3, dst []int) []int {
+    return g.g.query(box, dst)
+}
+
+func (g *Grid3) QueryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int {
+    return g.g.queryRadius(center, radius, dst)
+}
+
+func (g *Grid3) Nearest(p fp.F64Vec3, k int, dst []int) []int {
+    return g.g.nearest(p, k, dst)
+}
+
+func (g *Grid3) Raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    return g.g.raycast(ray, maxT)
+}
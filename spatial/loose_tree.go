@@ -0,0 +1,372 @@
+package spatial
+
+import (
+    "sort"
+
+    "github.com/camry/fp"
+)
+
+// looseTree A loose quadtree or octree. Every node covers a cell of the bounds and holds the boxes whose
+// centers lie in the cell and whose sizes are at most half that of the cell, so that each box lies within
+// the cell grown by half its size on every side, the loose bounds. A box therefore lives in exactly one
+// node, found from its center and size alone, and moving it rarely changes the node.
+type looseTree struct {
+    dims     int // 2 for a quadtree, 3 for an octree
+    maxDepth int
+    nodes    []treeNode
+    free     []int32
+    items    map[int]treeItem
+}
+
+type treeNode struct {
+    cell     fp.F64AABB3
+    loose    fp.F64AABB3
+    parent   int32
+    depth    int32
+    children [8]int32 // 0 for none, as the root is never a child
+    ids      []int
+}
+
+type treeItem struct {
+    box  fp.F64AABB3
+    node int32
+}
+
+func newLooseTree(bounds fp.F64AABB3, dims, maxDepth int) looseTree {
+    // The root also holds the boxes outside the bounds, so its loose bounds are unlimited.
+    root := treeNode{cell: bounds, loose: everything, parent: -1}
+    return looseTree{dims: dims, maxDepth: maxDepth, nodes: []treeNode{root}, items: map[int]treeItem{}}
+}
+
+func (t *looseTree) insert(id int, box fp.F64AABB3) {
+    if it, ok := t.items[id]; ok {
+        if n := t.place(box, false); n == it.node {
+            t.items[id] = treeItem{box: box, node: n}
+            return
+        }
+        t.remove(id)
+    }
+    n := t.place(box, true)
+    t.nodes[n].ids = append(t.nodes[n].ids, id)
+    t.items[id] = treeItem{box: box, node: n}
+}
+
+func (t *looseTree) update(id int, box fp.F64AABB3) bool {
+    if _, ok := t.items[id]; !ok {
+        return false
+    }
+    t.insert(id, box)
+    return true
+}
+
+func (t *looseTree) remove(id int) bool {
+    it, ok := t.items[id]
+    if !ok {
+        return false
+    }
+    delete(t.items, id)
+    n := it.node
+    ids := t.nodes[n].ids
+    for i, o := range ids {
+        if o == id {
+            t.nodes[n].ids = append(ids[:i], ids[i+1:]...)
+            break
+        }
+    }
+    // Release the nodes left without boxes or children.
+    for n != 0 && len(t.nodes[n].ids) == 0 && t.nodes[n].children == [8]int32{} {
+        p := t.nodes[n].parent
+        for i, c := range t.nodes[p].children {
+            if c == n {
+                t.nodes[p].children[i] = 0
+            }
+        }
+        t.nodes[n] = treeNode{}
+        t.free = append(t.free, n)
+        n = p
+    }
+    return true
+}
+
+func (t *looseTree) box(id int) (fp.F64AABB3, bool) {
+    it, ok := t.items[id]
+    return it.box, ok
+}
+
+// place Returns the node for the box, creating the missing nodes on the way when create is set. Without
+// create it returns -1 if the node does not exist.
+func (t *looseTree) place(box fp.F64AABB3, create bool) int32 {
+    center, ext := box.Center(), box.Extents()
+    n := int32(0)
+    for {
+        node := &t.nodes[n]
+        if int(node.depth) >= t.maxDepth || !node.cell.Contains(center) {
+            return n
+        }
+        half := node.cell.Extents()
+        if ext.X().GT(half.X().Div2()) || ext.Y().GT(half.Y().Div2()) || (t.dims == 3 && ext.Z().GT(half.Z().Div2())) {
+            return n
+        }
+        i := t.childIndex(node.cell, center)
+        c := node.children[i]
+        if c == 0 {
+            if !create {
+                return -1
+            }
+            c = t.newChild(n, i)
+        }
+        n = c
+    }
+}
+
+// childIndex Returns the child of the cell containing p, with bit 0 set for the upper half in x, bit 1
+// for y and bit 2 for z.
+func (t *looseTree) childIndex(cell fp.F64AABB3, p fp.F64Vec3) int {
+    c := cell.Center()
+    i := 0
+    if p.RawX >= c.RawX {
+        i |= 1
+    }
+    if p.RawY >= c.RawY {
+        i |= 2
+    }
+    if t.dims == 3 && p.RawZ >= c.RawZ {
+        i |= 4
+    }
+    return i
+}
+
+func (t *looseTree) newChild(parent int32, i int) int32 {
+    pc := t.nodes[parent].cell
+    c := pc.Center()
+    min, max := pc.Min, pc.Max
+    if i&1 != 0 {
+        min.RawX = c.RawX
+    } else {
+        max.RawX = c.RawX
+    }
+    if i&2 != 0 {
+        min.RawY = c.RawY
+    } else {
+        max.RawY = c.RawY
+    }
+    if t.dims == 3 {
+        if i&4 != 0 {
+            min.RawZ = c.RawZ
+        } else {
+            max.RawZ = c.RawZ
+        }
+    }
+    cell := fp.F64AABB3FromMinMax(min, max)
+    e := cell.Extents()
+    node := treeNode{
+        cell:   cell,
+        loose:  fp.F64AABB3FromCenterExtents(cell.Center(), e.Add(e)),
+        parent: parent,
+        depth:  t.nodes[parent].depth + 1,
+    }
+    var n int32
+    if k := len(t.free); k > 0 {
+        n, t.free = t.free[k-1], t.free[:k-1]
+        t.nodes[n] = node
+    } else {
+        n = int32(len(t.nodes))
+        t.nodes = append(t.nodes, node)
+    }
+    t.nodes[parent].children[i] = n
+    return n
+}
+
+func (t *looseTree) query(box fp.F64AABB3, dst []int) []int {
+    start := len(dst)
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &t.nodes[n]
+        if !node.loose.Overlaps(box) {
+            return
+        }
+        for _, id := range node.ids {
+            if t.items[id].box.Overlaps(box) {
+                dst = append(dst, id)
+            }
+        }
+        for _, c := range node.children {
+            if c != 0 {
+                visit(c)
+            }
+        }
+    }
+    visit(0)
+    return sortedUnique(dst, start)
+}
+
+func (t *looseTree) queryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int {
+    start, r := len(dst), distOf(radius)
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &t.nodes[n]
+        if distTo(center, node.loose).cmp(r) > 0 {
+            return
+        }
+        for _, id := range node.ids {
+            if distTo(center, t.items[id].box).cmp(r) <= 0 {
+                dst = append(dst, id)
+            }
+        }
+        for _, c := range node.children {
+            if c != 0 {
+                visit(c)
+            }
+        }
+    }
+    if radius.Raw >= 0 {
+        visit(0)
+    }
+    return sortedUnique(dst, start)
+}
+
+func (t *looseTree) nearest(p fp.F64Vec3, k int, dst []int) []int {
+    if k <= 0 {
+        return dst
+    }
+    best := nearest{k: k}
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &t.nodes[n]
+        for _, id := range node.ids {
+            best.offer(id, distTo(p, t.items[id].box))
+        }
+        // Nearer children first, so that the farther ones are more likely to be pruned.
+        var order [8]neighbor
+        m := 0
+        for _, c := range node.children {
+            if c != 0 {
+                order[m] = neighbor{d: distTo(p, t.nodes[c].loose), id: int(c)}
+                m++
+            }
+        }
+        sort.Slice(order[:m], func(i, j int) bool { return order[i].less(order[j]) })
+        for _, c := range order[:m] {
+            if !best.prunes(c.d) {
+                visit(int32(c.id))
+            }
+        }
+    }
+    visit(0)
+    return best.appendTo(dst)
+}
+
+func (t *looseTree) raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    h := hit{maxT: maxT}
+    var visit func(n int32)
+    visit = func(n int32) {
+        node := &t.nodes[n]
+        for _, id := range node.ids {
+            h.offer(id, ray, t.items[id].box)
+        }
+        for _, c := range node.children {
+            if c == 0 {
+                continue
+            }
+            if tc, _, ok := ray.IntersectAABB(t.nodes[c].loose); ok && !h.prunes(tc) {
+                visit(c)
+            }
+        }
+    }
+    visit(0)
+    return h.id, h.t, h.ok
+}
+
+// Quadtree A loose quadtree over 2D boxes, best for boxes of very different sizes spread unevenly over
+// known bounds. Boxes outside the bounds are still found, but are all kept in the root.
+type Quadtree struct {
+    t looseTree
+}
+
+// NewQuadtree Returns an empty quadtree over the bounds that splits cells at most maxDepth times.
+func NewQuadtree(bounds fp.F64AABB2, maxDepth int) *Quadtree {
+    return &Quadtree{t: newLooseTree(box3(bounds), 2, maxDepth)}
+}
+
+func (q *Quadtree) Insert(id int, box fp.F64AABB2) {
+    q.t.insert(id, box3(box))
+}
+
+func (q *Quadtree) Update(id int, box fp.F64AABB2) bool {
+    return q.t.update(id, box3(box))
+}
+
+func (q *Quadtree) Remove(id int) bool {
+    return q.t.remove(id)
+}
+
+func (q *Quadtree) Len() int {
+    return len(q.t.items)
+}
+
+func (q *Quadtree) Box(id int) (fp.F64AABB2, bool) {
+    b, ok := q.t.box(id)
+    return box2(b), ok
+}
+
+func (q *Quadtree) Query(box fp.F64AABB2, dst []int) []int {
+    return q.t.query(box3(box), dst)
+}
+
+func (q *Quadtree) QueryRadius(center fp.F64Vec2, radius fp.F64, dst []int) []int {
+    return q.t.queryRadius(vec3(center), radius, dst)
+}
+
+func (q *Quadtree) Nearest(p fp.F64Vec2, k int, dst []int) []int {
+    return q.t.nearest(vec3(p), k, dst)
+}
+
+func (q *Quadtree) Raycast(origin, dir fp.F64Vec2, maxT fp.F64) (int, fp.F64, bool) {
+    return q.t.raycast(fp.F64Ray3FromOriginDir(vec3(origin), vec3(dir)), maxT)
+}
+
+// Octree A loose octree over 3D boxes, see Quadtree.
+type Octree struct {
+    t looseTree
+}
+
+// NewOctree Returns an empty octree over the bounds that splits cells at most maxDepth times.
+func NewOctree(bounds fp.F64AABB3, maxDepth int) *Octree {
+    return &Octree{t: newLooseTree(bounds, 3, maxDepth)}
+}
+
+func (o *Octree) Insert(id int, box fp.F64AABB3) {
+    o.t.insert(id, box)
+}
+
+func (o *Octree) Update(id int, box fp.F64AABB3) bool {
+    return o.t.update(id, box)
+}
+
+func (o *Octree) Remove(id int) bool {
+    return o.t.remove(id)
+}
+
+func (o *Octree) Len() int {
+    return len(o.t.items)
+}
+
+func (o *Octree) Box(id int) (fp.F64AABB3, bool) {
+    return o.t.box(id)
+}
+
+func (o *Octree) Query(box fp.F64AABB3, dst []int) []int {
+    return o.t.query(box, dst)
+}
+
+func (o *Octree) QueryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int {
+    return o.t.queryRadius(center, radius, dst)
+}
+
+func (o *Octree) Nearest(p fp.F64Vec3, k int, dst []int) []int {
+    return o.t.nearest(p, k, dst)
+}
+
+func (o *Octree) Raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    return o.t.raycast(ray, maxT)
+}
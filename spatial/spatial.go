@@ -0,0 +1,218 @@
+package spatial
+
+import (
+    "math/bits"
+    "sort"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/fix64"
+)
+
+// Deterministic spatial indexes for F64Vec2 and F64Vec3 entities.
+//
+// Entities are bounding boxes identified by an int chosen by the caller; a point is a box whose Min equals
+// its Max. Quadtree and Grid2 index 2D boxes, Octree, Grid3 and BVH index 3D boxes, and all of them answer
+// the same queries with the same results:
+//
+//   - Query and QueryRadius return the ids of the boxes overlapping a box or a circle/sphere, touching
+//     boundaries included, in ascending id order.
+//   - Nearest returns up to k ids ordered by the distance from a point to their boxes, which is zero for
+//     boxes containing the point, with ties broken by the smaller id.
+//   - Raycast returns the box the ray enters first, with ties broken by the smaller id.
+//
+// Distances are compared exactly in 192-bit arithmetic and no result depends on map iteration order, so
+// the same operations give the same results on every machine. None of the indexes is safe for concurrent
+// use.
+
+// Index2 The operations shared by the 2D indexes.
+type Index2 interface {
+    // Insert Adds the box with the id, or moves it there if the id is already present.
+    Insert(id int, box fp.F64AABB2)
+    // Update Moves the box with the id, and reports whether the id was present.
+    Update(id int, box fp.F64AABB2) bool
+    // Remove Removes the id, and reports whether it was present.
+    Remove(id int) bool
+    // Len Returns the number of ids.
+    Len() int
+    // Box Returns the box of the id, and whether it is present.
+    Box(id int) (fp.F64AABB2, bool)
+    // Query Appends the ids of the boxes overlapping box to dst, in ascending order.
+    Query(box fp.F64AABB2, dst []int) []int
+    // QueryRadius Appends the ids of the boxes within radius of center to dst, in ascending order.
+    QueryRadius(center fp.F64Vec2, radius fp.F64, dst []int) []int
+    // Nearest Appends the ids of the k boxes nearest to p to dst, nearest first.
+    Nearest(p fp.F64Vec2, k int, dst []int) []int
+    // Raycast Returns the id of the first box hit by the ray from origin along dir with a parameter of at
+    // most maxT, and the parameter of the hit, see F64Ray3.
+    Raycast(origin, dir fp.F64Vec2, maxT fp.F64) (int, fp.F64, bool)
+}
+
+// Index3 The operations shared by the 3D indexes, see Index2.
+type Index3 interface {
+    Insert(id int, box fp.F64AABB3)
+    Update(id int, box fp.F64AABB3) bool
+    Remove(id int) bool
+    Len() int
+    Box(id int) (fp.F64AABB3, bool)
+    Query(box fp.F64AABB3, dst []int) []int
+    QueryRadius(center fp.F64Vec3, radius fp.F64, dst []int) []int
+    Nearest(p fp.F64Vec3, k int, dst []int) []int
+    Raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool)
+}
+
+// everything The box containing every representable point.
+var everything = fp.F64AABB3FromMinMax(
+    fp.F64Vec3FromRaw(fix64.MinValue, fix64.MinValue, fix64.MinValue),
+    fp.F64Vec3FromRaw(fix64.MaxValue, fix64.MaxValue, fix64.MaxValue),
+)
+
+// box3 Returns the 2D box as a 3D box in the z = 0 plane.
+func box3(b fp.F64AABB2) fp.F64AABB3 {
+    return fp.F64AABB3FromMinMax(fp.F64Vec3FromRaw(b.Min.RawX, b.Min.RawY, 0), fp.F64Vec3FromRaw(b.Max.RawX, b.Max.RawY, 0))
+}
+
+// box2 Drops the z coordinates of the box.
+func box2(b fp.F64AABB3) fp.F64AABB2 {
+    return fp.F64AABB2FromMinMax(fp.F64Vec2FromRaw(b.Min.RawX, b.Min.RawY), fp.F64Vec2FromRaw(b.Max.RawX, b.Max.RawY))
+}
+
+// vec3 Returns the 2D vector in the z = 0 plane.
+func vec3(v fp.F64Vec2) fp.F64Vec3 {
+    return fp.F64Vec3FromRaw(v.RawX, v.RawY, 0)
+}
+
+// dist An exact squared distance in raw units, least significant word first.
+type dist [3]uint64
+
+// distTo Returns the squared distance from p to the nearest point of b.
+func distTo(p fp.F64Vec3, b fp.F64AABB3) dist {
+    var d dist
+    for _, g := range [...]uint64{
+        gap(p.RawX, b.Min.RawX, b.Max.RawX),
+        gap(p.RawY, b.Min.RawY, b.Max.RawY),
+        gap(p.RawZ, b.Min.RawZ, b.Max.RawZ),
+    } {
+        hi, lo := bits.Mul64(g, g)
+        var c uint64
+        d[0], c = bits.Add64(d[0], lo, 0)
+        d[1], c = bits.Add64(d[1], hi, c)
+        d[2] += c
+    }
+    return d
+}
+
+// distOf Returns the square of the non-negative distance r.
+func distOf(r fp.F64) dist {
+    if r.Raw <= 0 {
+        return dist{}
+    }
+    hi, lo := bits.Mul64(uint64(r.Raw), uint64(r.Raw))
+    return dist{lo, hi, 0}
+}
+
+// gap Returns the distance from v to the interval [lo, hi].
+func gap(v, lo, hi int64) uint64 {
+    switch {
+    case v < lo:
+        return uint64(lo) - uint64(v)
+    case v > hi:
+        return uint64(v) - uint64(hi)
+    default:
+        return 0
+    }
+}
+
+func (d dist) cmp(o dist) int {
+    for i := 2; i >= 0; i-- {
+        if d[i] != o[i] {
+            if d[i] < o[i] {
+                return -1
+            }
+            return 1
+        }
+    }
+    return 0
+}
+
+// neighbor A candidate of a nearest neighbor search.
+type neighbor struct {
+    d  dist
+    id int
+}
+
+func (n neighbor) less(o neighbor) bool {
+    if c := n.d.cmp(o.d); c != 0 {
+        return c < 0
+    }
+    return n.id < o.id
+}
+
+// nearest The k best neighbors seen so far, ordered by distance and id.
+type nearest struct {
+    k    int
+    best []neighbor
+}
+
+func (n *nearest) offer(id int, d dist) {
+    c := neighbor{d: d, id: id}
+    if len(n.best) == n.k {
+        if !c.less(n.best[n.k-1]) {
+            return
+        }
+        n.best = n.best[:n.k-1]
+    }
+    i := sort.Search(len(n.best), func(i int) bool { return c.less(n.best[i]) })
+    n.best = append(n.best, neighbor{})
+    copy(n.best[i+1:], n.best[i:])
+    n.best[i] = c
+}
+
+// prunes Reports whether nothing at distance d or further can be among the k best.
+func (n *nearest) prunes(d dist) bool {
+    return len(n.best) == n.k && d.cmp(n.best[n.k-1].d) > 0
+}
+
+func (n *nearest) appendTo(dst []int) []int {
+    for _, c := range n.best {
+        dst = append(dst, c.id)
+    }
+    return dst
+}
+
+// hit The best hit of a ray cast so far.
+type hit struct {
+    maxT fp.F64
+    id   int
+    t    fp.F64
+    ok   bool
+}
+
+// offer Records the hit of the ray with box at t if it comes first.
+func (h *hit) offer(id int, ray fp.F64Ray3, box fp.F64AABB3) {
+    t, _, ok := ray.IntersectAABB(box)
+    if !ok || t.GT(h.maxT) {
+        return
+    }
+    if !h.ok || t.LT(h.t) || (t == h.t && id < h.id) {
+        h.id, h.t, h.ok = id, t, true
+    }
+}
+
+// prunes Reports whether no hit at parameter t or later can come first.
+func (h *hit) prunes(t fp.F64) bool {
+    return t.GT(h.maxT) || (h.ok && t.GT(h.t))
+}
+
+// sortedUnique Sorts the ids appended after start and removes duplicates among them.
+func sortedUnique(ids []int, start int) []int {
+    tail := ids[start:]
+    sort.Ints(tail)
+    n := 0
+    for i, id := range tail {
+        if i == 0 || id != tail[n-1] {
+            tail[n] = id
+            n++
+        }
+    }
+    return ids[:start+n]
+}
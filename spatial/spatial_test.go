@@ -0,0 +1,317 @@
+package spatial_test
+
+import (
+    "math/big"
+    "sort"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+    "github.com/camry/fp/rand"
+    "github.com/camry/fp/spatial"
+)
+
+func f(v float64) fp.F64 {
+    return fp.F64FromFloat64(v)
+}
+
+func vec2(x, y float64) fp.F64Vec2 {
+    return fp.F64Vec2FromFloat64(x, y)
+}
+
+func vec3(x, y, z float64) fp.F64Vec3 {
+    return fp.F64Vec3FromFloat64(x, y, z)
+}
+
+// randomBox3 Returns a point, a small box or now and then a large box, mostly within [-50, 50].
+func randomBox3(r *rand.Rand) fp.F64AABB3 {
+    c := fp.F64Vec3FromF64(r.F64Range(f(-50), f(50)), r.F64Range(f(-50), f(50)), r.F64Range(f(-50), f(50)))
+    size := fp.F64Zero
+    switch r.Intn(10) {
+    case 0:
+    case 1:
+        size = r.F64Range(f(5), f(40))
+    default:
+        size = r.F64Range(fp.F64Zero, f(2))
+    }
+    return fp.F64AABB3FromCenterExtents(c, fp.F64Vec3FromF64(size, r.F64Range(fp.F64Zero, size), size))
+}
+
+// distSqr Returns the exact squared raw distance from p to the box.
+func distSqr(p fp.F64Vec3, b fp.F64AABB3) *big.Int {
+    d := new(big.Int)
+    for _, v := range [][3]int64{{p.RawX, b.Min.RawX, b.Max.RawX}, {p.RawY, b.Min.RawY, b.Max.RawY}, {p.RawZ, b.Min.RawZ, b.Max.RawZ}} {
+        g := new(big.Int)
+        if v[0] < v[1] {
+            g.Sub(big.NewInt(v[1]), big.NewInt(v[0]))
+        } else if v[0] > v[2] {
+            g.Sub(big.NewInt(v[0]), big.NewInt(v[2]))
+        }
+        d.Add(d, g.Mul(g, g))
+    }
+    return d
+}
+
+// brute3 The reference answers, from testing every box.
+type brute3 map[int]fp.F64AABB3
+
+func (b brute3) ids() []int {
+    var ids []int
+    for id := range b {
+        ids = append(ids, id)
+    }
+    sort.Ints(ids)
+    return ids
+}
+
+func (b brute3) query(box fp.F64AABB3) []int {
+    var out []int
+    for _, id := range b.ids() {
+        if b[id].Overlaps(box) {
+            out = append(out, id)
+        }
+    }
+    return out
+}
+
+func (b brute3) queryRadius(c fp.F64Vec3, r fp.F64) []int {
+    var out []int
+    for _, id := range b.ids() {
+        if distSqr(c, b[id]).Cmp(new(big.Int).Mul(big.NewInt(r.Raw), big.NewInt(r.Raw))) <= 0 {
+            out = append(out, id)
+        }
+    }
+    return out
+}
+
+func (b brute3) nearest(p fp.F64Vec3, k int) []int {
+    ids := b.ids()
+    d := map[int]*big.Int{}
+    for _, id := range ids {
+        d[id] = distSqr(p, b[id])
+    }
+    sort.SliceStable(ids, func(i, j int) bool { return d[ids[i]].Cmp(d[ids[j]]) < 0 })
+    if len(ids) > k {
+        ids = ids[:k]
+    }
+    return ids
+}
+
+func (b brute3) raycast(ray fp.F64Ray3, maxT fp.F64) (int, fp.F64, bool) {
+    best, bestT, found := 0, fp.F64Zero, false
+    for _, id := range b.ids() {
+        if t, _, ok := ray.IntersectAABB(b[id]); ok && t.LE(maxT) && (!found || t.LT(bestT)) {
+            best, bestT, found = id, t, true
+        }
+    }
+    return best, bestT, found
+}
+
+// check3 Runs random inserts, updates and removals on the index and compares every query with brute force.
+func check3(t *testing.T, name string, idx spatial.Index3) {
+    r := rand.New(19)
+    ref := brute3{}
+    for i := 0; i < 300; i++ {
+        box := randomBox3(r)
+        idx.Insert(i, box)
+        ref[i] = box
+    }
+    for round := 0; round < 5; round++ {
+        for i := 0; i < 100; i++ {
+            id := r.Intn(400)
+            switch r.Intn(3) {
+            case 0:
+                _, present := ref[id]
+                assert.Equal(t, present, idx.Remove(id), name)
+                delete(ref, id)
+            case 1:
+                // Small moves mostly stay within margins and nodes, large ones do not.
+                box, present := ref[id]
+                if !present {
+                    assert.False(t, idx.Update(id, box), name)
+                    continue
+                }
+                d := r.F64Vec3InBall().MulF64(f(0.5))
+                if r.Intn(4) == 0 {
+                    d = d.MulF64(f(60))
+                }
+                box = fp.F64AABB3FromMinMax(box.Min.Add(d), box.Max.Add(d))
+                assert.True(t, idx.Update(id, box), name)
+                ref[id] = box
+            default:
+                box := randomBox3(r)
+                idx.Insert(id, box)
+                ref[id] = box
+            }
+        }
+        assert.Equal(t, len(ref), idx.Len(), name)
+        for id, box := range ref {
+            got, ok := idx.Box(id)
+            assert.True(t, ok, name)
+            assert.Equal(t, box, got, name)
+        }
+
+        for q := 0; q < 30; q++ {
+            box := randomBox3(r).Expand(f(5))
+            assert.Equal(t, ref.query(box), idx.Query(box, nil), "%s query %v", name, box)
+
+            c, radius := randomBox3(r).Center(), r.F64Range(fp.F64Zero, f(20))
+            assert.Equal(t, ref.queryRadius(c, radius), idx.QueryRadius(c, radius, nil), "%s radius %v %v", name, c, radius)
+
+            k := 1 + r.Intn(10)
+            assert.Equal(t, ref.nearest(c, k), idx.Nearest(c, k, nil), "%s nearest %v %d", name, c, k)
+
+            ray := fp.F64Ray3FromOriginDir(c.MulF64(f(2)), r.F64Vec3OnSphere())
+            maxT := r.F64Range(f(10), f(200))
+            wantID, wantT, wantOK := ref.raycast(ray, maxT)
+            gotID, gotT, gotOK := idx.Raycast(ray, maxT)
+            assert.Equal(t, wantOK, gotOK, "%s ray %v", name, ray)
+            if wantOK && gotOK {
+                assert.Equal(t, wantID, gotID, "%s ray %v", name, ray)
+                assert.Equal(t, wantT, gotT, "%s ray %v", name, ray)
+            }
+        }
+    }
+
+    // Everything, nothing and dst reuse.
+    everything := fp.F64AABB3FromMinMax(vec3(-1000, -1000, -1000), vec3(1000, 1000, 1000))
+    assert.Equal(t, ref.ids(), idx.Query(everything, nil), name)
+    assert.Equal(t, append([]int{-1}, ref.ids()...), idx.Query(everything, []int{-1}), name)
+    assert.Empty(t, idx.Query(fp.F64AABB3FromMinMax(vec3(500, 500, 500), vec3(501, 501, 501)), nil), name)
+    assert.Empty(t, idx.Nearest(fp.F64Vec3Zero, 0, nil), name)
+    assert.Len(t, idx.Nearest(fp.F64Vec3Zero, 1000, nil), len(ref), name)
+    for _, id := range ref.ids() {
+        assert.True(t, idx.Remove(id), name)
+    }
+    assert.Equal(t, 0, idx.Len(), name)
+    _, _, ok := idx.Raycast(fp.F64Ray3FromOriginDir(fp.F64Vec3Zero, fp.F64Vec3Up), f(100))
+    assert.False(t, ok, name)
+    assert.Empty(t, idx.Nearest(fp.F64Vec3Zero, 3, nil), name)
+}
+
+func TestIndex3(t *testing.T) {
+    bounds := fp.F64AABB3FromMinMax(vec3(-64, -64, -64), vec3(64, 64, 64))
+    check3(t, "octree", spatial.NewOctree(bounds, 6))
+    // Most boxes outside the bounds, which the root holds.
+    check3(t, "small octree", spatial.NewOctree(fp.F64AABB3FromMinMax(vec3(0, 0, 0), vec3(8, 8, 8)), 4))
+    check3(t, "grid", spatial.NewGrid3(f(4)))
+    check3(t, "fine grid", spatial.NewGrid3(f(0.5)))
+    check3(t, "bvh", spatial.NewBVH(f(0.25)))
+    check3(t, "tight bvh", spatial.NewBVH(fp.F64Zero))
+}
+
+func TestIndex2(t *testing.T) {
+    bounds := fp.F64AABB2FromMinMax(vec2(-64, -64), vec2(64, 64))
+    for name, idx := range map[string]spatial.Index2{
+        "quadtree": spatial.NewQuadtree(bounds, 8),
+        "grid":     spatial.NewGrid2(f(4)),
+    } {
+        r := rand.New(23)
+        var boxes []fp.F64AABB2
+        for i := 0; i < 500; i++ {
+            b := randomBox3(r)
+            box := fp.F64AABB2FromMinMax(fp.F64Vec2FromF64(b.Min.X(), b.Min.Y()), fp.F64Vec2FromF64(b.Max.X(), b.Max.Y()))
+            idx.Insert(i, box)
+            boxes = append(boxes, box)
+        }
+        for q := 0; q < 50; q++ {
+            query := fp.F64AABB2FromCenterExtents(vec2(0, 0), vec2(10, 10)).Expand(fp.F64FromInt32(int32(q)))
+            var want []int
+            for i, b := range boxes {
+                if b.Overlaps(query) {
+                    want = append(want, i)
+                }
+            }
+            assert.Equal(t, want, idx.Query(query, nil), name)
+
+            p := r.F64Vec2InDisk().MulF64(f(60))
+            dists := make([]*big.Int, len(boxes))
+            order := make([]int, len(boxes))
+            for i, b := range boxes {
+                dists[i] = distSqr(fp.F64Vec3FromF64(p.X(), p.Y(), fp.F64Zero), fp.F64AABB3FromMinMax(
+                    fp.F64Vec3FromF64(b.Min.X(), b.Min.Y(), fp.F64Zero), fp.F64Vec3FromF64(b.Max.X(), b.Max.Y(), fp.F64Zero)))
+                order[i] = i
+            }
+            sort.SliceStable(order, func(i, j int) bool { return dists[order[i]].Cmp(dists[order[j]]) < 0 })
+            assert.Equal(t, order[:5], idx.Nearest(p, 5, nil), name)
+
+            radius := r.F64Range(fp.F64Zero, f(10))
+            want = want[:0]
+            for i := range boxes {
+                if dists[i].Cmp(new(big.Int).Mul(big.NewInt(radius.Raw), big.NewInt(radius.Raw))) <= 0 {
+                    want = append(want, i)
+                }
+            }
+            assert.Equal(t, want, idx.QueryRadius(p, radius, nil), name)
+        }
+
+        // A ray along x at y = 0.5 hits the nearest box crossing that line.
+        origin, dir := vec2(-100, 0.5), vec2(1, 0)
+        id, hitT, ok := idx.Raycast(origin, dir, f(300))
+        assert.True(t, ok, name)
+        best := fp.F64MaxValue
+        for _, b := range boxes {
+            if b.Min.Y().LE(f(0.5)) && b.Max.Y().GE(f(0.5)) && b.Min.X().GE(f(-100)) {
+                best = fp.F64Min(best, b.Min.X().Add(f(100)))
+            }
+        }
+        assert.Equal(t, best, hitT, name)
+        box, _ := idx.Box(id)
+        assert.Equal(t, best, box.Min.X().Add(f(100)), name)
+        _, _, ok = idx.Raycast(origin, dir.Negate(), f(300))
+        assert.False(t, ok, name)
+    }
+}
+
+func TestTies(t *testing.T) {
+    bounds := fp.F64AABB3FromMinMax(vec3(-8, -8, -8), vec3(8, 8, 8))
+    for name, idx := range map[string]spatial.Index3{
+        "octree": spatial.NewOctree(bounds, 4),
+        "grid":   spatial.NewGrid3(fp.F64One),
+        "bvh":    spatial.NewBVH(fp.F64Zero),
+    } {
+        // Equal distances and hits are ordered by id, whatever the insertion order.
+        for _, id := range []int{7, 3, 9, 1} {
+            p := fp.F64Vec3FromInt32(int32(id%2*2-1)*2, 0, 0)
+            idx.Insert(id, fp.F64AABB3FromPoints(p))
+        }
+        assert.Equal(t, []int{1, 3, 7}, idx.Nearest(fp.F64Vec3Zero, 3, nil), name)
+        assert.Equal(t, []int{1, 3, 7, 9}, idx.QueryRadius(fp.F64Vec3Zero, fp.F64Two, nil), name)
+        assert.Empty(t, idx.QueryRadius(fp.F64Vec3Zero, f(1.999), nil), name)
+        idx.Insert(5, fp.F64AABB3FromPoints(vec3(0, 2, 0)))
+        idx.Insert(4, fp.F64AABB3FromPoints(vec3(0, 2, 0)))
+        id, hitT, ok := idx.Raycast(fp.F64Ray3FromOriginDir(fp.F64Vec3Zero, fp.F64Vec3Up), fp.F64Two)
+        assert.True(t, ok, name)
+        assert.Equal(t, 4, id, name)
+        assert.Equal(t, fp.F64Two, hitT, name)
+        _, _, ok = idx.Raycast(fp.F64Ray3FromOriginDir(fp.F64Vec3Zero, fp.F64Vec3Up), f(1.999))
+        assert.False(t, ok, name)
+    }
+}
+
+func TestBVHBalance(t *testing.T) {
+    bvh := spatial.NewBVH(f(0.1))
+    // Sorted insertions would make a list without rotations.
+    for i := 0; i < 1024; i++ {
+        bvh.Insert(i, fp.F64AABB3FromPoints(fp.F64Vec3FromInt32(int32(i), 0, 0)))
+    }
+    assert.LessOrEqual(t, bvh.Height(), 2*11)
+    assert.Equal(t, []int{500, 501, 502}, bvh.Query(fp.F64AABB3FromMinMax(vec3(499.5, -1, -1), vec3(502.5, 1, 1)), nil))
+}
+
+func TestGridLarge(t *testing.T) {
+    g := spatial.NewGrid3(fp.F64One)
+    big := fp.F64AABB3FromMinMax(vec3(-1000, -1, -1), vec3(1000, 1, 1))
+    g.Insert(1, big)
+    g.Insert(2, fp.F64AABB3FromPoints(vec3(5, 5, 5)))
+    assert.Equal(t, []int{1}, g.Query(fp.F64AABB3FromPoints(vec3(700, 0, 0)), nil))
+    assert.Equal(t, []int{2, 1}, g.Nearest(vec3(5, 4, 5), 2, nil))
+    id, hitT, ok := g.Raycast(fp.F64Ray3FromOriginDir(vec3(800, 10, 0), fp.F64Vec3Down), f(100))
+    assert.True(t, ok)
+    assert.Equal(t, 1, id)
+    assert.Equal(t, fp.F64FromInt32(9), hitT)
+    assert.True(t, g.Update(1, fp.F64AABB3FromPoints(vec3(0, 0, 0))))
+    assert.Empty(t, g.Query(fp.F64AABB3FromPoints(vec3(700, 0, 0)), nil))
+    assert.Panics(t, func() { spatial.NewGrid2(fp.F64Zero) })
+}
@@ -0,0 +1,431 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/ufix32"
+)
+
+var (
+    UF32Zero     = UF32FromRaw(ufix32.Zero)
+    UF32Half     = UF32FromRaw(ufix32.Half)
+    UF32One      = UF32FromRaw(ufix32.One)
+    UF32Two      = UF32FromRaw(ufix32.Two)
+    UF32Pi       = UF32FromRaw(ufix32.Pi)
+    UF32Pi2      = UF32FromRaw(ufix32.Pi2)
+    UF32PiHalf   = UF32FromRaw(ufix32.PiHalf)
+    UF32E        = UF32FromRaw(ufix32.E)
+    UF32MinValue = UF32FromRaw(ufix32.MinValue)
+    UF32MaxValue = UF32FromRaw(ufix32.MaxValue)
+)
+
+// UF32 Unsigned 16.16 fixed point value struct, for quantities that are never negative. It has one bit more
+// range than F32. Logarithms return F32, and exponents passed to Pow are F32, as both can be negative.
+type UF32 struct {
+    Raw uint32 // Raw fixed point value
+}
+
+/************************************/
+/*********** Construction ***********/
+/************************************/
+
+func UF32FromRaw(raw uint32) UF32 {
+    var f UF32
+    f.Raw = raw
+    return f
+}
+
+func UF32FromUint32(v uint32) UF32 {
+    return UF32FromRaw(ufix32.FromUint32(v))
+}
+
+// UF32FromString Parses a decimal string such as "1.25", or a raw hexadecimal literal, see ufix32.Parse.
+func UF32FromString(s string) (UF32, error) {
+    raw, err := ufix32.Parse(s)
+    return UF32FromRaw(raw), err
+}
+
+// UF32FromFloat32 Converts v, truncating toward zero. Negative values return 0, values too large UF32MaxValue.
+func UF32FromFloat32(v float32) UF32 {
+    return UF32FromRaw(ufix32.FromFloat32(v))
+}
+
+// UF32FromFloat64 Converts v, truncating toward zero. Negative values return 0, values too large UF32MaxValue.
+func UF32FromFloat64(v float64) UF32 {
+    return UF32FromRaw(ufix32.FromFloat64(v))
+}
+
+// UF32FromF32 Converts v, wrapping negative values around, see UF32FromF32Checked.
+func UF32FromF32(v F32) UF32 {
+    return UF32FromRaw(uint32(v.Raw))
+}
+
+// UF32FromF32Checked Converts v, ok is false when v is negative and the result wrapped around.
+func UF32FromF32Checked(v F32) (UF32, bool) {
+    raw, ok := ufix32.FromFix32(v.Raw)
+    return UF32FromRaw(raw), ok
+}
+
+func UF32FromUF64(v UF64) UF32 {
+    return UF32FromRaw(uint32(v.Raw >> 16))
+}
+
+// UF32Ratio Creates the fixed point number that's a divided by b.
+func UF32Ratio(a, b uint32) UF32 {
+    return UF32FromRaw(uint32((uint64(a) << 16) / uint64(b)))
+}
+
+// UF32Min returns the smallest UF32 that was passed in the arguments.
+func UF32Min(first UF32, rest ...UF32) UF32 {
+    ans := first
+    for _, item := range rest {
+        if item.Raw < ans.Raw {
+            ans = item
+        }
+    }
+    return ans
+}
+
+// UF32Max returns the largest UF32 that was passed in the arguments.
+func UF32Max(first UF32, rest ...UF32) UF32 {
+    ans := first
+    for _, item := range rest {
+        if item.Raw > ans.Raw {
+            ans = item
+        }
+    }
+    return ans
+}
+
+/************************************/
+/*********** Conversions ************/
+/************************************/
+
+func (f UF32) FloorToInt() uint32 {
+    return ufix32.FloorToInt(f.Raw)
+}
+
+func (f UF32) CeilToInt() uint32 {
+    return ufix32.CeilToInt(f.Raw)
+}
+
+func (f UF32) RoundToInt() uint32 {
+    return ufix32.RoundToInt(f.Raw)
+}
+
+func (f UF32) Float32() float32 {
+    return ufix32.ToFloat32(f.Raw)
+}
+
+func (f UF32) Float64() float64 {
+    return ufix32.ToFloat64(f.Raw)
+}
+
+// F32 Converts f, wrapping values above F32MaxValue around to negative ones, see F32Checked.
+func (f UF32) F32() F32 {
+    return F32FromRaw(int32(f.Raw))
+}
+
+// F32Checked Converts f, ok is false when f is above F32MaxValue and the result wrapped around.
+func (f UF32) F32Checked() (F32, bool) {
+    raw, ok := ufix32.ToFix32(f.Raw)
+    return F32FromRaw(raw), ok
+}
+
+func (f UF32) UF64() UF64 {
+    return UF64FromRaw(uint64(f.Raw) << 16)
+}
+
+/************************************/
+/************ Operators *************/
+/************************************/
+
+// Add f + v2, wrapping around on overflow
+func (f UF32) Add(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.Add(f.Raw, v2.Raw))
+}
+
+// Sub f - v2, wrapping around below zero
+func (f UF32) Sub(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.Sub(f.Raw, v2.Raw))
+}
+
+// Mul f * v2
+func (f UF32) Mul(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.Mul(f.Raw, v2.Raw))
+}
+
+// DivPrecise f / v2
+func (f UF32) DivPrecise(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.DivPrecise(f.Raw, v2.Raw))
+}
+
+// Mod f % v2
+func (f UF32) Mod(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.Mod(f.Raw, v2.Raw))
+}
+
+// AddChecked f + v2, ok is false on overflow
+func (f UF32) AddChecked(v2 UF32) (UF32, bool) {
+    r, ok := ufix32.AddChecked(f.Raw, v2.Raw)
+    return UF32FromRaw(r), ok
+}
+
+// SubChecked f - v2, ok is false on underflow
+func (f UF32) SubChecked(v2 UF32) (UF32, bool) {
+    r, ok := ufix32.SubChecked(f.Raw, v2.Raw)
+    return UF32FromRaw(r), ok
+}
+
+// MulChecked f * v2, ok is false on overflow
+func (f UF32) MulChecked(v2 UF32) (UF32, bool) {
+    r, ok := ufix32.MulChecked(f.Raw, v2.Raw)
+    return UF32FromRaw(r), ok
+}
+
+// DivChecked f / v2, ok is false on overflow or division by zero
+func (f UF32) DivChecked(v2 UF32) (UF32, bool) {
+    r, ok := ufix32.DivChecked(f.Raw, v2.Raw)
+    return UF32FromRaw(r), ok
+}
+
+// AddSat f + v2, clamped to UF32MinValue/UF32MaxValue
+func (f UF32) AddSat(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.AddSat(f.Raw, v2.Raw))
+}
+
+// SubSat f - v2, clamped to UF32MinValue/UF32MaxValue
+func (f UF32) SubSat(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.SubSat(f.Raw, v2.Raw))
+}
+
+// MulSat f * v2, clamped to UF32MinValue/UF32MaxValue
+func (f UF32) MulSat(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.MulSat(f.Raw, v2.Raw))
+}
+
+// DivSat f / v2, clamped to UF32MinValue/UF32MaxValue
+func (f UF32) DivSat(v2 UF32) UF32 {
+    return UF32FromRaw(ufix32.DivSat(f.Raw, v2.Raw))
+}
+
+// Add2 f++
+func (f UF32) Add2() UF32 {
+    return UF32FromRaw(f.Raw + ufix32.One)
+}
+
+// Sub2 f--
+func (f UF32) Sub2() UF32 {
+    return UF32FromRaw(f.Raw - ufix32.One)
+}
+
+// EQ f == v2
+func (f UF32) EQ(v2 UF32) bool {
+    return f.Raw == v2.Raw
+}
+
+// NE f != v2
+func (f UF32) NE(v2 UF32) bool {
+    return f.Raw != v2.Raw
+}
+
+// LT f < v2
+func (f UF32) LT(v2 UF32) bool {
+    return f.Raw < v2.Raw
+}
+
+// LE f <= v2
+func (f UF32) LE(v2 UF32) bool {
+    return f.Raw <= v2.Raw
+}
+
+// GT f > v2
+func (f UF32) GT(v2 UF32) bool {
+    return f.Raw > v2.Raw
+}
+
+// GE f >= v2
+func (f UF32) GE(v2 UF32) bool {
+    return f.Raw >= v2.Raw
+}
+
+func (f UF32) Div2() UF32 {
+    return UF32FromRaw(f.Raw >> 1)
+}
+
+func (f UF32) Ceil() UF32 {
+    return UF32FromRaw(ufix32.Ceil(f.Raw))
+}
+
+func (f UF32) Floor() UF32 {
+    return UF32FromRaw(ufix32.Floor(f.Raw))
+}
+
+func (f UF32) Round() UF32 {
+    return UF32FromRaw(ufix32.Round(f.Raw))
+}
+
+func (f UF32) Fract() UF32 {
+    return UF32FromRaw(ufix32.Fract(f.Raw))
+}
+
+func (f UF32) Div(b UF32) UF32 {
+    return UF32FromRaw(ufix32.Div(f.Raw, b.Raw))
+}
+
+func (f UF32) DivFast(b UF32) UF32 {
+    return UF32FromRaw(ufix32.DivFast(f.Raw, b.Raw))
+}
+
+func (f UF32) DivFastest(b UF32) UF32 {
+    return UF32FromRaw(ufix32.DivFastest(f.Raw, b.Raw))
+}
+
+func (f UF32) SqrtPrecise() UF32 {
+    return UF32FromRaw(ufix32.SqrtPrecise(f.Raw))
+}
+
+func (f UF32) Sqrt() UF32 {
+    return UF32FromRaw(ufix32.Sqrt(f.Raw))
+}
+
+func (f UF32) SqrtFast() UF32 {
+    return UF32FromRaw(ufix32.SqrtFast(f.Raw))
+}
+
+func (f UF32) SqrtFastest() UF32 {
+    return UF32FromRaw(ufix32.SqrtFastest(f.Raw))
+}
+
+func (f UF32) RSqrt() UF32 {
+    return UF32FromRaw(ufix32.RSqrt(f.Raw))
+}
+
+func (f UF32) RSqrtFast() UF32 {
+    return UF32FromRaw(ufix32.RSqrtFast(f.Raw))
+}
+
+func (f UF32) RSqrtFastest() UF32 {
+    return UF32FromRaw(ufix32.RSqrtFastest(f.Raw))
+}
+
+func (f UF32) Rcp() UF32 {
+    return UF32FromRaw(ufix32.Rcp(f.Raw))
+}
+
+func (f UF32) RcpFast() UF32 {
+    return UF32FromRaw(ufix32.RcpFast(f.Raw))
+}
+
+func (f UF32) RcpFastest() UF32 {
+    return UF32FromRaw(ufix32.RcpFastest(f.Raw))
+}
+
+func (f UF32) Exp() UF32 {
+    return UF32FromRaw(ufix32.Exp(expArg32(f.Raw)))
+}
+
+func (f UF32) ExpFast() UF32 {
+    return UF32FromRaw(ufix32.ExpFast(expArg32(f.Raw)))
+}
+
+func (f UF32) ExpFastest() UF32 {
+    return UF32FromRaw(ufix32.ExpFastest(expArg32(f.Raw)))
+}
+
+func (f UF32) Exp2() UF32 {
+    return UF32FromRaw(ufix32.Exp2(expArg32(f.Raw)))
+}
+
+func (f UF32) Exp2Fast() UF32 {
+    return UF32FromRaw(ufix32.Exp2Fast(expArg32(f.Raw)))
+}
+
+func (f UF32) Exp2Fastest() UF32 {
+    return UF32FromRaw(ufix32.Exp2Fastest(expArg32(f.Raw)))
+}
+
+func (f UF32) Log() F32 {
+    return F32FromRaw(ufix32.Log(f.Raw))
+}
+
+func (f UF32) LogFast() F32 {
+    return F32FromRaw(ufix32.LogFast(f.Raw))
+}
+
+func (f UF32) LogFastest() F32 {
+    return F32FromRaw(ufix32.LogFastest(f.Raw))
+}
+
+func (f UF32) Log2() F32 {
+    return F32FromRaw(ufix32.Log2(f.Raw))
+}
+
+func (f UF32) Log2Fast() F32 {
+    return F32FromRaw(ufix32.Log2Fast(f.Raw))
+}
+
+func (f UF32) Log2Fastest() F32 {
+    return F32FromRaw(ufix32.Log2Fastest(f.Raw))
+}
+
+func (f UF32) Pow(b F32) UF32 {
+    return UF32FromRaw(ufix32.Pow(f.Raw, b.Raw))
+}
+
+func (f UF32) PowFast(b F32) UF32 {
+    return UF32FromRaw(ufix32.PowFast(f.Raw, b.Raw))
+}
+
+func (f UF32) PowFastest(b F32) UF32 {
+    return UF32FromRaw(ufix32.PowFastest(f.Raw, b.Raw))
+}
+
+func (f UF32) Clamp(min, max UF32) UF32 {
+    return UF32FromRaw(ufix32.Clamp(f.Raw, min.Raw, max.Raw))
+}
+
+func (f UF32) Clamp01() UF32 {
+    return UF32FromRaw(ufix32.Clamp(f.Raw, ufix32.Zero, ufix32.One))
+}
+
+// Lerp Interpolates from f to b by t, with t in [0, 1].
+func (f UF32) Lerp(b, t UF32) UF32 {
+    return UF32FromRaw(ufix32.Lerp(f.Raw, b.Raw, t.Raw))
+}
+
+func (f UF32) Equals(obj UF32) bool {
+    return f.Raw == obj.Raw
+}
+
+func (f UF32) CompareTo(other UF32) int32 {
+    if f.Raw < other.Raw {
+        return -1
+    }
+    if f.Raw > other.Raw {
+        return +1
+    }
+    return 0
+}
+
+func (f UF32) ToString() string {
+    return ufix32.ToString(f.Raw)
+}
+
+// Text Converts f to a string in the given format, see ufix32.Format.
+func (f UF32) Text(format byte, prec int) string {
+    return ufix32.Format(f.Raw, format, prec)
+}
+
+// Format Implements fmt.Formatter, supporting %v, %s, %f and %x.
+func (f UF32) Format(s fmt.State, verb rune) {
+    formatUfixed(s, verb, uint64(f.Raw), 16, "fp.UF32")
+}
+
+// expArg32 Converts a non-negative exponent to the signed argument of ufix32.Exp and Exp2, see expArg64.
+func expArg32(raw uint32) int32 {
+    if raw > 32<<16 {
+        return 32 << 16
+    }
+    return int32(raw)
+}
@@ -0,0 +1,38 @@
+package fp_test
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+)
+
+func TestUF32_Range(t *testing.T) {
+    d := fp.UF32FromUint32(50000).Add(fp.UF32Half)
+    assert.Equal(t, "50000.5", d.ToString())
+    assert.InDelta(t, math.Sqrt(50000.5), d.Sqrt().Float64(), 0.001)
+    assert.InDelta(t, math.Log(50000.5), d.Log().Float64(), 0.0001)
+    assert.Equal(t, "50000.5", fp.UF32FromF32(fp.F32FromInt32(-15536).Add(fp.F32Half)).ToString()) // wraps
+
+    _, ok := d.F32Checked()
+    assert.False(t, ok)
+    _, ok = d.MulChecked(fp.UF32Two)
+    assert.False(t, ok)
+    assert.Equal(t, fp.UF32MaxValue, d.MulSat(fp.UF32Two))
+    _, ok = fp.UF32FromF32Checked(fp.F32Neg1)
+    assert.False(t, ok)
+    v, ok := fp.UF32FromF32Checked(fp.F32Pi)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F32Pi, v.F32())
+}
+
+func TestUF32_Math(t *testing.T) {
+    p := fp.UF32FromFloat64(0.75)
+    assert.InDelta(t, math.Log2(0.75), p.Log2().Float64(), 0.0001)
+    assert.InDelta(t, math.Pow(0.75, 3), p.Pow(fp.F32FromInt32(3)).Float64(), 0.0001)
+    assert.InDelta(t, math.Exp(0.75), p.Exp().Float64(), 0.0001)
+    assert.Equal(t, fp.UF32MaxValue, fp.UF32FromUint32(100).Exp2())
+    assert.Equal(t, fp.UF32Ratio(3, 4), p)
+}
@@ -0,0 +1,436 @@
+package fp
+
+import (
+    "fmt"
+
+    "github.com/camry/fp/ufix64"
+)
+
+var (
+    UF64Zero     = UF64FromRaw(ufix64.Zero)
+    UF64Half     = UF64FromRaw(ufix64.Half)
+    UF64One      = UF64FromRaw(ufix64.One)
+    UF64Two      = UF64FromRaw(ufix64.Two)
+    UF64Pi       = UF64FromRaw(ufix64.Pi)
+    UF64Pi2      = UF64FromRaw(ufix64.Pi2)
+    UF64PiHalf   = UF64FromRaw(ufix64.PiHalf)
+    UF64E        = UF64FromRaw(ufix64.E)
+    UF64MinValue = UF64FromRaw(ufix64.MinValue)
+    UF64MaxValue = UF64FromRaw(ufix64.MaxValue)
+)
+
+// UF64 Unsigned 32.32 fixed point value struct, for quantities that are never negative. It has one bit more
+// range than F64. Logarithms return F64, and exponents passed to Pow are F64, as both can be negative.
+type UF64 struct {
+    Raw uint64 // Raw fixed point value
+}
+
+/************************************/
+/*********** Construction ***********/
+/************************************/
+
+func UF64FromRaw(raw uint64) UF64 {
+    var f UF64
+    f.Raw = raw
+    return f
+}
+
+func UF64FromUint32(v uint32) UF64 {
+    return UF64FromRaw(ufix64.FromUint32(v))
+}
+
+func UF64FromUint64(v uint64) UF64 {
+    return UF64FromRaw(ufix64.FromUint64(v))
+}
+
+// UF64FromString Parses a decimal string such as "1.25", or a raw hexadecimal literal, see ufix64.Parse.
+func UF64FromString(s string) (UF64, error) {
+    raw, err := ufix64.Parse(s)
+    return UF64FromRaw(raw), err
+}
+
+// UF64FromFloat32 Converts v, truncating toward zero. Negative values return 0, values too large UF64MaxValue.
+func UF64FromFloat32(v float32) UF64 {
+    return UF64FromRaw(ufix64.FromFloat32(v))
+}
+
+// UF64FromFloat64 Converts v, truncating toward zero. Negative values return 0, values too large UF64MaxValue.
+func UF64FromFloat64(v float64) UF64 {
+    return UF64FromRaw(ufix64.FromFloat64(v))
+}
+
+// UF64FromF64 Converts v, wrapping negative values around, see UF64FromF64Checked.
+func UF64FromF64(v F64) UF64 {
+    return UF64FromRaw(uint64(v.Raw))
+}
+
+// UF64FromF64Checked Converts v, ok is false when v is negative and the result wrapped around.
+func UF64FromF64Checked(v F64) (UF64, bool) {
+    raw, ok := ufix64.FromFix64(v.Raw)
+    return UF64FromRaw(raw), ok
+}
+
+func UF64FromUF32(v UF32) UF64 {
+    return UF64FromRaw(uint64(v.Raw) << 16)
+}
+
+// UF64Ratio Creates the fixed point number that's a divided by b.
+func UF64Ratio(a, b uint32) UF64 {
+    return UF64FromRaw(uint64((uint64(a) << 32) / uint64(b)))
+}
+
+// UF64Min returns the smallest UF64 that was passed in the arguments.
+func UF64Min(first UF64, rest ...UF64) UF64 {
+    ans := first
+    for _, item := range rest {
+        if item.Raw < ans.Raw {
+            ans = item
+        }
+    }
+    return ans
+}
+
+// UF64Max returns the largest UF64 that was passed in the arguments.
+func UF64Max(first UF64, rest ...UF64) UF64 {
+    ans := first
+    for _, item := range rest {
+        if item.Raw > ans.Raw {
+            ans = item
+        }
+    }
+    return ans
+}
+
+/************************************/
+/*********** Conversions ************/
+/************************************/
+
+func (f UF64) FloorToInt() uint32 {
+    return ufix64.FloorToInt(f.Raw)
+}
+
+func (f UF64) CeilToInt() uint32 {
+    return ufix64.CeilToInt(f.Raw)
+}
+
+func (f UF64) RoundToInt() uint32 {
+    return ufix64.RoundToInt(f.Raw)
+}
+
+func (f UF64) Float32() float32 {
+    return ufix64.ToFloat32(f.Raw)
+}
+
+func (f UF64) Float64() float64 {
+    return ufix64.ToFloat64(f.Raw)
+}
+
+// F64 Converts f, wrapping values above F64MaxValue around to negative ones, see F64Checked.
+func (f UF64) F64() F64 {
+    return F64FromRaw(int64(f.Raw))
+}
+
+// F64Checked Converts f, ok is false when f is above F64MaxValue and the result wrapped around.
+func (f UF64) F64Checked() (F64, bool) {
+    raw, ok := ufix64.ToFix64(f.Raw)
+    return F64FromRaw(raw), ok
+}
+
+func (f UF64) UF32() UF32 {
+    return UF32FromRaw(uint32(f.Raw >> 16))
+}
+
+/************************************/
+/************ Operators *************/
+/************************************/
+
+// Add f + v2, wrapping around on overflow
+func (f UF64) Add(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.Add(f.Raw, v2.Raw))
+}
+
+// Sub f - v2, wrapping around below zero
+func (f UF64) Sub(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.Sub(f.Raw, v2.Raw))
+}
+
+// Mul f * v2
+func (f UF64) Mul(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.Mul(f.Raw, v2.Raw))
+}
+
+// DivPrecise f / v2
+func (f UF64) DivPrecise(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.DivPrecise(f.Raw, v2.Raw))
+}
+
+// Mod f % v2
+func (f UF64) Mod(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.Mod(f.Raw, v2.Raw))
+}
+
+// AddChecked f + v2, ok is false on overflow
+func (f UF64) AddChecked(v2 UF64) (UF64, bool) {
+    r, ok := ufix64.AddChecked(f.Raw, v2.Raw)
+    return UF64FromRaw(r), ok
+}
+
+// SubChecked f - v2, ok is false on underflow
+func (f UF64) SubChecked(v2 UF64) (UF64, bool) {
+    r, ok := ufix64.SubChecked(f.Raw, v2.Raw)
+    return UF64FromRaw(r), ok
+}
+
+// MulChecked f * v2, ok is false on overflow
+func (f UF64) MulChecked(v2 UF64) (UF64, bool) {
+    r, ok := ufix64.MulChecked(f.Raw, v2.Raw)
+    return UF64FromRaw(r), ok
+}
+
+// DivChecked f / v2, ok is false on overflow or division by zero
+func (f UF64) DivChecked(v2 UF64) (UF64, bool) {
+    r, ok := ufix64.DivChecked(f.Raw, v2.Raw)
+    return UF64FromRaw(r), ok
+}
+
+// AddSat f + v2, clamped to UF64MinValue/UF64MaxValue
+func (f UF64) AddSat(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.AddSat(f.Raw, v2.Raw))
+}
+
+// SubSat f - v2, clamped to UF64MinValue/UF64MaxValue
+func (f UF64) SubSat(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.SubSat(f.Raw, v2.Raw))
+}
+
+// MulSat f * v2, clamped to UF64MinValue/UF64MaxValue
+func (f UF64) MulSat(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.MulSat(f.Raw, v2.Raw))
+}
+
+// DivSat f / v2, clamped to UF64MinValue/UF64MaxValue
+func (f UF64) DivSat(v2 UF64) UF64 {
+    return UF64FromRaw(ufix64.DivSat(f.Raw, v2.Raw))
+}
+
+// Add2 f++
+func (f UF64) Add2() UF64 {
+    return UF64FromRaw(f.Raw + ufix64.One)
+}
+
+// Sub2 f--
+func (f UF64) Sub2() UF64 {
+    return UF64FromRaw(f.Raw - ufix64.One)
+}
+
+// EQ f == v2
+func (f UF64) EQ(v2 UF64) bool {
+    return f.Raw == v2.Raw
+}
+
+// NE f != v2
+func (f UF64) NE(v2 UF64) bool {
+    return f.Raw != v2.Raw
+}
+
+// LT f < v2
+func (f UF64) LT(v2 UF64) bool {
+    return f.Raw < v2.Raw
+}
+
+// LE f <= v2
+func (f UF64) LE(v2 UF64) bool {
+    return f.Raw <= v2.Raw
+}
+
+// GT f > v2
+func (f UF64) GT(v2 UF64) bool {
+    return f.Raw > v2.Raw
+}
+
+// GE f >= v2
+func (f UF64) GE(v2 UF64) bool {
+    return f.Raw >= v2.Raw
+}
+
+func (f UF64) Div2() UF64 {
+    return UF64FromRaw(f.Raw >> 1)
+}
+
+func (f UF64) Ceil() UF64 {
+    return UF64FromRaw(ufix64.Ceil(f.Raw))
+}
+
+func (f UF64) Floor() UF64 {
+    return UF64FromRaw(ufix64.Floor(f.Raw))
+}
+
+func (f UF64) Round() UF64 {
+    return UF64FromRaw(ufix64.Round(f.Raw))
+}
+
+func (f UF64) Fract() UF64 {
+    return UF64FromRaw(ufix64.Fract(f.Raw))
+}
+
+func (f UF64) Div(b UF64) UF64 {
+    return UF64FromRaw(ufix64.Div(f.Raw, b.Raw))
+}
+
+func (f UF64) DivFast(b UF64) UF64 {
+    return UF64FromRaw(ufix64.DivFast(f.Raw, b.Raw))
+}
+
+func (f UF64) DivFastest(b UF64) UF64 {
+    return UF64FromRaw(ufix64.DivFastest(f.Raw, b.Raw))
+}
+
+func (f UF64) SqrtPrecise() UF64 {
+    return UF64FromRaw(ufix64.SqrtPrecise(f.Raw))
+}
+
+func (f UF64) Sqrt() UF64 {
+    return UF64FromRaw(ufix64.Sqrt(f.Raw))
+}
+
+func (f UF64) SqrtFast() UF64 {
+    return UF64FromRaw(ufix64.SqrtFast(f.Raw))
+}
+
+func (f UF64) SqrtFastest() UF64 {
+    return UF64FromRaw(ufix64.SqrtFastest(f.Raw))
+}
+
+func (f UF64) RSqrt() UF64 {
+    return UF64FromRaw(ufix64.RSqrt(f.Raw))
+}
+
+func (f UF64) RSqrtFast() UF64 {
+    return UF64FromRaw(ufix64.RSqrtFast(f.Raw))
+}
+
+func (f UF64) RSqrtFastest() UF64 {
+    return UF64FromRaw(ufix64.RSqrtFastest(f.Raw))
+}
+
+func (f UF64) Rcp() UF64 {
+    return UF64FromRaw(ufix64.Rcp(f.Raw))
+}
+
+func (f UF64) RcpFast() UF64 {
+    return UF64FromRaw(ufix64.RcpFast(f.Raw))
+}
+
+func (f UF64) RcpFastest() UF64 {
+    return UF64FromRaw(ufix64.RcpFastest(f.Raw))
+}
+
+func (f UF64) Exp() UF64 {
+    return UF64FromRaw(ufix64.Exp(expArg64(f.Raw)))
+}
+
+func (f UF64) ExpFast() UF64 {
+    return UF64FromRaw(ufix64.ExpFast(expArg64(f.Raw)))
+}
+
+func (f UF64) ExpFastest() UF64 {
+    return UF64FromRaw(ufix64.ExpFastest(expArg64(f.Raw)))
+}
+
+func (f UF64) Exp2() UF64 {
+    return UF64FromRaw(ufix64.Exp2(expArg64(f.Raw)))
+}
+
+func (f UF64) Exp2Fast() UF64 {
+    return UF64FromRaw(ufix64.Exp2Fast(expArg64(f.Raw)))
+}
+
+func (f UF64) Exp2Fastest() UF64 {
+    return UF64FromRaw(ufix64.Exp2Fastest(expArg64(f.Raw)))
+}
+
+func (f UF64) Log() F64 {
+    return F64FromRaw(ufix64.Log(f.Raw))
+}
+
+func (f UF64) LogFast() F64 {
+    return F64FromRaw(ufix64.LogFast(f.Raw))
+}
+
+func (f UF64) LogFastest() F64 {
+    return F64FromRaw(ufix64.LogFastest(f.Raw))
+}
+
+func (f UF64) Log2() F64 {
+    return F64FromRaw(ufix64.Log2(f.Raw))
+}
+
+func (f UF64) Log2Fast() F64 {
+    return F64FromRaw(ufix64.Log2Fast(f.Raw))
+}
+
+func (f UF64) Log2Fastest() F64 {
+    return F64FromRaw(ufix64.Log2Fastest(f.Raw))
+}
+
+func (f UF64) Pow(b F64) UF64 {
+    return UF64FromRaw(ufix64.Pow(f.Raw, b.Raw))
+}
+
+func (f UF64) PowFast(b F64) UF64 {
+    return UF64FromRaw(ufix64.PowFast(f.Raw, b.Raw))
+}
+
+func (f UF64) PowFastest(b F64) UF64 {
+    return UF64FromRaw(ufix64.PowFastest(f.Raw, b.Raw))
+}
+
+func (f UF64) Clamp(min, max UF64) UF64 {
+    return UF64FromRaw(ufix64.Clamp(f.Raw, min.Raw, max.Raw))
+}
+
+func (f UF64) Clamp01() UF64 {
+    return UF64FromRaw(ufix64.Clamp(f.Raw, ufix64.Zero, ufix64.One))
+}
+
+// Lerp Interpolates from f to b by t, with t in [0, 1].
+func (f UF64) Lerp(b, t UF64) UF64 {
+    return UF64FromRaw(ufix64.Lerp(f.Raw, b.Raw, t.Raw))
+}
+
+func (f UF64) Equals(obj UF64) bool {
+    return f.Raw == obj.Raw
+}
+
+func (f UF64) CompareTo(other UF64) int32 {
+    if f.Raw < other.Raw {
+        return -1
+    }
+    if f.Raw > other.Raw {
+        return +1
+    }
+    return 0
+}
+
+func (f UF64) ToString() string {
+    return ufix64.ToString(f.Raw)
+}
+
+// Text Converts f to a string in the given format, see ufix64.Format.
+func (f UF64) Text(format byte, prec int) string {
+    return ufix64.Format(f.Raw, format, prec)
+}
+
+// Format Implements fmt.Formatter, supporting %v, %s, %f and %x.
+func (f UF64) Format(s fmt.State, verb rune) {
+    formatUfixed(s, verb, uint64(f.Raw), 32, "fp.UF64")
+}
+
+// expArg64 Converts a non-negative exponent to the signed argument of ufix64.Exp and Exp2, which saturate
+// long before the exponent stops fitting.
+func expArg64(raw uint64) int64 {
+    if raw > 64<<32 {
+        return 64 << 32
+    }
+    return int64(raw)
+}
@@ -0,0 +1,59 @@
+package fp_test
+
+import (
+    "fmt"
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp"
+)
+
+func TestUF64_Range(t *testing.T) {
+    // Values beyond F64MaxValue still compute.
+    timer := fp.UF64FromUint32(3000000000).Add(fp.UF64Half)
+    assert.Equal(t, "3000000000.5", timer.ToString())
+    assert.InDelta(t, math.Sqrt(3000000000.5), timer.Sqrt().Float64(), 0.01)
+    assert.InDelta(t, math.Log(3000000000.5), timer.Log().Float64(), 0.000001)
+    assert.Equal(t, "3000000000.500000", fmt.Sprintf("%f", timer))
+
+    _, ok := timer.F64Checked()
+    assert.False(t, ok)
+    _, ok = timer.AddChecked(timer)
+    assert.False(t, ok)
+    _, ok = fp.UF64One.SubChecked(fp.UF64Two)
+    assert.False(t, ok)
+    assert.Equal(t, fp.UF64Zero, fp.UF64One.SubSat(fp.UF64Two))
+    assert.True(t, fp.UF64One.Sub(fp.UF64Two).GT(timer)) // wraps around
+}
+
+func TestUF64_Math(t *testing.T) {
+    p := fp.UF64FromFloat64(0.3)
+    assert.InDelta(t, math.Log2(0.3), p.Log2().Float64(), 0.000001)
+    assert.InDelta(t, math.Pow(0.3, -1.5), p.Pow(fp.F64FromFloat64(-1.5)).Float64(), 0.00001)
+    assert.InDelta(t, math.Exp(0.3), p.Exp().Float64(), 0.000001)
+    assert.InDelta(t, 1/0.3, fp.UF64One.Div(p).Float64(), 0.000001)
+    assert.Equal(t, fp.UF64MaxValue, fp.UF64FromUint32(1000).Exp())
+    assert.Equal(t, fp.UF64FromRaw(0x4ccccccc), fp.UF64Ratio(3, 10))
+    assert.Equal(t, fp.UF64Ratio(3, 10), p.Lerp(fp.UF64One, fp.UF64Zero))
+    assert.Equal(t, int32(-1), p.CompareTo(fp.UF64One))
+}
+
+func TestUF64_Convert(t *testing.T) {
+    v, ok := fp.UF64FromF64Checked(fp.F64Pi)
+    assert.True(t, ok)
+    assert.Equal(t, fp.F64Pi, v.F64())
+    _, ok = fp.UF64FromF64Checked(fp.F64Neg1)
+    assert.False(t, ok)
+    assert.Equal(t, fp.UF64MaxValue.Sub(fp.UF64One).Add(fp.UF64FromRaw(1)), fp.UF64FromF64(fp.F64Neg1))
+
+    assert.Equal(t, fp.UF32FromUint32(40000), fp.UF64FromUint32(40000).UF32())
+    assert.Equal(t, fp.UF64FromUint32(40000), fp.UF64FromUF32(fp.UF32FromUint32(40000)))
+
+    f, err := fp.UF64FromString("4000000000.25")
+    assert.NoError(t, err)
+    assert.Equal(t, fp.UF64FromUint32(4000000000).Add(fp.UF64Half.Div2()), f)
+    _, err = fp.UF64FromString("-1")
+    assert.Error(t, err)
+}
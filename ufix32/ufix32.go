@@ -0,0 +1,354 @@
+package ufix32
+
+import (
+    "math"
+
+    "github.com/camry/fp/fixutil"
+    "github.com/camry/fp/ufix64"
+)
+
+// Direct fixed point (unsigned 16.16) functions.
+//
+// Functions whose result can be negative, such as Log, return signed 16.16 values as used by fix32, and
+// Exp, Exp2 and Pow take signed arguments the same way. The math functions are evaluated by ufix64 and
+// rounded down to 16.16.
+
+const (
+    Shift        int32  = 16
+    FractionMask uint32 = (1 << Shift) - 1
+    IntegerMask         = ^FractionMask
+)
+
+// Constants
+const (
+    Zero   uint32 = 0
+    One    uint32 = 1 << Shift
+    Two    uint32 = 2 << Shift
+    Three  uint32 = 3 << Shift
+    Four   uint32 = 4 << Shift
+    Half          = One >> 1
+    Pi            = uint32(13493037705 >> 16)
+    Pi2           = uint32(26986075409 >> 16)
+    PiHalf        = uint32(6746518852 >> 16)
+    E             = uint32(11674931555 >> 16)
+)
+
+const (
+    MinValue uint32 = 0
+    MaxValue uint32 = math.MaxUint32
+)
+
+// FromUint32 Converts an integer to a fp-point value.
+func FromUint32(v uint32) uint32 {
+    return v << Shift
+}
+
+// FromFloat32 Converts a float32 to a fp-point value, see FromFloat64.
+func FromFloat32(v float32) uint32 {
+    return FromFloat64(float64(v))
+}
+
+// FromFloat64 Converts a float64 to a fp-point value, truncating toward zero. Negative values and NaN
+// return 0, values too large return MaxValue.
+func FromFloat64(v float64) uint32 {
+    if !(v > 0) {
+        return 0
+    }
+    if v >= 65536.0 {
+        return MaxValue
+    }
+    return uint32(v * 65536.0)
+}
+
+// FromFix32 Converts a signed 16.16 value, ok is false when v is negative and the result wrapped around.
+func FromFix32(v int32) (uint32, bool) {
+    return uint32(v), v >= 0
+}
+
+// ToFix32 Converts the value to signed 16.16, ok is false when v is too large and the result wrapped around.
+func ToFix32(v uint32) (int32, bool) {
+    return int32(v), v <= math.MaxInt32
+}
+
+// CeilToInt Converts a fp-point value into an integer by rounding it up to nearest integer.
+func CeilToInt(v uint32) uint32 {
+    return (v + (One - 1)) >> Shift
+}
+
+// FloorToInt Converts a fp-point value into an integer by rounding it down to nearest integer.
+func FloorToInt(v uint32) uint32 {
+    return v >> Shift
+}
+
+// RoundToInt Converts a fp-point value into an integer by rounding it to nearest integer.
+func RoundToInt(v uint32) uint32 {
+    return (v + Half) >> Shift
+}
+
+// ToFloat64 Converts a fp-point value into a float64.
+func ToFloat64(v uint32) float64 {
+    return float64(v) * (1.0 / 65536.0)
+}
+
+// ToFloat32 Converts a fp-point value into a float32.
+func ToFloat32(v uint32) float32 {
+    return float32(v) * (1.0 / 65536.0)
+}
+
+// ToString Converts the value to the shortest decimal string that parses back to the same value.
+func ToString(v uint32) string {
+    return fixutil.FormatUfixed(uint64(v), uint(Shift), 'f', -1)
+}
+
+// Format Converts the value to a string using integer arithmetic only, see fix64.Format.
+func Format(v uint32, fmt byte, prec int) string {
+    return fixutil.FormatUfixed(uint64(v), uint(Shift), fmt, prec)
+}
+
+// Parse Converts a decimal string, or a raw hexadecimal literal, into a fixed-point value, see fix64.Parse.
+// Negative values are out of range.
+func Parse(s string) (uint32, error) {
+    v, err := fixutil.ParseUfixed("ufix32.Parse", s, uint(Shift), 32)
+    return uint32(v), err
+}
+
+// Ceil Round up to nearest integer.
+func Ceil(v uint32) uint32 {
+    return (v + FractionMask) & IntegerMask
+}
+
+// Floor Round down to nearest integer.
+func Floor(v uint32) uint32 {
+    return v & IntegerMask
+}
+
+// Round to nearest integer.
+func Round(v uint32) uint32 {
+    return (v + Half) & IntegerMask
+}
+
+// Fract Returns the fractional part of x. Equal to 'x - floor(x)'.
+func Fract(v uint32) uint32 {
+    return v & FractionMask
+}
+
+// Min Returns the minimum of the two values.
+func Min(a, b uint32) uint32 {
+    if a < b {
+        return a
+    }
+    return b
+}
+
+// Max Returns the maximum of the two values.
+func Max(a, b uint32) uint32 {
+    if a > b {
+        return a
+    }
+    return b
+}
+
+// Clamp Returns the value clamped between min and max.
+func Clamp(a, min, max uint32) uint32 {
+    if a > max {
+        return max
+    }
+    if a < min {
+        return min
+    }
+    return a
+}
+
+// Add Adds the two FP numbers together, wrapping around on overflow.
+func Add(a, b uint32) uint32 {
+    return a + b
+}
+
+// Sub Subtracts the two FP numbers from each other, wrapping around below zero.
+func Sub(a, b uint32) uint32 {
+    return a - b
+}
+
+// Mul Multiplies two FP values together.
+func Mul(a, b uint32) uint32 {
+    return uint32((uint64(a) * uint64(b)) >> Shift)
+}
+
+// Lerp Linearly interpolate from a to b by t, with t in [0, 1].
+func Lerp(a, b, t uint32) uint32 {
+    return Mul(a, One-t) + Mul(b, t)
+}
+
+// DivPrecise Divides two FP values, returning MaxValue on overflow and 0 when b is 0.
+func DivPrecise(a, b uint32) uint32 {
+    if b == 0 {
+        return 0
+    }
+    r := (uint64(a) << Shift) / uint64(b)
+    if r > uint64(MaxValue) {
+        return MaxValue
+    }
+    return uint32(r)
+}
+
+// Mod Divides two FP values and returns the modulus.
+func Mod(a, b uint32) uint32 {
+    if b == 0 {
+        return 0
+    }
+    return a % b
+}
+
+// widen Converts to u32.32 for evaluation by ufix64.
+func widen(v uint32) uint64 {
+    return uint64(v) << 16
+}
+
+// narrow Converts a u32.32 result back, rounding down and clamping to MaxValue.
+func narrow(v uint64) uint32 {
+    v >>= 16
+    if v > uint64(MaxValue) {
+        return MaxValue
+    }
+    return uint32(v)
+}
+
+// Div Calculates division approximation.
+func Div(a, b uint32) uint32 {
+    return narrow(ufix64.Div(widen(a), widen(b)))
+}
+
+// DivFast Calculates division approximation.
+func DivFast(a, b uint32) uint32 {
+    return narrow(ufix64.DivFast(widen(a), widen(b)))
+}
+
+// DivFastest Calculates division approximation.
+func DivFastest(a, b uint32) uint32 {
+    return narrow(ufix64.DivFastest(widen(a), widen(b)))
+}
+
+// SqrtPrecise Calculates the square root of the given number, rounded down.
+func SqrtPrecise(a uint32) uint32 {
+    return narrow(ufix64.SqrtPrecise(widen(a)))
+}
+
+// Sqrt Calculates the square root.
+func Sqrt(x uint32) uint32 {
+    return narrow(ufix64.Sqrt(widen(x)))
+}
+
+// SqrtFast Calculates the square root.
+func SqrtFast(x uint32) uint32 {
+    return narrow(ufix64.SqrtFast(widen(x)))
+}
+
+// SqrtFastest Calculates the square root.
+func SqrtFastest(x uint32) uint32 {
+    return narrow(ufix64.SqrtFastest(widen(x)))
+}
+
+// RSqrt Calculates the reciprocal square root.
+func RSqrt(x uint32) uint32 {
+    return narrow(ufix64.RSqrt(widen(x)))
+}
+
+// RSqrtFast Calculates the reciprocal square root.
+func RSqrtFast(x uint32) uint32 {
+    return narrow(ufix64.RSqrtFast(widen(x)))
+}
+
+// RSqrtFastest Calculates the reciprocal square root.
+func RSqrtFastest(x uint32) uint32 {
+    return narrow(ufix64.RSqrtFastest(widen(x)))
+}
+
+// Rcp Calculates reciprocal approximation.
+func Rcp(x uint32) uint32 {
+    return narrow(ufix64.Rcp(widen(x)))
+}
+
+// RcpFast Calculates reciprocal approximation.
+func RcpFast(x uint32) uint32 {
+    return narrow(ufix64.RcpFast(widen(x)))
+}
+
+// RcpFastest Calculates reciprocal approximation.
+func RcpFastest(x uint32) uint32 {
+    return narrow(ufix64.RcpFastest(widen(x)))
+}
+
+// Exp2 Calculates the base 2 exponent of the signed 16.16 value x.
+func Exp2(x int32) uint32 {
+    return narrow(ufix64.Exp2(int64(x) << 16))
+}
+
+// Exp2Fast Calculates the base 2 exponent of the signed 16.16 value x.
+func Exp2Fast(x int32) uint32 {
+    return narrow(ufix64.Exp2Fast(int64(x) << 16))
+}
+
+// Exp2Fastest Calculates the base 2 exponent of the signed 16.16 value x.
+func Exp2Fastest(x int32) uint32 {
+    return narrow(ufix64.Exp2Fastest(int64(x) << 16))
+}
+
+// Exp Calculates the natural exponent of the signed 16.16 value x.
+func Exp(x int32) uint32 {
+    return narrow(ufix64.Exp(int64(x) << 16))
+}
+
+// ExpFast Calculates the natural exponent of the signed 16.16 value x.
+func ExpFast(x int32) uint32 {
+    return narrow(ufix64.ExpFast(int64(x) << 16))
+}
+
+// ExpFastest Calculates the natural exponent of the signed 16.16 value x.
+func ExpFastest(x int32) uint32 {
+    return narrow(ufix64.ExpFastest(int64(x) << 16))
+}
+
+// Log Natural logarithm (base e), as a signed 16.16 value. Returns 0 for 0.
+func Log(x uint32) int32 {
+    return int32(ufix64.Log(widen(x)) >> 16)
+}
+
+// LogFast Natural logarithm (base e), as a signed 16.16 value. Returns 0 for 0.
+func LogFast(x uint32) int32 {
+    return int32(ufix64.LogFast(widen(x)) >> 16)
+}
+
+// LogFastest Natural logarithm (base e), as a signed 16.16 value. Returns 0 for 0.
+func LogFastest(x uint32) int32 {
+    return int32(ufix64.LogFastest(widen(x)) >> 16)
+}
+
+// Log2 Binary logarithm, as a signed 16.16 value. Returns 0 for 0.
+func Log2(x uint32) int32 {
+    return int32(ufix64.Log2(widen(x)) >> 16)
+}
+
+// Log2Fast Binary logarithm, as a signed 16.16 value. Returns 0 for 0.
+func Log2Fast(x uint32) int32 {
+    return int32(ufix64.Log2Fast(widen(x)) >> 16)
+}
+
+// Log2Fastest Binary logarithm, as a signed 16.16 value. Returns 0 for 0.
+func Log2Fastest(x uint32) int32 {
+    return int32(ufix64.Log2Fastest(widen(x)) >> 16)
+}
+
+// Pow Calculates x to the power of the signed 16.16 exponent.
+func Pow(x uint32, exponent int32) uint32 {
+    return narrow(ufix64.Pow(widen(x), int64(exponent)<<16))
+}
+
+// PowFast Calculates x to the power of the signed 16.16 exponent.
+func PowFast(x uint32, exponent int32) uint32 {
+    return narrow(ufix64.PowFast(widen(x), int64(exponent)<<16))
+}
+
+// PowFastest Calculates x to the power of the signed 16.16 exponent.
+func PowFastest(x uint32, exponent int32) uint32 {
+    return narrow(ufix64.PowFastest(widen(x), int64(exponent)<<16))
+}
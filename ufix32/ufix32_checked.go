@@ -0,0 +1,77 @@
+package ufix32
+
+// Overflow-checked and saturating variants of the basic operators.
+//
+// The Checked functions report with ok=false when the true result does not fit into u16.16. AddChecked,
+// SubChecked and MulChecked still return the wrapped value of their unchecked counterparts, while
+// DivChecked returns 0 on overflow and on division by zero. The Sat functions clamp to 0/MaxValue
+// instead.
+
+// AddChecked Adds the two FP numbers together, reporting whether the result overflowed.
+func AddChecked(a, b uint32) (uint32, bool) {
+    r := a + b
+    return r, r >= a
+}
+
+// SubChecked Subtracts the two FP numbers from each other, reporting whether the result went below zero.
+func SubChecked(a, b uint32) (uint32, bool) {
+    return a - b, a >= b
+}
+
+// MulChecked Multiplies two FP values together, reporting whether the result overflowed.
+func MulChecked(a, b uint32) (uint32, bool) {
+    r := (uint64(a) * uint64(b)) >> Shift
+    return uint32(r), r <= uint64(MaxValue)
+}
+
+// DivChecked Divides two FP values like DivPrecise, but reports division by zero and overflow with ok=false
+// and a result of 0.
+func DivChecked(a, b uint32) (uint32, bool) {
+    if b == 0 {
+        return 0, false
+    }
+    r := (uint64(a) << Shift) / uint64(b)
+    if r > uint64(MaxValue) {
+        return 0, false
+    }
+    return uint32(r), true
+}
+
+// AddSat Adds the two FP numbers together, clamping to MaxValue on overflow.
+func AddSat(a, b uint32) uint32 {
+    r, ok := AddChecked(a, b)
+    if !ok {
+        return MaxValue
+    }
+    return r
+}
+
+// SubSat Subtracts the two FP numbers from each other, clamping to 0.
+func SubSat(a, b uint32) uint32 {
+    r, ok := SubChecked(a, b)
+    if !ok {
+        return 0
+    }
+    return r
+}
+
+// MulSat Multiplies two FP values together, clamping to MaxValue on overflow.
+func MulSat(a, b uint32) uint32 {
+    r, ok := MulChecked(a, b)
+    if !ok {
+        return MaxValue
+    }
+    return r
+}
+
+// DivSat Divides two FP values, clamping to MaxValue on overflow and on division by zero. 0 / 0 returns 0.
+func DivSat(a, b uint32) uint32 {
+    r, ok := DivChecked(a, b)
+    if !ok {
+        if a == 0 {
+            return 0
+        }
+        return MaxValue
+    }
+    return r
+}
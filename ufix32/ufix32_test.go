@@ -0,0 +1,75 @@
+package ufix32_test
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix32"
+    "github.com/camry/fp/ufix32"
+)
+
+func TestArithmetic(t *testing.T) {
+    f1 := ufix32.FromUint32(50000)
+    f2 := ufix32.FromFloat64(0.25)
+    assert.Equal(t, uint32(50000), ufix32.RoundToInt(ufix32.Add(f1, f2)))
+    assert.Equal(t, 49999.75, ufix32.ToFloat64(ufix32.Sub(f1, f2)))
+    assert.Equal(t, uint32(12500), ufix32.FloorToInt(ufix32.Mul(f1, f2)))
+    assert.Equal(t, ufix32.FromUint32(40000), ufix32.DivPrecise(ufix32.FromUint32(10000), f2))
+    assert.Equal(t, ufix32.MaxValue, ufix32.DivPrecise(f1, f2))
+
+    _, ok := ufix32.AddChecked(f1, f1)
+    assert.False(t, ok)
+    _, ok = ufix32.SubChecked(f2, f1)
+    assert.False(t, ok)
+    r, ok := ufix32.MulChecked(f1, ufix32.FromFloat64(1.25))
+    assert.True(t, ok)
+    assert.Equal(t, ufix32.FromUint32(62500), r)
+    _, ok = ufix32.MulChecked(f1, ufix32.Two)
+    assert.False(t, ok)
+    assert.Equal(t, ufix32.MaxValue, ufix32.MulSat(f1, ufix32.Two))
+    assert.Equal(t, uint32(0), ufix32.SubSat(f2, f1))
+}
+
+func TestMath(t *testing.T) {
+    assert.InDelta(t, math.Sqrt(50000), ufix32.ToFloat64(ufix32.Sqrt(ufix32.FromUint32(50000))), 0.0001)
+    assert.Equal(t, ufix32.FromUint32(3), ufix32.SqrtPrecise(ufix32.FromUint32(9)))
+    assert.InDelta(t, 0.1, ufix32.ToFloat64(ufix32.RSqrt(ufix32.FromUint32(100))), 0.0001)
+    assert.InDelta(t, 0.5, ufix32.ToFloat64(ufix32.Rcp(ufix32.Two)), 0.0001)
+    assert.InDelta(t, 25000, ufix32.ToFloat64(ufix32.Div(ufix32.FromUint32(50000), ufix32.Two)), 0.01)
+    assert.InDelta(t, -0.0100503359, fix32.ToFloat64(ufix32.Log(ufix32.FromFloat64(0.99))), 0.0001)
+    assert.InDelta(t, math.Log2(50000), fix32.ToFloat64(ufix32.Log2(ufix32.FromUint32(50000))), 0.0001)
+    assert.InDelta(t, math.Exp(10.5), ufix32.ToFloat64(ufix32.Exp(fix32.FromFloat64(10.5))), 0.01)
+    assert.InDelta(t, math.Exp(-2), ufix32.ToFloat64(ufix32.Exp(fix32.FromInt32(-2))), 0.0001)
+    assert.Equal(t, ufix32.MaxValue, ufix32.Exp2(fix32.FromInt32(16)))
+    assert.InDelta(t, 0.5, ufix32.ToFloat64(ufix32.Pow(ufix32.FromUint32(4), -fix32.Half)), 0.0001)
+}
+
+func TestConvert(t *testing.T) {
+    v, ok := ufix32.FromFix32(fix32.Pi)
+    assert.True(t, ok)
+    assert.Equal(t, ufix32.Pi, v)
+    _, ok = ufix32.FromFix32(fix32.Neg1)
+    assert.False(t, ok)
+
+    _, ok = ufix32.ToFix32(ufix32.FromUint32(40000))
+    assert.False(t, ok)
+    s, ok := ufix32.ToFix32(ufix32.FromUint32(30000))
+    assert.True(t, ok)
+    assert.Equal(t, fix32.FromInt32(30000), s)
+}
+
+func TestFormatParse(t *testing.T) {
+    assert.Equal(t, "65535.99998", ufix32.ToString(ufix32.MaxValue))
+    for _, v := range []uint32{0, 1, ufix32.Pi, ufix32.MaxValue} {
+        r, err := ufix32.Parse(ufix32.ToString(v))
+        assert.NoError(t, err)
+        assert.Equal(t, v, r)
+    }
+    _, err := ufix32.Parse("-0.5")
+    assert.Error(t, err)
+    r, err := ufix32.Parse("65536")
+    assert.Error(t, err)
+    assert.Equal(t, ufix32.MaxValue, r)
+}
@@ -0,0 +1,563 @@
+package ufix64
+
+import (
+    "math"
+    "math/bits"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/fixutil"
+)
+
+// Direct fixed point (unsigned 32.32) functions.
+//
+// Functions whose result can be negative, such as Log, return signed 32.32 values as used by fix64, and
+// Exp, Exp2 and Pow take signed arguments the same way.
+
+const (
+    Shift        int32  = 32
+    FractionMask uint64 = (1 << Shift) - 1
+    IntegerMask         = ^FractionMask
+)
+
+// Constants
+const (
+    Zero   uint64 = 0
+    One    uint64 = 1 << Shift
+    Two    uint64 = 2 << Shift
+    Three  uint64 = 3 << Shift
+    Four   uint64 = 4 << Shift
+    Half          = One >> 1
+    Pi     uint64 = 13493037705
+    Pi2    uint64 = 26986075409
+    PiHalf uint64 = 6746518852
+    E      uint64 = 11674931555
+)
+
+const (
+    MinValue uint64 = 0
+    MaxValue uint64 = math.MaxUint64
+)
+
+// FromUint32 Converts an integer to a fp-point value.
+func FromUint32(v uint32) uint64 {
+    return uint64(v) << Shift
+}
+
+// FromUint64 Converts an integer to a fp-point value.
+func FromUint64(v uint64) uint64 {
+    return v << Shift
+}
+
+// FromFloat32 Converts a float32 to a fp-point value, see FromFloat64.
+func FromFloat32(v float32) uint64 {
+    return FromFloat64(float64(v))
+}
+
+// FromFloat64 Converts a float64 to a fp-point value, truncating toward zero. Negative values and NaN
+// return 0, values too large return MaxValue.
+func FromFloat64(v float64) uint64 {
+    if !(v > 0) {
+        return 0
+    }
+    if v >= 4294967296.0 {
+        return MaxValue
+    }
+    return uint64(v * 4294967296.0)
+}
+
+// FromFix64 Converts a signed 32.32 value, ok is false when v is negative and the result wrapped around.
+func FromFix64(v int64) (uint64, bool) {
+    return uint64(v), v >= 0
+}
+
+// ToFix64 Converts the value to signed 32.32, ok is false when v is too large and the result wrapped around.
+func ToFix64(v uint64) (int64, bool) {
+    return int64(v), v <= math.MaxInt64
+}
+
+// CeilToInt Converts a fp-point value into an integer by rounding it up to nearest integer.
+func CeilToInt(v uint64) uint32 {
+    return uint32((v + (One - 1)) >> Shift)
+}
+
+// FloorToInt Converts a fp-point value into an integer by rounding it down to nearest integer.
+func FloorToInt(v uint64) uint32 {
+    return uint32(v >> Shift)
+}
+
+// RoundToInt Converts a fp-point value into an integer by rounding it to nearest integer.
+func RoundToInt(v uint64) uint32 {
+    return uint32((v + Half) >> Shift)
+}
+
+// ToFloat64 Converts a fp-point value into a float64.
+func ToFloat64(v uint64) float64 {
+    return float64(v) * (1.0 / 4294967296.0)
+}
+
+// ToFloat32 Converts a fp-point value into a float32.
+func ToFloat32(v uint64) float32 {
+    return float32(v) * (1.0 / 4294967296.0)
+}
+
+// ToString Converts the value to the shortest decimal string that parses back to the same value.
+func ToString(v uint64) string {
+    return fixutil.FormatUfixed(v, uint(Shift), 'f', -1)
+}
+
+// Format Converts the value to a string using integer arithmetic only, see fix64.Format.
+func Format(v uint64, fmt byte, prec int) string {
+    return fixutil.FormatUfixed(v, uint(Shift), fmt, prec)
+}
+
+// Parse Converts a decimal string, or a raw hexadecimal literal, into a fixed-point value, see fix64.Parse.
+// Negative values are out of range.
+func Parse(s string) (uint64, error) {
+    return fixutil.ParseUfixed("ufix64.Parse", s, uint(Shift), 64)
+}
+
+// Ceil Round up to nearest integer.
+func Ceil(v uint64) uint64 {
+    return (v + FractionMask) & IntegerMask
+}
+
+// Floor Round down to nearest integer.
+func Floor(v uint64) uint64 {
+    return v & IntegerMask
+}
+
+// Round to nearest integer.
+func Round(v uint64) uint64 {
+    return (v + Half) & IntegerMask
+}
+
+// Fract Returns the fractional part of x. Equal to 'x - floor(x)'.
+func Fract(v uint64) uint64 {
+    return v & FractionMask
+}
+
+// Min Returns the minimum of the two values.
+func Min(a, b uint64) uint64 {
+    if a < b {
+        return a
+    }
+    return b
+}
+
+// Max Returns the maximum of the two values.
+func Max(a, b uint64) uint64 {
+    if a > b {
+        return a
+    }
+    return b
+}
+
+// Clamp Returns the value clamped between min and max.
+func Clamp(a, min, max uint64) uint64 {
+    if a > max {
+        return max
+    }
+    if a < min {
+        return min
+    }
+    return a
+}
+
+// Add Adds the two FP numbers together, wrapping around on overflow.
+func Add(a, b uint64) uint64 {
+    return a + b
+}
+
+// Sub Subtracts the two FP numbers from each other, wrapping around below zero.
+func Sub(a, b uint64) uint64 {
+    return a - b
+}
+
+// Mul Multiplies two FP values together.
+func Mul(a, b uint64) uint64 {
+    hi, lo := bits.Mul64(a, b)
+    return hi<<Shift | lo>>Shift
+}
+
+// Lerp Linearly interpolate from a to b by t, with t in [0, 1].
+func Lerp(a, b, t uint64) uint64 {
+    return Mul(a, One-t) + Mul(b, t)
+}
+
+// DivPrecise Divides two FP values, returning MaxValue on overflow and 0 when b is 0.
+func DivPrecise(a, b uint64) uint64 {
+    if b == 0 {
+        return 0
+    }
+    hi := a >> Shift
+    if hi >= b {
+        return MaxValue
+    }
+    q, _ := bits.Div64(hi, a<<Shift, b)
+    return q
+}
+
+// Mod Divides two FP values and returns the modulus.
+func Mod(a, b uint64) uint64 {
+    if b == 0 {
+        return 0
+    }
+    return a % b
+}
+
+// normalize Splits x > 0 into an s2.30 mantissa in [1.0, 2.0( and the exponent offset.
+func normalize(x uint64) (n int32, offset int32) {
+    offset = 31 - int32(bits.LeadingZeros64(x))
+    if offset >= 0 {
+        n = int32(x >> offset >> 2)
+    } else {
+        n = int32(x << -offset >> 2)
+    }
+    return n, offset
+}
+
+// shiftSat Shifts v right by offset, or left for negative offsets, clamping to MaxValue.
+func shiftSat(v uint64, offset int32) uint64 {
+    if offset >= 0 {
+        return v >> offset
+    }
+    if v > MaxValue>>-offset {
+        return MaxValue
+    }
+    return v << -offset
+}
+
+// mulShiftSat Returns a * b shifted right by sh, or left for negative sh, clamping to MaxValue.
+func mulShiftSat(a, b uint64, sh int32) uint64 {
+    hi, lo := bits.Mul64(a, b)
+    if sh <= 0 {
+        if hi != 0 {
+            return MaxValue
+        }
+        return shiftSat(lo, sh)
+    }
+    if hi>>sh != 0 {
+        return MaxValue
+    }
+    return hi<<(64-sh) | lo>>sh
+}
+
+func div(a, b uint64, poly func(int32) int32) uint64 {
+    if b == 0 {
+        return 0
+    }
+
+    // Normalize input into [1.0, 2.0( range (convert to s2.30).
+    n, offset := normalize(b)
+    const ONE int32 = 1 << 30
+
+    // Polynomial approximation.
+    res := poly(n - ONE)
+
+    // Apply exponent, convert back to s32.32.
+    return mulShiftSat(a, uint64(res), 30+offset)
+}
+
+// Div Calculates division approximation.
+func Div(a, b uint64) uint64 {
+    return div(a, b, fixutil.RcpPoly4Lut8)
+}
+
+// DivFast Calculates division approximation.
+func DivFast(a, b uint64) uint64 {
+    return div(a, b, fixutil.RcpPoly6)
+}
+
+// DivFastest Calculates division approximation.
+func DivFastest(a, b uint64) uint64 {
+    return div(a, b, fixutil.RcpPoly4)
+}
+
+// SqrtPrecise Calculates the square root of the given number, rounded down.
+func SqrtPrecise(a uint64) uint64 {
+    // Bitwise integer square root of the 96-bit value a << 32.
+    nHi, nLo := a>>Shift, a<<Shift
+    var q uint64
+    for b := uint64(1) << 47; b > 0; b >>= 1 {
+        t := q | b
+        hi, lo := bits.Mul64(t, t)
+        if hi < nHi || (hi == nHi && lo <= nLo) {
+            q = t
+        }
+    }
+    return q
+}
+
+func sqrt(x uint64, poly func(int32) int32) uint64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Constants (s2.30).
+    const ONE int32 = 1 << 30
+    const SQRT2 int32 = 1518500249 // sqrt(2.0)
+
+    // Normalize input into [1.0, 2.0( range (as s2.30).
+    n, offset := normalize(x)
+    y := poly(n - ONE)
+
+    // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
+    adjust := ONE
+    if (offset & 1) != 0 {
+        adjust = SQRT2
+    }
+    offset = offset >> 1
+
+    // Apply exponent, convert back to s32.32.
+    yr := uint64(fixutil.Qmul30(adjust, y)) << 2
+    if offset >= 0 {
+        return yr << offset
+    }
+    return yr >> -offset
+}
+
+// Sqrt Calculates the square root.
+func Sqrt(x uint64) uint64 {
+    return sqrt(x, fixutil.SqrtPoly3Lut8)
+}
+
+// SqrtFast Calculates the square root.
+func SqrtFast(x uint64) uint64 {
+    return sqrt(x, fixutil.SqrtPoly4)
+}
+
+// SqrtFastest Calculates the square root.
+func SqrtFastest(x uint64) uint64 {
+    return sqrt(x, fixutil.SqrtPoly3)
+}
+
+func rsqrt(x uint64, poly func(int32) int32) uint64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Constants (s2.30).
+    const ONE int32 = 1 << 30
+    const HalfSqrt2 int32 = 759250125 // 0.5 * sqrt(2.0)
+
+    // Normalize input into [1.0, 2.0( range (as s2.30).
+    n, offset := normalize(x)
+    y := poly(n - ONE)
+
+    // Divide offset by 2 (to get sqrt), compute adjust value for odd exponents.
+    adjust := ONE
+    if (offset & 1) != 0 {
+        adjust = HalfSqrt2
+    }
+    offset = offset >> 1
+
+    // Apply exponent, convert back to s32.32.
+    yr := uint64(fixutil.Qmul30(adjust, y)) << 2
+    if offset >= 0 {
+        return yr >> offset
+    }
+    return yr << -offset
+}
+
+// RSqrt Calculates the reciprocal square root.
+func RSqrt(x uint64) uint64 {
+    return rsqrt(x, fixutil.RSqrtPoly3Lut16)
+}
+
+// RSqrtFast Calculates the reciprocal square root.
+func RSqrtFast(x uint64) uint64 {
+    return rsqrt(x, fixutil.RSqrtPoly5)
+}
+
+// RSqrtFastest Calculates the reciprocal square root.
+func RSqrtFastest(x uint64) uint64 {
+    return rsqrt(x, fixutil.RSqrtPoly3)
+}
+
+func rcp(x uint64, poly func(int32) int32) uint64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Normalize input into [1.0, 2.0( range (convert to s2.30).
+    n, offset := normalize(x)
+    const ONE int32 = 1 << 30
+
+    // Polynomial approximation, apply exponent, convert back to s32.32.
+    y := uint64(poly(n-ONE)) << 2
+    return shiftSat(y, offset)
+}
+
+// Rcp Calculates reciprocal approximation.
+func Rcp(x uint64) uint64 {
+    return rcp(x, fixutil.RcpPoly4Lut8)
+}
+
+// RcpFast Calculates reciprocal approximation.
+func RcpFast(x uint64) uint64 {
+    return rcp(x, fixutil.RcpPoly6)
+}
+
+// RcpFastest Calculates reciprocal approximation.
+func RcpFastest(x uint64) uint64 {
+    return rcp(x, fixutil.RcpPoly4)
+}
+
+func exp2(x int64, poly func(int32) int32) uint64 {
+    // Handle values that would under or overflow.
+    if x >= 32*fix64.One {
+        return MaxValue
+    }
+    if x <= -32*fix64.One {
+        return 0
+    }
+
+    // Compute exp2 for fractional part.
+    k := int32((uint64(x) & FractionMask) >> 2)
+    y := uint64(poly(k)) << 2
+
+    // Combine integer and fractional result, and convert back to s32.32.
+    intPart := int32(x >> Shift)
+    if intPart >= 0 {
+        return y << intPart
+    }
+    return y >> -intPart
+}
+
+// Exp2 Calculates the base 2 exponent of the signed 32.32 value x.
+func Exp2(x int64) uint64 {
+    return exp2(x, fixutil.Exp2Poly5)
+}
+
+// Exp2Fast Calculates the base 2 exponent of the signed 32.32 value x.
+func Exp2Fast(x int64) uint64 {
+    return exp2(x, fixutil.Exp2Poly4)
+}
+
+// Exp2Fastest Calculates the base 2 exponent of the signed 32.32 value x.
+func Exp2Fastest(x int64) uint64 {
+    return exp2(x, fixutil.Exp2Poly3)
+}
+
+// Exp Calculates the natural exponent of the signed 32.32 value x.
+func Exp(x int64) uint64 {
+    // e^x == 2^(x / ln(2))
+    return Exp2(fix64.Mul(x, fix64.RcpLn2))
+}
+
+// ExpFast Calculates the natural exponent of the signed 32.32 value x.
+func ExpFast(x int64) uint64 {
+    // e^x == 2^(x / ln(2))
+    return Exp2Fast(fix64.Mul(x, fix64.RcpLn2))
+}
+
+// ExpFastest Calculates the natural exponent of the signed 32.32 value x.
+func ExpFastest(x int64) uint64 {
+    // e^x == 2^(x / ln(2))
+    return Exp2Fastest(fix64.Mul(x, fix64.RcpLn2))
+}
+
+func log(x uint64, poly func(int32) int32) int64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Normalize value to range [1.0, 2.0( as s2.30 and extract exponent.
+    const ONE int32 = 1 << 30
+    n, offset := normalize(x)
+    y := int64(poly(n-ONE)) << 2
+
+    // Combine integer and fractional parts (into s32.32).
+    return int64(offset)*fix64.RcpLog2E + y
+}
+
+// Log Natural logarithm (base e), as a signed 32.32 value. Returns 0 for 0.
+func Log(x uint64) int64 {
+    return log(x, fixutil.LogPoly5Lut8)
+}
+
+// LogFast Natural logarithm (base e), as a signed 32.32 value. Returns 0 for 0.
+func LogFast(x uint64) int64 {
+    return log(x, fixutil.LogPoly3Lut8)
+}
+
+// LogFastest Natural logarithm (base e), as a signed 32.32 value. Returns 0 for 0.
+func LogFastest(x uint64) int64 {
+    return log(x, fixutil.LogPoly5)
+}
+
+func log2(x uint64, poly func(int32) int32) int64 {
+    if x == 0 {
+        return 0
+    }
+
+    // Normalize value to range [1.0, 2.0( as s2.30 and extract exponent.
+    const ONE int32 = 1 << 30
+    n, offset := normalize(x)
+
+    // Polynomial approximation of mantissa.
+    y := int64(poly(n-ONE)) << 2
+
+    // Combine integer and fractional parts (into s32.32).
+    return (int64(offset) << Shift) + y
+}
+
+// Log2 Binary logarithm, as a signed 32.32 value. Returns 0 for 0.
+func Log2(x uint64) int64 {
+    return log2(x, fixutil.Log2Poly4Lut16)
+}
+
+// Log2Fast Binary logarithm, as a signed 32.32 value. Returns 0 for 0.
+func Log2Fast(x uint64) int64 {
+    return log2(x, fixutil.Log2Poly3Lut16)
+}
+
+// Log2Fastest Binary logarithm, as a signed 32.32 value. Returns 0 for 0.
+func Log2Fastest(x uint64) int64 {
+    return log2(x, fixutil.Log2Poly5)
+}
+
+// Pow Calculates x to the power of the signed 32.32 exponent.
+func Pow(x uint64, exponent int64) uint64 {
+    // n^0 == 1
+    if exponent == 0 {
+        return One
+    }
+
+    // Return 0 for invalid values
+    if x == 0 {
+        return 0
+    }
+
+    return Exp(fix64.Mul(exponent, Log(x)))
+}
+
+// PowFast Calculates x to the power of the signed 32.32 exponent.
+func PowFast(x uint64, exponent int64) uint64 {
+    // n^0 == 1
+    if exponent == 0 {
+        return One
+    }
+
+    // Return 0 for invalid values
+    if x == 0 {
+        return 0
+    }
+
+    return ExpFast(fix64.Mul(exponent, LogFast(x)))
+}
+
+// PowFastest Calculates x to the power of the signed 32.32 exponent.
+func PowFastest(x uint64, exponent int64) uint64 {
+    // n^0 == 1
+    if exponent == 0 {
+        return One
+    }
+
+    // Return 0 for invalid values
+    if x == 0 {
+        return 0
+    }
+
+    return ExpFastest(fix64.Mul(exponent, LogFastest(x)))
+}
@@ -0,0 +1,78 @@
+package ufix64
+
+import (
+    "math/bits"
+)
+
+// Overflow-checked and saturating variants of the basic operators.
+//
+// The Checked functions report with ok=false when the true result does not fit into u32.32. AddChecked,
+// SubChecked and MulChecked still return the wrapped value of their unchecked counterparts, while
+// DivChecked returns 0 on overflow and on division by zero. The Sat functions clamp to 0/MaxValue
+// instead.
+
+// AddChecked Adds the two FP numbers together, reporting whether the result overflowed.
+func AddChecked(a, b uint64) (uint64, bool) {
+    r, carry := bits.Add64(a, b, 0)
+    return r, carry == 0
+}
+
+// SubChecked Subtracts the two FP numbers from each other, reporting whether the result went below zero.
+func SubChecked(a, b uint64) (uint64, bool) {
+    r, borrow := bits.Sub64(a, b, 0)
+    return r, borrow == 0
+}
+
+// MulChecked Multiplies two FP values together, reporting whether the result overflowed.
+func MulChecked(a, b uint64) (uint64, bool) {
+    hi, _ := bits.Mul64(a, b)
+    return Mul(a, b), hi>>Shift == 0
+}
+
+// DivChecked Divides two FP values like DivPrecise, but reports division by zero and overflow with ok=false
+// and a result of 0.
+func DivChecked(a, b uint64) (uint64, bool) {
+    if b == 0 || a>>Shift >= b {
+        return 0, false
+    }
+    return DivPrecise(a, b), true
+}
+
+// AddSat Adds the two FP numbers together, clamping to MaxValue on overflow.
+func AddSat(a, b uint64) uint64 {
+    r, ok := AddChecked(a, b)
+    if !ok {
+        return MaxValue
+    }
+    return r
+}
+
+// SubSat Subtracts the two FP numbers from each other, clamping to 0.
+func SubSat(a, b uint64) uint64 {
+    r, ok := SubChecked(a, b)
+    if !ok {
+        return 0
+    }
+    return r
+}
+
+// MulSat Multiplies two FP values together, clamping to MaxValue on overflow.
+func MulSat(a, b uint64) uint64 {
+    r, ok := MulChecked(a, b)
+    if !ok {
+        return MaxValue
+    }
+    return r
+}
+
+// DivSat Divides two FP values, clamping to MaxValue on overflow and on division by zero. 0 / 0 returns 0.
+func DivSat(a, b uint64) uint64 {
+    r, ok := DivChecked(a, b)
+    if !ok {
+        if a == 0 {
+            return 0
+        }
+        return MaxValue
+    }
+    return r
+}
@@ -0,0 +1,121 @@
+package ufix64_test
+
+import (
+    "math"
+    "math/big"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+
+    "github.com/camry/fp/fix64"
+    "github.com/camry/fp/ufix64"
+)
+
+func TestArithmetic(t *testing.T) {
+    // The top bit is range that signed 32.32 does not have.
+    f1 := ufix64.FromUint32(3000000000)
+    f2 := ufix64.FromFloat64(0.25)
+    assert.Equal(t, uint32(3000000000), ufix64.RoundToInt(ufix64.Add(f1, f2)))
+    assert.Equal(t, 2999999999.75, ufix64.ToFloat64(ufix64.Sub(f1, f2)))
+    assert.Equal(t, uint32(750000000), ufix64.FloorToInt(ufix64.Mul(f1, f2)))
+    assert.Equal(t, ufix64.FromUint32(4000000000), ufix64.DivPrecise(ufix64.FromUint32(1000000000), f2))
+    assert.Equal(t, ufix64.MaxValue, ufix64.DivPrecise(f1, f2))
+    assert.Equal(t, uint64(0), ufix64.FromFloat64(-1))
+    assert.Equal(t, ufix64.MaxValue, ufix64.FromFloat64(1e10))
+    assert.Equal(t, ufix64.FromFloat64(1.75), ufix64.Lerp(ufix64.One, ufix64.Two, ufix64.FromFloat64(0.75)))
+}
+
+func TestChecked(t *testing.T) {
+    values := []uint64{0, 1, ufix64.Half, ufix64.One, ufix64.Two, ufix64.FromUint32(65536), math.MaxInt64, ufix64.MaxValue - 1, ufix64.MaxValue}
+    max := new(big.Int).SetUint64(ufix64.MaxValue)
+    fits := func(v *big.Int) bool {
+        return v.Sign() >= 0 && v.Cmp(max) <= 0
+    }
+    for _, a := range values {
+        for _, b := range values {
+            ba, bb := new(big.Int).SetUint64(a), new(big.Int).SetUint64(b)
+
+            r, ok := ufix64.AddChecked(a, b)
+            assert.Equal(t, fits(new(big.Int).Add(ba, bb)), ok, "AddChecked(%d, %d)", a, b)
+            assert.Equal(t, ufix64.Add(a, b), r)
+
+            r, ok = ufix64.SubChecked(a, b)
+            assert.Equal(t, fits(new(big.Int).Sub(ba, bb)), ok, "SubChecked(%d, %d)", a, b)
+            assert.Equal(t, ufix64.Sub(a, b), r)
+
+            prod := new(big.Int).Rsh(new(big.Int).Mul(ba, bb), 32)
+            r, ok = ufix64.MulChecked(a, b)
+            assert.Equal(t, fits(prod), ok, "MulChecked(%d, %d)", a, b)
+            if ok {
+                assert.Equal(t, prod.Uint64(), r)
+            }
+
+            r, ok = ufix64.DivChecked(a, b)
+            if b == 0 {
+                assert.False(t, ok)
+                continue
+            }
+            quo := new(big.Int).Quo(new(big.Int).Lsh(ba, 32), bb)
+            assert.Equal(t, fits(quo), ok, "DivChecked(%d, %d)", a, b)
+            if ok {
+                assert.Equal(t, quo.Uint64(), r)
+            }
+        }
+    }
+    assert.Equal(t, uint64(0), ufix64.SubSat(ufix64.One, ufix64.Two))
+    assert.Equal(t, ufix64.MaxValue, ufix64.AddSat(ufix64.MaxValue, 1))
+    assert.Equal(t, ufix64.MaxValue, ufix64.DivSat(ufix64.One, 0))
+}
+
+func TestMath(t *testing.T) {
+    big := ufix64.FromFloat64(3e9)
+    assert.InDelta(t, math.Sqrt(3e9), ufix64.ToFloat64(ufix64.Sqrt(big)), 0.01)
+    assert.InDelta(t, math.Sqrt(3e9), ufix64.ToFloat64(ufix64.SqrtPrecise(big)), 1e-9)
+    assert.Equal(t, ufix64.FromUint32(3), ufix64.SqrtPrecise(ufix64.FromUint32(9)))
+    assert.InDelta(t, 0.5477225575, ufix64.ToFloat64(ufix64.Sqrt(ufix64.FromFloat64(0.3))), 0.000001)
+    assert.InDelta(t, 0.1, ufix64.ToFloat64(ufix64.RSqrt(ufix64.FromUint32(100))), 0.000001)
+    assert.InDelta(t, 1/3e9, ufix64.ToFloat64(ufix64.Rcp(big)), 1e-9)
+    assert.InDelta(t, 1500, ufix64.ToFloat64(ufix64.Div(big, ufix64.FromUint32(2000000))), 0.001)
+
+    // Log is negative below one and Exp accepts negative arguments.
+    assert.InDelta(t, -0.0100503359, fix64.ToFloat64(ufix64.Log(ufix64.FromFloat64(0.99))), 0.000001)
+    assert.InDelta(t, math.Log(3e9), fix64.ToFloat64(ufix64.Log(big)), 0.000001)
+    assert.InDelta(t, 31.5, fix64.ToFloat64(ufix64.Log2(ufix64.FromFloat64(math.Exp2(31.5)))), 0.000001)
+    assert.InDelta(t, math.Exp(-2), ufix64.ToFloat64(ufix64.Exp(fix64.FromInt32(-2))), 0.000001)
+    assert.InDelta(t, math.Exp2(31.5)/1e9, ufix64.ToFloat64(ufix64.Exp2(fix64.FromFloat64(31.5)))/1e9, 0.000001)
+    assert.Equal(t, ufix64.MaxValue, ufix64.Exp2(fix64.FromInt32(32)))
+    assert.InDelta(t, 0.5, ufix64.ToFloat64(ufix64.Pow(ufix64.FromUint32(4), -fix64.Half)), 0.000001)
+}
+
+func TestConvert(t *testing.T) {
+    v, ok := ufix64.FromFix64(fix64.Pi)
+    assert.True(t, ok)
+    assert.Equal(t, ufix64.Pi, v)
+    _, ok = ufix64.FromFix64(fix64.Neg1)
+    assert.False(t, ok)
+
+    s, ok := ufix64.ToFix64(ufix64.FromUint32(1 << 31))
+    assert.False(t, ok)
+    assert.Equal(t, fix64.MinValue, s)
+    s, ok = ufix64.ToFix64(ufix64.E)
+    assert.True(t, ok)
+    assert.Equal(t, fix64.E, s)
+}
+
+func TestFormatParse(t *testing.T) {
+    assert.Equal(t, "4294967295.9999999998", ufix64.ToString(ufix64.MaxValue))
+    assert.Equal(t, "0xffffffffffffffff", ufix64.Format(ufix64.MaxValue, 'x', -1))
+    for _, v := range []uint64{0, 1, ufix64.Pi, ufix64.MaxValue, ufix64.MaxValue - 1} {
+        r, err := ufix64.Parse(ufix64.ToString(v))
+        assert.NoError(t, err)
+        assert.Equal(t, v, r)
+    }
+    _, err := ufix64.Parse("-1")
+    assert.Error(t, err)
+    r, err := ufix64.Parse("-0")
+    assert.NoError(t, err)
+    assert.Equal(t, uint64(0), r)
+    r, err = ufix64.Parse("4294967296")
+    assert.Error(t, err)
+    assert.Equal(t, ufix64.MaxValue, r)
+}